@@ -90,3 +90,16 @@ func (arn ARN) String() string {
 		arn.AccountID + arnDelimiter +
 		arn.Resource
 }
+
+// ResourceID returns the resource of the ARN without its leading resource
+// type, such as "my-firewall" of the resource "firewall/my-firewall". The
+// resource type is the part of the resource before the first slash (/) or
+// colon (:). Any path that follows the resource type is returned, such as
+// "my-db/table/my-table" of the resource "database/my-db/table/my-table". If
+// the resource has no resource type, the whole resource is returned.
+func (arn ARN) ResourceID() string {
+	if i := strings.IndexAny(arn.Resource, "/:"); i >= 0 {
+		return arn.Resource[i+1:]
+	}
+	return arn.Resource
+}
@@ -127,3 +127,65 @@ func TestIsARN(t *testing.T) {
 		})
 	}
 }
+
+func TestARNResourceID(t *testing.T) {
+	cases := map[string]struct {
+		Input      string
+		AccountID  string
+		Region     string
+		ResourceID string
+	}{
+		"timestream database": {
+			Input:      "arn:aws:timestream:us-east-1:123456789012:database/my-db",
+			AccountID:  "123456789012",
+			Region:     "us-east-1",
+			ResourceID: "my-db",
+		},
+		"timestream table": {
+			Input:      "arn:aws:timestream:us-east-1:123456789012:database/my-db/table/my-table",
+			AccountID:  "123456789012",
+			Region:     "us-east-1",
+			ResourceID: "my-db/table/my-table",
+		},
+		"iotsitewise asset": {
+			Input:      "arn:aws:iotsitewise:us-west-2:123456789012:asset/a1b2c3d4-5678-90ab-cdef-11111EXAMPLE",
+			AccountID:  "123456789012",
+			Region:     "us-west-2",
+			ResourceID: "a1b2c3d4-5678-90ab-cdef-11111EXAMPLE",
+		},
+		"networkfirewall firewall": {
+			Input:      "arn:aws:network-firewall:us-west-2:123456789012:firewall/my-firewall",
+			AccountID:  "123456789012",
+			Region:     "us-west-2",
+			ResourceID: "my-firewall",
+		},
+		"colon resource type": {
+			Input:      "arn:aws:rds:eu-west-1:123456789012:db:mysql-db",
+			AccountID:  "123456789012",
+			Region:     "eu-west-1",
+			ResourceID: "mysql-db",
+		},
+		"no resource type": {
+			Input:      "arn:aws:s3:::my_corporate_bucket",
+			ResourceID: "my_corporate_bucket",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			arn, err := Parse(c.Input)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.AccountID, arn.AccountID; e != a {
+				t.Errorf("expect %v account ID, got %v", e, a)
+			}
+			if e, a := c.Region, arn.Region; e != a {
+				t.Errorf("expect %v region, got %v", e, a)
+			}
+			if e, a := c.ResourceID, arn.ResourceID(); e != a {
+				t.Errorf("expect %v resource ID, got %v", e, a)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package aws
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/internal/sdk"
+	"github.com/aws/aws-sdk-go-v2/internal/sync/singleflight"
+)
+
+// DescribeCache caches the results of describing resources, such as with
+// DescribeTable or DescribeAsset, for a TTL, so metadata that is read
+// repeatedly is not described for every read. Concurrent gets of a key that
+// is not cached are coalesced into a single fetch, whose result is shared by
+// each of the gets. Errors are not cached.
+//
+// A DescribeCache is safe for concurrent use.
+//
+//    cache := aws.NewDescribeCache(5 * time.Minute)
+//    v, err := cache.Get("db/table", func() (interface{}, error) {
+//        return client.DescribeTable(ctx, params)
+//    })
+type DescribeCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]describeCacheEntry
+	sf      singleflight.Group
+}
+
+type describeCacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// NewDescribeCache returns a DescribeCache that caches values for the ttl. If
+// the ttl is zero or less, values are not cached, but concurrent gets of a key
+// are still coalesced.
+func NewDescribeCache(ttl time.Duration) *DescribeCache {
+	return &DescribeCache{
+		ttl:     ttl,
+		entries: map[string]describeCacheEntry{},
+	}
+}
+
+// Get returns the cached value of the key, if it has not expired. Otherwise
+// fetch is called to retrieve the value, which is cached if fetch does not
+// return an error. Gets of the key while fetch is being called wait for, and
+// return the result of, that call instead of calling their own fetch.
+func (c *DescribeCache) Get(key string, fetch func() (interface{}, error)) (interface{}, error) {
+	if v, ok := c.get(key); ok {
+		return v, nil
+	}
+
+	v, err, _ := c.sf.Do(key, func() (interface{}, error) {
+		if v, ok := c.get(key); ok {
+			return v, nil
+		}
+
+		v, err := fetch()
+		if err != nil {
+			return nil, err
+		}
+		if c.ttl > 0 {
+			c.mu.Lock()
+			c.entries[key] = describeCacheEntry{
+				value:   v,
+				expires: sdk.NowTime().Add(c.ttl),
+			}
+			c.mu.Unlock()
+		}
+		return v, nil
+	})
+	return v, err
+}
+
+// Invalidate removes the cached value of the key, so the next Get of the key
+// fetches the value.
+func (c *DescribeCache) Invalidate(key string) {
+	c.mu.Lock()
+	delete(c.entries, key)
+	c.mu.Unlock()
+}
+
+func (c *DescribeCache) get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !sdk.NowTime().Before(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
@@ -0,0 +1,130 @@
+package aws_test
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/sdk"
+)
+
+func TestDescribeCache_SingleFlight(t *testing.T) {
+	cache := aws.NewDescribeCache(time.Minute)
+
+	var fetches int32
+	release := make(chan struct{})
+	fetch := func() (interface{}, error) {
+		atomic.AddInt32(&fetches, 1)
+		<-release
+		return "table", nil
+	}
+
+	const gets = 10
+	var started, wg sync.WaitGroup
+	results := make([]interface{}, gets)
+	for i := 0; i < gets; i++ {
+		started.Add(1)
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			started.Done()
+			v, err := cache.Get("db/table", fetch)
+			if err != nil {
+				t.Errorf("expect no error, got %v", err)
+			}
+			results[i] = v
+		}(i)
+	}
+	started.Wait()
+	// Allow the gets to reach the in-flight fetch before it completes.
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if e, a := int32(1), atomic.LoadInt32(&fetches); e != a {
+		t.Errorf("expect %v fetches, got %v", e, a)
+	}
+	for i, v := range results {
+		if e, a := "table", v; e != a {
+			t.Errorf("expect get %d to return %v, got %v", i, e, a)
+		}
+	}
+}
+
+func TestDescribeCache_TTL(t *testing.T) {
+	orig := sdk.NowTime
+	defer func() { sdk.NowTime = orig }()
+
+	now := time.Date(2021, 2, 3, 12, 0, 0, 0, time.UTC)
+	sdk.NowTime = func() time.Time { return now }
+
+	var fetches int
+	fetch := func() (interface{}, error) {
+		fetches++
+		return fmt.Sprintf("value-%d", fetches), nil
+	}
+
+	cache := aws.NewDescribeCache(time.Minute)
+
+	cases := []struct {
+		Advance       time.Duration
+		Key           string
+		ExpectValue   string
+		ExpectFetches int
+	}{
+		{Key: "a", ExpectValue: "value-1", ExpectFetches: 1},
+		{Advance: 59 * time.Second, Key: "a", ExpectValue: "value-1", ExpectFetches: 1},
+		{Key: "b", ExpectValue: "value-2", ExpectFetches: 2},
+		{Advance: time.Second, Key: "a", ExpectValue: "value-3", ExpectFetches: 3},
+		{Key: "a", ExpectValue: "value-3", ExpectFetches: 3},
+	}
+
+	for i, c := range cases {
+		now = now.Add(c.Advance)
+		v, err := cache.Get(c.Key, fetch)
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if e, a := c.ExpectValue, v; e != a {
+			t.Errorf("%d, expect %v value, got %v", i, e, a)
+		}
+		if e, a := c.ExpectFetches, fetches; e != a {
+			t.Errorf("%d, expect %v fetches, got %v", i, e, a)
+		}
+	}
+
+	cache.Invalidate("a")
+	if v, _ := cache.Get("a", fetch); v != "value-4" {
+		t.Errorf("expect invalidated value to be fetched, got %v", v)
+	}
+}
+
+func TestDescribeCache_Error(t *testing.T) {
+	cache := aws.NewDescribeCache(time.Minute)
+
+	var fetches int
+	_, err := cache.Get("a", func() (interface{}, error) {
+		fetches++
+		return nil, fmt.Errorf("throttled")
+	})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+
+	v, err := cache.Get("a", func() (interface{}, error) {
+		fetches++
+		return "value", nil
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "value", v; e != a {
+		t.Errorf("expect %v value, got %v", e, a)
+	}
+	if e, a := 2, fetches; e != a {
+		t.Errorf("expect %v fetches, got %v", e, a)
+	}
+}
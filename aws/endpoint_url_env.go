@@ -0,0 +1,33 @@
+package aws
+
+import (
+	"os"
+	"strings"
+)
+
+// ServiceEndpointURLEnvVar returns the name of the environment variable that
+// overrides the endpoint URL of the service with the service ID, such as
+// AWS_ENDPOINT_URL_TIMESTREAM_WRITE for the "Timestream Write" service. The
+// service ID is upper cased, with characters other than letters and digits
+// replaced with underscores.
+func ServiceEndpointURLEnvVar(serviceID string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, serviceID)
+	return "AWS_ENDPOINT_URL_" + name
+}
+
+// ServiceEndpointURLFromEnv returns the endpoint URL of the service with the
+// service ID from the environment, such as for sending requests to a local
+// emulator or private endpoint without changing code. Returns an empty string
+// if the environment variable named by ServiceEndpointURLEnvVar is not set.
+func ServiceEndpointURLFromEnv(serviceID string) string {
+	return os.Getenv(ServiceEndpointURLEnvVar(serviceID))
+}
@@ -0,0 +1,53 @@
+package aws_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting"
+)
+
+func TestServiceEndpointURLFromEnv(t *testing.T) {
+	cases := map[string]struct {
+		ServiceID string
+		Env       map[string]string
+		ExpectVar string
+		ExpectURL string
+	}{
+		"single word": {
+			ServiceID: "DynamoDB",
+			Env:       map[string]string{"AWS_ENDPOINT_URL_DYNAMODB": "http://localhost:8000"},
+			ExpectVar: "AWS_ENDPOINT_URL_DYNAMODB",
+			ExpectURL: "http://localhost:8000",
+		},
+		"multiple words": {
+			ServiceID: "Timestream Write",
+			Env:       map[string]string{"AWS_ENDPOINT_URL_TIMESTREAM_WRITE": "http://localhost:4566"},
+			ExpectVar: "AWS_ENDPOINT_URL_TIMESTREAM_WRITE",
+			ExpectURL: "http://localhost:4566",
+		},
+		"other service": {
+			ServiceID: "EC2",
+			Env:       map[string]string{"AWS_ENDPOINT_URL_DYNAMODB": "http://localhost:8000"},
+			ExpectVar: "AWS_ENDPOINT_URL_EC2",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			restoreEnv := awstesting.StashEnv()
+			defer awstesting.PopEnv(restoreEnv)
+			for k, v := range c.Env {
+				os.Setenv(k, v)
+			}
+
+			if e, a := c.ExpectVar, aws.ServiceEndpointURLEnvVar(c.ServiceID); e != a {
+				t.Errorf("expect %v variable, got %v", e, a)
+			}
+			if e, a := c.ExpectURL, aws.ServiceEndpointURLFromEnv(c.ServiceID); e != a {
+				t.Errorf("expect %v URL, got %v", e, a)
+			}
+		})
+	}
+}
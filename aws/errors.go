@@ -1,5 +1,10 @@
 package aws
 
+import (
+	"fmt"
+	"strings"
+)
+
 // MissingRegionError is an error that is returned if region configuration
 // value was not found.
 type MissingRegionError struct{}
@@ -7,3 +12,43 @@ type MissingRegionError struct{}
 func (*MissingRegionError) Error() string {
 	return "an AWS region is required, but was not found"
 }
+
+// BatchFailure is the failure of a single item of a batch.
+type BatchFailure struct {
+	// The index of the item within the batch.
+	Index int
+
+	// The error the item failed with.
+	Err error
+}
+
+// BatchError is an error that is returned by batch helpers when one or more
+// of the items of the batch failed. The successful items of the batch are
+// returned by the helper along with the error.
+type BatchError struct {
+	// The failed items of the batch, ordered by index.
+	Failures []BatchFailure
+}
+
+func (e *BatchError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d batch item(s) failed", len(e.Failures))
+	for i, f := range e.Failures {
+		if i == 0 {
+			sb.WriteString(", ")
+		} else {
+			sb.WriteString("; ")
+		}
+		fmt.Fprintf(&sb, "index %d: %v", f.Index, f.Err)
+	}
+	return sb.String()
+}
+
+// Unwrap returns the errors of the failed items of the batch.
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failures))
+	for _, f := range e.Failures {
+		errs = append(errs, f.Err)
+	}
+	return errs
+}
@@ -0,0 +1,59 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type batchItemError struct {
+	Item string
+}
+
+func (e *batchItemError) Error() string {
+	return fmt.Sprintf("item %s failed", e.Item)
+}
+
+func TestBatchError(t *testing.T) {
+	errThrottled := errors.New("throttled")
+	itemErr := &batchItemError{Item: "c"}
+
+	var err error = &BatchError{
+		Failures: []BatchFailure{
+			{Index: 1, Err: errThrottled},
+			{Index: 3, Err: fmt.Errorf("wrapped, %w", itemErr)},
+		},
+	}
+
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("expect %T error, got %T", batchErr, err)
+	}
+	if e, a := 2, len(batchErr.Failures); e != a {
+		t.Fatalf("expect %v failures, got %v", e, a)
+	}
+	for i, expect := range []int{1, 3} {
+		if e, a := expect, batchErr.Failures[i].Index; e != a {
+			t.Errorf("expect %v index, got %v", e, a)
+		}
+	}
+
+	if e, a := 2, len(batchErr.Unwrap()); e != a {
+		t.Errorf("expect %v unwrapped errors, got %v", e, a)
+	}
+	if !errors.Is(err, errThrottled) {
+		t.Errorf("expect error to wrap %v", errThrottled)
+	}
+	var asItemErr *batchItemError
+	if !errors.As(err, &asItemErr) {
+		t.Fatalf("expect error to wrap %T", asItemErr)
+	}
+	if e, a := "c", asItemErr.Item; e != a {
+		t.Errorf("expect %v item, got %v", e, a)
+	}
+
+	expectMsg := "2 batch item(s) failed, index 1: throttled; index 3: wrapped, item c failed"
+	if e, a := expectMsg, err.Error(); e != a {
+		t.Errorf("expect %q message, got %q", e, a)
+	}
+}
@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aws/smithy-go/middleware"
+)
+
+// ConcurrencyLimiter limits the number of operations that are invoked
+// concurrently. A limiter is shared by the operations whose stacks it is
+// added to with AddConcurrencyLimitMiddleware.
+type ConcurrencyLimiter struct {
+	tokens chan struct{}
+}
+
+// NewConcurrencyLimiter returns a ConcurrencyLimiter allowing at most max
+// operations to be invoked concurrently. If max is zero or less, nil is
+// returned, and operations are not limited.
+func NewConcurrencyLimiter(max int) *ConcurrencyLimiter {
+	if max <= 0 {
+		return nil
+	}
+	return &ConcurrencyLimiter{
+		tokens: make(chan struct{}, max),
+	}
+}
+
+// SharedConcurrencyLimiter is a ConcurrencyLimiter shared by the operations of
+// a client, created by the first operation that retrieves it. The zero value
+// is ready to use.
+type SharedConcurrencyLimiter struct {
+	once    sync.Once
+	limiter *ConcurrencyLimiter
+}
+
+// Get returns the shared ConcurrencyLimiter, creating it with
+// NewConcurrencyLimiter(max) on the first call. The max of later calls is
+// ignored. If s is nil, nil is returned, and operations are not limited.
+func (s *SharedConcurrencyLimiter) Get(max int) *ConcurrencyLimiter {
+	if s == nil {
+		return nil
+	}
+	s.once.Do(func() {
+		s.limiter = NewConcurrencyLimiter(max)
+	})
+	return s.limiter
+}
+
+// acquire blocks until the operation may be invoked, or the context is done.
+func (l *ConcurrencyLimiter) acquire(ctx context.Context) error {
+	select {
+	case l.tokens <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// concurrencyLimitHeldKey is the context key for the ConcurrencyLimiter held
+// by the operation the context was invoked with.
+type concurrencyLimitHeldKey struct{}
+
+// release allows another operation to be invoked.
+func (l *ConcurrencyLimiter) release() {
+	<-l.tokens
+}
+
+// AddConcurrencyLimitMiddleware adds a middleware to the front of the stack's
+// initialize step that waits until the limiter allows the operation to be
+// invoked, and releases the limiter once the operation has completed. If the
+// operation's context is done while waiting, the context's error is
+// returned. If the limiter is nil, the middleware is not added.
+//
+// Operations invoked by a middleware of an operation holding the limiter, such
+// as describing a resource before the request is sent, share the operation's
+// hold on the limiter instead of waiting, which could never succeed with a
+// limit of one.
+func AddConcurrencyLimitMiddleware(stack *middleware.Stack, limiter *ConcurrencyLimiter) error {
+	if limiter == nil {
+		return nil
+	}
+	return stack.Initialize.Add(&concurrencyLimit{limiter: limiter}, middleware.Before)
+}
+
+// concurrencyLimit limits the number of concurrent operations with a
+// ConcurrencyLimiter.
+type concurrencyLimit struct {
+	limiter *ConcurrencyLimiter
+}
+
+// ID returns the id of the middleware
+func (*concurrencyLimit) ID() string {
+	return "ConcurrencyLimit"
+}
+
+// HandleInitialize implements the InitializeMiddleware interface
+func (m *concurrencyLimit) HandleInitialize(
+	ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	if held, _ := ctx.Value(concurrencyLimitHeldKey{}).(*ConcurrencyLimiter); held == m.limiter {
+		return next.HandleInitialize(ctx, in)
+	}
+
+	if err := m.limiter.acquire(ctx); err != nil {
+		return out, metadata, err
+	}
+	defer m.limiter.release()
+
+	ctx = context.WithValue(ctx, concurrencyLimitHeldKey{}, m.limiter)
+	return next.HandleInitialize(ctx, in)
+}
@@ -0,0 +1,168 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+)
+
+func TestAddConcurrencyLimitMiddleware(t *testing.T) {
+	const max = 2
+
+	limiter := NewConcurrencyLimiter(max)
+	started := make(chan struct{})
+	unblock := make(chan struct{})
+
+	newHandler := func() middleware.Handler {
+		stack := middleware.NewStack("ExampleOperation", func() interface{} { return nil })
+		if err := AddConcurrencyLimitMiddleware(stack, limiter); err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		return middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, in interface{}) (
+			interface{}, middleware.Metadata, error,
+		) {
+			started <- struct{}{}
+			<-unblock
+			return nil, middleware.Metadata{}, nil
+		}), stack)
+	}
+
+	done := make(chan error, max+1)
+	for i := 0; i < max+1; i++ {
+		go func() {
+			_, _, err := newHandler().Handle(context.Background(), struct{}{})
+			done <- err
+		}()
+	}
+
+	for i := 0; i < max; i++ {
+		select {
+		case <-started:
+		case <-time.After(time.Second):
+			t.Fatalf("expect %v operations to start", max)
+		}
+	}
+
+	// The operation over the limit waits for one of the others to complete.
+	select {
+	case <-started:
+		t.Fatalf("expect operation over the limit to wait")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	unblock <- struct{}{}
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatalf("expect waiting operation to start once another completes")
+	}
+	close(unblock)
+
+	for i := 0; i < max+1; i++ {
+		if err := <-done; err != nil {
+			t.Errorf("expect no error, got %v", err)
+		}
+	}
+}
+
+func TestAddConcurrencyLimitMiddleware_ContextDone(t *testing.T) {
+	limiter := NewConcurrencyLimiter(1)
+
+	stack := middleware.NewStack("ExampleOperation", func() interface{} { return nil })
+	if err := AddConcurrencyLimitMiddleware(stack, limiter); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, in interface{}) (
+		interface{}, middleware.Metadata, error,
+	) {
+		return nil, middleware.Metadata{}, nil
+	}), stack)
+
+	if err := limiter.acquire(context.Background()); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer limiter.release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, _, err := handler.Handle(ctx, struct{}{})
+	if e, a := context.DeadlineExceeded, err; !errors.Is(a, e) {
+		t.Errorf("expect %v error, got %v", e, a)
+	}
+}
+
+func TestAddConcurrencyLimitMiddleware_Nested(t *testing.T) {
+	limiter := NewConcurrencyLimiter(1)
+
+	newHandler := func(fn func(context.Context) error) middleware.Handler {
+		stack := middleware.NewStack("ExampleOperation", func() interface{} { return nil })
+		if err := AddConcurrencyLimitMiddleware(stack, limiter); err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		return middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, in interface{}) (
+			interface{}, middleware.Metadata, error,
+		) {
+			return nil, middleware.Metadata{}, fn(ctx)
+		}), stack)
+	}
+
+	var nestedInvoked bool
+	nested := newHandler(func(context.Context) error {
+		nestedInvoked = true
+		return nil
+	})
+	outer := newHandler(func(ctx context.Context) error {
+		_, _, err := nested.Handle(ctx, struct{}{})
+		return err
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// The nested operation shares the outer operation's hold on the limiter.
+	if _, _, err := outer.Handle(ctx, struct{}{}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if !nestedInvoked {
+		t.Errorf("expect nested operation to be invoked")
+	}
+}
+
+func TestAddConcurrencyLimitMiddleware_Unlimited(t *testing.T) {
+	stack := middleware.NewStack("ExampleOperation", func() interface{} { return nil })
+	if err := AddConcurrencyLimitMiddleware(stack, NewConcurrencyLimiter(0)); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if _, ok := stack.Initialize.Get("ConcurrencyLimit"); ok {
+		t.Errorf("expect middleware not to be added")
+	}
+}
+
+func TestSharedConcurrencyLimiter(t *testing.T) {
+	var shared SharedConcurrencyLimiter
+
+	limiter := shared.Get(2)
+	if limiter == nil {
+		t.Fatalf("expect limiter")
+	}
+	if e, a := 2, cap(limiter.tokens); e != a {
+		t.Errorf("expect %v max, got %v", e, a)
+	}
+	if e, a := limiter, shared.Get(5); e != a {
+		t.Errorf("expect the limiter to be shared")
+	}
+
+	var unlimited SharedConcurrencyLimiter
+	if limiter := unlimited.Get(0); limiter != nil {
+		t.Errorf("expect no limiter, got %v", limiter)
+	}
+
+	var nilShared *SharedConcurrencyLimiter
+	if limiter := nilShared.Get(2); limiter != nil {
+		t.Errorf("expect no limiter, got %v", limiter)
+	}
+}
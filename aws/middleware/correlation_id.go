@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// DefaultCorrelationIDHeader is the default header the correlation ID is sent
+// in by the middleware added with AddCorrelationIDMiddleware.
+const DefaultCorrelationIDHeader = "X-Correlation-Id"
+
+type correlationIDKey struct{}
+
+// WithCorrelationID returns a context with the correlation ID, such as the ID
+// of the trace or request being served, that operations invoked with the
+// context send in their requests' correlation ID header.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// GetCorrelationID returns the correlation ID of the context set by
+// WithCorrelationID, or an empty string if none is set.
+func GetCorrelationID(ctx context.Context) string {
+	v, _ := ctx.Value(correlationIDKey{}).(string)
+	return v
+}
+
+// AddCorrelationIDMiddleware adds a middleware to the stack's build step that
+// sets the header of the operation's requests to the correlation ID of the
+// operation's context, if the context has one. If header is empty,
+// DefaultCorrelationIDHeader is used.
+func AddCorrelationIDMiddleware(stack *middleware.Stack, header string) error {
+	if len(header) == 0 {
+		header = DefaultCorrelationIDHeader
+	}
+	return stack.Build.Add(&correlationID{header: header}, middleware.After)
+}
+
+// correlationID sets the correlation ID header of requests.
+type correlationID struct {
+	header string
+}
+
+// ID returns the id of the middleware
+func (*correlationID) ID() string {
+	return "CorrelationID"
+}
+
+// HandleBuild implements the BuildMiddleware interface
+func (m *correlationID) HandleBuild(
+	ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler,
+) (
+	out middleware.BuildOutput, metadata middleware.Metadata, err error,
+) {
+	id := GetCorrelationID(ctx)
+	if len(id) == 0 {
+		return next.HandleBuild(ctx, in)
+	}
+
+	req, ok := in.Request.(*smithyhttp.Request)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown transport type %T", in.Request)
+	}
+	req.Header.Set(m.header, id)
+
+	return next.HandleBuild(ctx, in)
+}
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestAddCorrelationIDMiddleware(t *testing.T) {
+	cases := map[string]struct {
+		Header        string
+		CorrelationID string
+		ExpectHeader  string
+	}{
+		"no correlation id": {},
+		"default header": {
+			CorrelationID: "trace-1234",
+			ExpectHeader:  DefaultCorrelationIDHeader,
+		},
+		"custom header": {
+			Header:        "X-Request-Trace",
+			CorrelationID: "trace-1234",
+			ExpectHeader:  "X-Request-Trace",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			stack := middleware.NewStack("ExampleOperation", smithyhttp.NewStackRequest)
+			if err := AddCorrelationIDMiddleware(stack, c.Header); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			var req *smithyhttp.Request
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, in interface{}) (
+				interface{}, middleware.Metadata, error,
+			) {
+				req = in.(*smithyhttp.Request)
+				return nil, middleware.Metadata{}, nil
+			}), stack)
+
+			ctx := context.Background()
+			if len(c.CorrelationID) != 0 {
+				ctx = WithCorrelationID(ctx, c.CorrelationID)
+			}
+			if _, _, err := handler.Handle(ctx, struct{}{}); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if len(c.ExpectHeader) == 0 {
+				for _, h := range []string{DefaultCorrelationIDHeader, "X-Request-Trace"} {
+					if v := req.Header.Get(h); len(v) != 0 {
+						t.Errorf("expect no %v header, got %v", h, v)
+					}
+				}
+				return
+			}
+			if e, a := c.CorrelationID, req.Header.Get(c.ExpectHeader); e != a {
+				t.Errorf("expect %v correlation id, got %v", e, a)
+			}
+		})
+	}
+}
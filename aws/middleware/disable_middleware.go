@@ -0,0 +1,113 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/aws/smithy-go/middleware"
+)
+
+// removableMiddlewareIDs are the middleware that can be disabled for a call
+// by DisableMiddleware. The operation can still be invoked without them, unlike
+// middleware such as signing, endpoint resolution, and retries.
+var removableMiddlewareIDs = map[string]struct{}{
+	"EndpointHostPrefix":                  {},
+	"ValidateResponseCRC32Checksum":       {},
+	"DynamoDB:ResponseChecksumValidation": {},
+}
+
+type disabledMiddlewareKey struct{}
+
+// DisableMiddleware returns a context that disables the middleware with the
+// ID for operations invoked with the context, such as to skip the
+// "EndpointHostPrefix" middleware for a single call. Middleware already
+// disabled by the context remain disabled.
+//
+// Only the host prefix and response checksum validation middleware guarded by
+// AddDisableMiddlewareGuards can be disabled. Other IDs are ignored.
+//
+//    ctx = middleware.DisableMiddleware(ctx, "EndpointHostPrefix")
+//    out, err := client.ListGateways(ctx, params)
+func DisableMiddleware(ctx context.Context, id string) context.Context {
+	disabled, _ := ctx.Value(disabledMiddlewareKey{}).(map[string]struct{})
+
+	ids := make(map[string]struct{}, len(disabled)+1)
+	for k := range disabled {
+		ids[k] = struct{}{}
+	}
+	ids[id] = struct{}{}
+
+	return context.WithValue(ctx, disabledMiddlewareKey{}, ids)
+}
+
+// IsMiddlewareDisabled returns whether the middleware with the ID has been
+// disabled for the context by DisableMiddleware.
+func IsMiddlewareDisabled(ctx context.Context, id string) bool {
+	disabled, _ := ctx.Value(disabledMiddlewareKey{}).(map[string]struct{})
+	_, ok := disabled[id]
+	return ok
+}
+
+// AddDisableMiddlewareGuards wraps the removable middleware of the stack, such
+// as "EndpointHostPrefix", with a guard that skips the middleware when it has
+// been disabled for the context by DisableMiddleware. Other middleware are not
+// wrapped. The guards must be added after the operation's other middleware
+// have been added to the stack.
+func AddDisableMiddlewareGuards(stack *middleware.Stack) error {
+	for id := range removableMiddlewareIDs {
+		if m, ok := stack.Serialize.Get(id); ok {
+			if _, err := stack.Serialize.Swap(id, &serializeGuard{next: m}); err != nil {
+				return err
+			}
+		}
+		if m, ok := stack.Deserialize.Get(id); ok {
+			if _, err := stack.Deserialize.Swap(id, &deserializeGuard{next: m}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// serializeGuard skips the serialize middleware when it is disabled.
+type serializeGuard struct {
+	next middleware.SerializeMiddleware
+}
+
+// ID returns the id of the middleware
+func (m *serializeGuard) ID() string {
+	return m.next.ID()
+}
+
+// HandleSerialize implements the SerializeMiddleware interface
+func (m *serializeGuard) HandleSerialize(
+	ctx context.Context, in middleware.SerializeInput, next middleware.SerializeHandler,
+) (
+	out middleware.SerializeOutput, metadata middleware.Metadata, err error,
+) {
+	if IsMiddlewareDisabled(ctx, m.ID()) {
+		return next.HandleSerialize(ctx, in)
+	}
+	return m.next.HandleSerialize(ctx, in, next)
+}
+
+// deserializeGuard skips the deserialize middleware when it is disabled.
+type deserializeGuard struct {
+	next middleware.DeserializeMiddleware
+}
+
+// ID returns the id of the middleware
+func (m *deserializeGuard) ID() string {
+	return m.next.ID()
+}
+
+// HandleDeserialize implements the DeserializeMiddleware interface
+func (m *deserializeGuard) HandleDeserialize(
+	ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler,
+) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	if IsMiddlewareDisabled(ctx, m.ID()) {
+		return next.HandleDeserialize(ctx, in)
+	}
+	return m.next.HandleDeserialize(ctx, in, next)
+}
@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aws/smithy-go/middleware"
+)
+
+// recordMiddleware records its ID each time it is invoked.
+type recordMiddleware struct {
+	id      string
+	invoked *[]string
+}
+
+func (m *recordMiddleware) ID() string { return m.id }
+
+func (m *recordMiddleware) HandleSerialize(
+	ctx context.Context, in middleware.SerializeInput, next middleware.SerializeHandler,
+) (
+	out middleware.SerializeOutput, metadata middleware.Metadata, err error,
+) {
+	*m.invoked = append(*m.invoked, m.id)
+	return next.HandleSerialize(ctx, in)
+}
+
+func TestAddDisableMiddlewareGuards(t *testing.T) {
+	ids := []string{"Signing", "EndpointHostPrefix", "OperationSerializer", "ValidateResponseCRC32Checksum"}
+
+	cases := map[string]struct {
+		Disable       []string
+		ExpectInvoked []string
+	}{
+		"none disabled": {
+			ExpectInvoked: ids,
+		},
+		"one disabled": {
+			Disable:       []string{"EndpointHostPrefix"},
+			ExpectInvoked: []string{"Signing", "OperationSerializer", "ValidateResponseCRC32Checksum"},
+		},
+		"multiple disabled": {
+			Disable:       []string{"EndpointHostPrefix", "ValidateResponseCRC32Checksum"},
+			ExpectInvoked: []string{"Signing", "OperationSerializer"},
+		},
+		"not removable": {
+			Disable:       []string{"Signing", "OperationSerializer"},
+			ExpectInvoked: ids,
+		},
+		"unknown": {
+			Disable:       []string{"Unknown"},
+			ExpectInvoked: ids,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var invoked []string
+			stack := middleware.NewStack("ExampleOperation", func() interface{} { return nil })
+			for _, id := range ids {
+				if err := stack.Serialize.Add(&recordMiddleware{id: id, invoked: &invoked}, middleware.After); err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+			}
+			if err := AddDisableMiddlewareGuards(stack); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := ids, stack.Serialize.List(); !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v middleware, got %v", e, a)
+			}
+
+			ctx := context.Background()
+			for _, id := range c.Disable {
+				ctx = DisableMiddleware(ctx, id)
+			}
+
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, in interface{}) (
+				interface{}, middleware.Metadata, error,
+			) {
+				return nil, middleware.Metadata{}, nil
+			}), stack)
+			if _, _, err := handler.Handle(ctx, struct{}{}); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.ExpectInvoked, invoked; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v invoked, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestDisableMiddleware(t *testing.T) {
+	ctx := DisableMiddleware(context.Background(), "First")
+	second := DisableMiddleware(ctx, "Second")
+
+	if !IsMiddlewareDisabled(second, "First") || !IsMiddlewareDisabled(second, "Second") {
+		t.Errorf("expect both middleware disabled")
+	}
+	if IsMiddlewareDisabled(ctx, "Second") {
+		t.Errorf("expect parent context not to be modified")
+	}
+	if IsMiddlewareDisabled(context.Background(), "First") {
+		t.Errorf("expect no middleware disabled")
+	}
+}
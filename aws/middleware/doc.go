@@ -0,0 +1,15 @@
+// Package middleware provides transport agnostic middleware for decorating SDK
+// service client operation stacks.
+//
+// Debugging the Operation Stack
+//
+// The middleware of an operation's stack, and the order they will be invoked
+// in, can be inspected with the stack's String method. The ID of each
+// middleware is listed under the step it was added to, e.g. by appending an API
+// option that logs the stack after the operation's middleware have been added.
+//
+//    o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+//        log.Println(stack.String())
+//        return nil
+//    })
+package middleware
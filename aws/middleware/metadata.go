@@ -47,6 +47,7 @@ type (
 	regionKey        struct{}
 	operationNameKey struct{}
 	partitionIDKey   struct{}
+	attemptNumberKey struct{}
 )
 
 // GetServiceID retrieves the service id from the context.
@@ -103,6 +104,19 @@ func GetPartitionID(ctx context.Context) string {
 	return v
 }
 
+// GetAttemptNumber retrieves the number of the request attempt from the
+// context, starting at 1 for the first attempt. The attempt number is set by
+// the retry middleware before each attempt, and is only available to
+// middleware after the retry middleware in the stack. Returns zero if the
+// attempt number is not set.
+//
+// Scoped to stack values. Use github.com/aws/smithy-go/middleware#ClearStackValues
+// to clear all stack values.
+func GetAttemptNumber(ctx context.Context) int {
+	v, _ := middleware.GetStackValue(ctx, attemptNumberKey{}).(int)
+	return v
+}
+
 // SetSigningName set or modifies the signing name on the context.
 //
 // Scoped to stack values. Use github.com/aws/smithy-go/middleware#ClearStackValues
@@ -150,3 +164,11 @@ func setOperationName(ctx context.Context, value string) context.Context {
 func SetPartitionID(ctx context.Context, value string) context.Context {
 	return middleware.WithStackValue(ctx, partitionIDKey{}, value)
 }
+
+// SetAttemptNumber sets the number of the request attempt on the context.
+//
+// Scoped to stack values. Use github.com/aws/smithy-go/middleware#ClearStackValues
+// to clear all stack values.
+func SetAttemptNumber(ctx context.Context, value int) context.Context {
+	return middleware.WithStackValue(ctx, attemptNumberKey{}, value)
+}
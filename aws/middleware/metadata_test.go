@@ -38,3 +38,15 @@ func TestServiceMetadataProvider(t *testing.T) {
 		t.Errorf("expected no error, got %v", err)
 	}
 }
+
+func TestAttemptNumber(t *testing.T) {
+	ctx := context.Background()
+	if e, a := 0, GetAttemptNumber(ctx); e != a {
+		t.Errorf("expect %v attempt number, got %v", e, a)
+	}
+
+	ctx = SetAttemptNumber(ctx, 2)
+	if e, a := 2, GetAttemptNumber(ctx); e != a {
+		t.Errorf("expect %v attempt number, got %v", e, a)
+	}
+}
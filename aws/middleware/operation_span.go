@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/aws/smithy-go/middleware"
+)
+
+// AddOperationSpanMiddleware adds a middleware to the front of the stack's
+// initialize step that calls startSpan with the name of the operation before
+// the operation is invoked. The context returned by startSpan is used for the
+// rest of the operation, and the returned finish function is called with the
+// operation's final error, or nil, once the operation has completed. If
+// startSpan is nil, the middleware is not added.
+//
+// The middleware allows an operation's span to be started and ended with a
+// tracer without the SDK depending on the tracer's package.
+func AddOperationSpanMiddleware(stack *middleware.Stack, startSpan func(ctx context.Context, operation string) (context.Context, func(err error))) error {
+	if startSpan == nil {
+		return nil
+	}
+	return stack.Initialize.Add(&operationSpan{
+		operation: stack.ID(),
+		startSpan: startSpan,
+	}, middleware.Before)
+}
+
+// operationSpan starts a span for the operation, and finishes it when the
+// operation returns.
+type operationSpan struct {
+	operation string
+	startSpan func(context.Context, string) (context.Context, func(error))
+}
+
+// ID returns the id of the middleware
+func (*operationSpan) ID() string {
+	return "OperationSpan"
+}
+
+// HandleInitialize implements the InitializeMiddleware interface
+func (m *operationSpan) HandleInitialize(
+	ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	ctx, finish := m.startSpan(ctx, m.operation)
+	if finish != nil {
+		defer func() { finish(err) }()
+	}
+	return next.HandleInitialize(ctx, in)
+}
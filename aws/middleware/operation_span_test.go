@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/smithy-go/middleware"
+)
+
+type spanContextKey struct{}
+
+func TestAddOperationSpanMiddleware(t *testing.T) {
+	cases := map[string]struct {
+		Err error
+	}{
+		"success": {},
+		"error":   {Err: errors.New("operation error")},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var started, finished []string
+			var finishErr error
+			startSpan := func(ctx context.Context, operation string) (context.Context, func(error)) {
+				started = append(started, operation)
+				return context.WithValue(ctx, spanContextKey{}, operation), func(err error) {
+					finished = append(finished, operation)
+					finishErr = err
+				}
+			}
+
+			stack := middleware.NewStack("ExampleOperation", func() interface{} { return nil })
+			if err := AddOperationSpanMiddleware(stack, startSpan); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, in interface{}) (
+				interface{}, middleware.Metadata, error,
+			) {
+				if e, a := "ExampleOperation", ctx.Value(spanContextKey{}); e != a {
+					t.Errorf("expect %v span context, got %v", e, a)
+				}
+				if len(finished) != 0 {
+					t.Errorf("expect span not finished before handler returns")
+				}
+				return nil, middleware.Metadata{}, c.Err
+			}), stack)
+
+			_, _, err := handler.Handle(context.Background(), struct{}{})
+			if e, a := c.Err, err; e != a {
+				t.Errorf("expect %v error, got %v", e, a)
+			}
+			if e, a := []string{"ExampleOperation"}, started; len(a) != 1 || e[0] != a[0] {
+				t.Errorf("expect %v started, got %v", e, a)
+			}
+			if e, a := []string{"ExampleOperation"}, finished; len(a) != 1 || e[0] != a[0] {
+				t.Errorf("expect %v finished, got %v", e, a)
+			}
+			if e, a := c.Err, finishErr; e != a {
+				t.Errorf("expect %v finish error, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestAddOperationSpanMiddleware_Nil(t *testing.T) {
+	stack := middleware.NewStack("ExampleOperation", func() interface{} { return nil })
+	if err := AddOperationSpanMiddleware(stack, nil); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if _, ok := stack.Initialize.Get("OperationSpan"); ok {
+		t.Errorf("expect middleware not to be added")
+	}
+}
@@ -167,6 +167,42 @@ func AddSDKAgentKeyValue(keyType SDKAgentKeyType, key, value string) func(*middl
 	}
 }
 
+// AddUserAgentAppID retrieves a requestUserAgent from the provided stack, or
+// initializes one, and appends the application identifier to the User-Agent
+// string as "app/<appID>". Characters in appID that are not valid for a
+// User-Agent token are replaced with "-". No User-Agent component is added if
+// appID is empty.
+func AddUserAgentAppID(appID string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		if len(appID) == 0 {
+			return nil
+		}
+		requestUserAgent, err := getOrAddRequestUserAgent(stack)
+		if err != nil {
+			return err
+		}
+		requestUserAgent.AddUserAgentKey(ApplicationIdentifier.string() + "/" + sanitizeUserAgentToken(appID))
+		return nil
+	}
+}
+
+// sanitizeUserAgentToken replaces any characters of v that are not valid
+// within a User-Agent product token with "-".
+//
+// https://tools.ietf.org/html/rfc7230#section-3.2.6
+func sanitizeUserAgentToken(v string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case strings.ContainsRune("!#$%&'*+-.^_`|~", r):
+			return r
+		default:
+			return '-'
+		}
+	}, v)
+}
+
 // AddRequestUserAgentMiddleware registers a requestUserAgent middleware on the stack if not present.
 func AddRequestUserAgentMiddleware(stack *middleware.Stack) error {
 	_, err := getOrAddRequestUserAgent(stack)
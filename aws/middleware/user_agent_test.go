@@ -250,3 +250,51 @@ func TestAddUserAgentKeyValue_AddToStack(t *testing.T) {
 		t.Error("User-Agent did not match expected")
 	}
 }
+
+func TestAddUserAgentAppID(t *testing.T) {
+	cases := map[string]struct {
+		AppID  string
+		Expect string
+	}{
+		"no app id": {
+			Expect: expectedAgent,
+		},
+		"valid app id": {
+			AppID:  "my-app_1.0",
+			Expect: expectedAgent + " app/my-app_1.0",
+		},
+		"invalid characters": {
+			AppID:  "my app/(v1)",
+			Expect: expectedAgent + " app/my-app--v1-",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			restoreEnv := clearEnv()
+			defer restoreEnv()
+
+			stack := middleware.NewStack("testStack", smithyhttp.NewStackRequest)
+			if err := AddRequestUserAgentMiddleware(stack); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if err := AddUserAgentAppID(c.AppID)(stack); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			b, _ := stack.Build.Get((*requestUserAgent)(nil).ID())
+			bi := middleware.BuildInput{Request: &smithyhttp.Request{Request: &http.Request{Header: map[string][]string{}}}}
+			_, _, err := b.HandleBuild(context.Background(), bi, middleware.BuildHandlerFunc(func(ctx context.Context, input middleware.BuildInput) (o middleware.BuildOutput, m middleware.Metadata, err error) {
+				return o, m, err
+			}))
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			ua := bi.Request.(*smithyhttp.Request).Header.Get("User-Agent")
+			if e, a := c.Expect, ua; e != a {
+				t.Errorf("expect %v User-Agent, got %v", e, a)
+			}
+		})
+	}
+}
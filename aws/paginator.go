@@ -0,0 +1,42 @@
+//go:build go1.18
+// +build go1.18
+
+package aws
+
+import "context"
+
+// Paginator is a paginator of an operation's output pages of type T, such as
+// the paginators of the service clients. O is the type of the functional
+// options of the service client's operations.
+type Paginator[T, O any] interface {
+	HasMorePages() bool
+	NextPage(context.Context, ...O) (T, error)
+}
+
+// CollectPages retrieves every page of the paginator, and returns the items
+// extracted from each page by extract as a single slice, in page order. If a
+// page cannot be retrieved the items collected so far are returned with the
+// error.
+//
+// All items are held in memory until every page has been retrieved. Iterate
+// over the paginator's pages directly instead for operations that may return
+// a large number of items.
+//
+// The type arguments are inferred from the paginator with Go 1.21 or later.
+//
+//    databases, err := aws.CollectPages(ctx,
+//        timestreamwrite.NewListDatabasesPaginator(client, params),
+//        func(page *timestreamwrite.ListDatabasesOutput) []types.Database {
+//            return page.Databases
+//        })
+func CollectPages[T, O, Item any](ctx context.Context, p Paginator[T, O], extract func(T) []Item, optFns ...O) ([]Item, error) {
+	var items []Item
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx, optFns...)
+		if err != nil {
+			return items, err
+		}
+		items = append(items, extract(page)...)
+	}
+	return items, nil
+}
@@ -0,0 +1,67 @@
+//go:build go1.21
+// +build go1.21
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type mockPaginatorOptions struct{}
+
+type mockPaginator struct {
+	pages [][]string
+	err   error
+	next  int
+}
+
+func (p *mockPaginator) HasMorePages() bool {
+	return p.next < len(p.pages)
+}
+
+func (p *mockPaginator) NextPage(ctx context.Context, optFns ...func(*mockPaginatorOptions)) ([]string, error) {
+	if p.err != nil && p.next == 1 {
+		return nil, p.err
+	}
+	page := p.pages[p.next]
+	p.next++
+	return page, nil
+}
+
+func TestCollectPages(t *testing.T) {
+	cases := map[string]struct {
+		Pages       [][]string
+		Err         error
+		ExpectItems []string
+	}{
+		"no pages": {},
+		"multiple pages": {
+			Pages:       [][]string{{"a", "b"}, {}, {"c"}},
+			ExpectItems: []string{"a", "b", "c"},
+		},
+		"page error": {
+			Pages:       [][]string{{"a", "b"}, {"c"}},
+			Err:         errors.New("page error"),
+			ExpectItems: []string{"a", "b"},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := &mockPaginator{pages: c.Pages, err: c.Err}
+
+			items, err := CollectPages(context.Background(), p, func(page []string) []string {
+				return page
+			})
+			if e, a := c.Err, err; e != a {
+				t.Errorf("expect %v error, got %v", e, a)
+			}
+			if e, a := c.ExpectItems, items; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v items, got %v", e, a)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// RecordingClientOptions provides the options for the RecordingClient.
+type RecordingClientOptions struct {
+	// The HTTP client requests are sent with when they have not been
+	// recorded. Defaults to a new http.Client.
+	Client HTTPClient
+
+	// If set, requests that have not been recorded fail with a
+	// *RecordingNotFoundError instead of being sent, such as when replaying
+	// recordings in continuous integration.
+	ReplayOnly bool
+}
+
+// RecordingNotFoundError is returned by a ReplayOnly RecordingClient for a
+// request that has not been recorded.
+type RecordingNotFoundError struct {
+	Operation string
+	Path      string
+}
+
+func (e *RecordingNotFoundError) Error() string {
+	return fmt.Sprintf("no recording of %s request found at %s", e.Operation, e.Path)
+}
+
+// RecordingClient is an HTTPClient that records the responses to requests in
+// a directory the first time the requests are sent, and replays the recorded
+// responses for the same requests afterwards without sending them. Use the
+// RecordingClient as the HTTPClient of a service client to write
+// deterministic tests of code calling AWS services, without calling the live
+// services once the responses have been recorded.
+//
+// Recordings are keyed by the request's operation, and a hash of the
+// request's method, path, and normalized body. The operation is taken from
+// the X-Amz-Target header of JSON protocol requests, or the Action parameter
+// of query protocol requests, falling back to the method and path of the
+// request. JSON and form encoded bodies are normalized so the order of their
+// members does not change the key. Requests whose bodies vary between runs,
+// such as with generated idempotency tokens, must set those members
+// explicitly to be replayed.
+//
+//    client := timestreamwrite.New(timestreamwrite.Options{
+//        Region:     "us-west-2",
+//        HTTPClient: aws.NewRecordingClient("testdata/recordings"),
+//    })
+type RecordingClient struct {
+	dir     string
+	options RecordingClientOptions
+}
+
+// NewRecordingClient returns a RecordingClient recording responses in, and
+// replaying them from, the directory.
+func NewRecordingClient(dir string, optFns ...func(*RecordingClientOptions)) *RecordingClient {
+	var options RecordingClientOptions
+	for _, fn := range optFns {
+		fn(&options)
+	}
+	if options.Client == nil {
+		options.Client = &http.Client{}
+	}
+
+	return &RecordingClient{
+		dir:     dir,
+		options: options,
+	}
+}
+
+// recordedResponse is the format of a recording file.
+type recordedResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// Do replays the recorded response to the request, or sends the request and
+// records its response if it has not been recorded.
+func (c *RecordingClient) Do(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body, %w", err)
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	operation := recordingOperation(req, body)
+	path := filepath.Join(c.dir, operation+"-"+recordingKey(req, body)+".json")
+
+	if b, err := ioutil.ReadFile(path); err == nil {
+		var recorded recordedResponse
+		if err := json.Unmarshal(b, &recorded); err != nil {
+			return nil, fmt.Errorf("failed to decode recording %s, %w", path, err)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+			StatusCode:    recorded.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        recorded.Header,
+			Body:          ioutil.NopCloser(bytes.NewReader(recorded.Body)),
+			ContentLength: int64(len(recorded.Body)),
+			Request:       req,
+		}, nil
+	} else if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read recording %s, %w", path, err)
+	}
+
+	if c.options.ReplayOnly {
+		return nil, &RecordingNotFoundError{Operation: operation, Path: path}
+	}
+
+	resp, err := c.options.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body, %w", err)
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	b, err := json.MarshalIndent(recordedResponse{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       respBody,
+	}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode recording, %w", err)
+	}
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create recording directory %s, %w", c.dir, err)
+	}
+	if err := ioutil.WriteFile(path, b, 0644); err != nil {
+		return nil, fmt.Errorf("failed to write recording %s, %w", path, err)
+	}
+
+	return resp, nil
+}
+
+var invalidRecordingNameChars = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+
+// recordingOperation returns the name of the request's operation.
+func recordingOperation(req *http.Request, body []byte) string {
+	if v := req.Header.Get("X-Amz-Target"); len(v) != 0 {
+		return v[strings.LastIndex(v, ".")+1:]
+	}
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(body)); err == nil && len(values.Get("Action")) != 0 {
+			return values.Get("Action")
+		}
+	}
+	return strings.Trim(invalidRecordingNameChars.ReplaceAllString(req.Method+req.URL.Path, "_"), "_")
+}
+
+// recordingKey returns the hash of the request's method, path, and normalized
+// body.
+func recordingKey(req *http.Request, body []byte) string {
+	var doc interface{}
+	if err := json.Unmarshal(body, &doc); err == nil {
+		// Maps are marshaled with sorted keys.
+		body, _ = json.Marshal(doc)
+	} else if strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			body = []byte(values.Encode())
+		}
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(req.Method + " " + req.URL.EscapedPath() + "?" + req.URL.Query().Encode() + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))[:16]
+}
@@ -0,0 +1,152 @@
+package aws
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type recordingTestClient struct {
+	calls int
+}
+
+func (c *recordingTestClient) Do(req *http.Request) (*http.Response, error) {
+	c.calls++
+	body, _ := ioutil.ReadAll(req.Body)
+	return &http.Response{
+		StatusCode: 200,
+		Header:     http.Header{"X-Amzn-Requestid": []string{"request-id"}},
+		Body:       ioutil.NopCloser(strings.NewReader(`{"Echo":` + string(body) + `}`)),
+	}, nil
+}
+
+func newRecordingTestRequest(t *testing.T, target, body string) *http.Request {
+	req, err := http.NewRequest("POST", "https://example.amazonaws.com/", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	req.Header.Set("X-Amz-Target", target)
+	req.Header.Set("Content-Type", "application/x-amz-json-1.0")
+	return req
+}
+
+func TestRecordingClient(t *testing.T) {
+	dir := t.TempDir()
+	sender := &recordingTestClient{}
+	client := NewRecordingClient(dir, func(o *RecordingClientOptions) {
+		o.Client = sender
+	})
+
+	do := func(target, body string) string {
+		resp, err := client.Do(newRecordingTestRequest(t, target, body))
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		b, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		if e, a := "request-id", resp.Header.Get("X-Amzn-Requestid"); e != a {
+			t.Errorf("expect %v request id, got %v", e, a)
+		}
+		return string(b)
+	}
+
+	// Recorded on first use.
+	if e, a := `{"Echo":{"A":"1","B":"2"}}`, do("Example.Operation", `{"A":"1","B":"2"}`); e != a {
+		t.Errorf("expect %v response, got %v", e, a)
+	}
+	if e, a := 1, sender.calls; e != a {
+		t.Errorf("expect %v requests sent, got %v", e, a)
+	}
+
+	// Replayed regardless of member order.
+	if e, a := `{"Echo":{"A":"1","B":"2"}}`, do("Example.Operation", `{"B":"2","A":"1"}`); e != a {
+		t.Errorf("expect %v response, got %v", e, a)
+	}
+	if e, a := 1, sender.calls; e != a {
+		t.Errorf("expect %v requests sent, got %v", e, a)
+	}
+
+	// A different body is recorded separately.
+	if e, a := `{"Echo":{"A":"3"}}`, do("Example.Operation", `{"A":"3"}`); e != a {
+		t.Errorf("expect %v response, got %v", e, a)
+	}
+	if e, a := 2, sender.calls; e != a {
+		t.Errorf("expect %v requests sent, got %v", e, a)
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 2, len(files); e != a {
+		t.Fatalf("expect %v recordings, got %v", e, a)
+	}
+	for _, f := range files {
+		if !strings.HasPrefix(f.Name(), "Operation-") {
+			t.Errorf("expect recording keyed by operation, got %v", f.Name())
+		}
+	}
+}
+
+func TestRecordingClient_ReplayOnly(t *testing.T) {
+	sender := &recordingTestClient{}
+	client := NewRecordingClient(t.TempDir(), func(o *RecordingClientOptions) {
+		o.Client = sender
+		o.ReplayOnly = true
+	})
+
+	_, err := client.Do(newRecordingTestRequest(t, "Example.Operation", `{}`))
+	var notFound *RecordingNotFoundError
+	if !errors.As(err, &notFound) {
+		t.Fatalf("expect %T error, got %v", notFound, err)
+	}
+	if e, a := "Operation", notFound.Operation; e != a {
+		t.Errorf("expect %v operation, got %v", e, a)
+	}
+	if e, a := 0, sender.calls; e != a {
+		t.Errorf("expect %v requests sent, got %v", e, a)
+	}
+}
+
+func TestRecordingOperation(t *testing.T) {
+	cases := map[string]struct {
+		Method, URL string
+		Header      http.Header
+		Body        string
+		Expect      string
+	}{
+		"json target": {
+			Method: "POST", URL: "https://ingest.timestream.us-west-2.amazonaws.com/",
+			Header: http.Header{"X-Amz-Target": []string{"Timestream_20181101.DescribeDatabase"}},
+			Expect: "DescribeDatabase",
+		},
+		"query action": {
+			Method: "POST", URL: "https://ec2.us-west-2.amazonaws.com/",
+			Header: http.Header{"Content-Type": []string{"application/x-www-form-urlencoded"}},
+			Body:   "Action=DescribeVpcs&Version=2016-11-15",
+			Expect: "DescribeVpcs",
+		},
+		"rest path": {
+			Method: "GET", URL: "https://elasticfilesystem.us-west-2.amazonaws.com/2015-02-01/file-systems/fs-1234",
+			Header: http.Header{},
+			Expect: "GET_2015-02-01_file-systems_fs-1234",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			req, err := http.NewRequest(c.Method, c.URL, nil)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			req.Header = c.Header
+			if e, a := c.Expect, recordingOperation(req, []byte(c.Body)); e != a {
+				t.Errorf("expect %v operation, got %v", e, a)
+			}
+		})
+	}
+}
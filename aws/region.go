@@ -0,0 +1,26 @@
+package aws
+
+import (
+	"context"
+	"os"
+)
+
+// ResolveRegion returns the AWS region configured by the environment, from
+// the AWS_REGION environment variable, or the AWS_DEFAULT_REGION environment
+// variable if AWS_REGION is not set. If neither is set a *MissingRegionError
+// is returned, so client constructors can fail fast instead of when their
+// first operation is invoked.
+//
+//    region, err := aws.ResolveRegion(ctx)
+//    if err != nil {
+//        return err
+//    }
+//    client := sso.New(sso.Options{Region: region})
+func ResolveRegion(ctx context.Context) (string, error) {
+	for _, name := range []string{"AWS_REGION", "AWS_DEFAULT_REGION"} {
+		if v := os.Getenv(name); len(v) != 0 {
+			return v, nil
+		}
+	}
+	return "", &MissingRegionError{}
+}
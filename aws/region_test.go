@@ -0,0 +1,70 @@
+package aws_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting"
+)
+
+func TestResolveRegion(t *testing.T) {
+	cases := map[string]struct {
+		Env          map[string]string
+		ExpectRegion string
+		ExpectErr    bool
+	}{
+		"AWS_REGION": {
+			Env:          map[string]string{"AWS_REGION": "us-west-2"},
+			ExpectRegion: "us-west-2",
+		},
+		"AWS_DEFAULT_REGION": {
+			Env:          map[string]string{"AWS_DEFAULT_REGION": "eu-west-1"},
+			ExpectRegion: "eu-west-1",
+		},
+		"AWS_REGION precedence": {
+			Env: map[string]string{
+				"AWS_REGION":         "us-west-2",
+				"AWS_DEFAULT_REGION": "eu-west-1",
+			},
+			ExpectRegion: "us-west-2",
+		},
+		"empty AWS_REGION": {
+			Env: map[string]string{
+				"AWS_REGION":         "",
+				"AWS_DEFAULT_REGION": "eu-west-1",
+			},
+			ExpectRegion: "eu-west-1",
+		},
+		"unset": {
+			ExpectErr: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			restoreEnv := awstesting.StashEnv()
+			defer awstesting.PopEnv(restoreEnv)
+			for k, v := range c.Env {
+				os.Setenv(k, v)
+			}
+
+			region, err := aws.ResolveRegion(context.Background())
+			if c.ExpectErr {
+				var missing *aws.MissingRegionError
+				if !errors.As(err, &missing) {
+					t.Fatalf("expect %T error, got %v", missing, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectRegion, region; e != a {
+				t.Errorf("expect %v region, got %v", e, a)
+			}
+		})
+	}
+}
@@ -80,6 +80,7 @@ func (r Attempt) HandleFinalize(ctx context.Context, in smithymiddle.FinalizeInp
 			MaxAttempts:      maxAttempts,
 			AttemptClockSkew: attemptClockSkew,
 		})
+		attemptCtx = awsmiddle.SetAttemptNumber(attemptCtx, attemptNum)
 
 		var attemptResult AttemptResult
 
@@ -271,3 +272,25 @@ func AddRetryMiddlewares(stack *smithymiddle.Stack, options AddRetryMiddlewaresO
 	}
 	return nil
 }
+
+// AddRetryTransientNetworkErrors wraps the Retryer of the stack's Retry
+// middleware, added by AddRetryMiddlewares, to also retry transient network
+// errors. See AddWithTransientNetworkErrors.
+//
+// Only add to the stacks of idempotent operations, since the failed request
+// may have been received by the service.
+func AddRetryTransientNetworkErrors(stack *smithymiddle.Stack) error {
+	m, ok := stack.Finalize.Get((&Attempt{}).ID())
+	if !ok {
+		return fmt.Errorf("retry middleware not found in stack")
+	}
+	attempt, ok := m.(*Attempt)
+	if !ok {
+		return fmt.Errorf("expect retry middleware to be %T, got %T", attempt, m)
+	}
+
+	wrapped := *attempt
+	wrapped.retryer = AddWithTransientNetworkErrors(attempt.retryer)
+	_, err := stack.Finalize.Swap(wrapped.ID(), &wrapped)
+	return err
+}
@@ -3,6 +3,7 @@ package retry
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"reflect"
 	"strconv"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddle "github.com/aws/aws-sdk-go-v2/aws/middleware"
 	"github.com/aws/aws-sdk-go-v2/internal/sdk"
 	"github.com/aws/smithy-go/middleware"
 	smithyhttp "github.com/aws/smithy-go/transport/http"
@@ -446,3 +448,60 @@ type mockRawResponseKey struct{}
 func setMockRawResponse(m *middleware.Metadata, v interface{}) {
 	m.Set(mockRawResponseKey{}, v)
 }
+
+func TestAttemptMiddleware_AttemptNumber(t *testing.T) {
+	restoreSleep := sdk.TestingUseNopSleep()
+	defer restoreSleep()
+
+	am := NewAttemptMiddleware(NewStandard(func(s *StandardOptions) {
+		s.MaxAttempts = 3
+	}), func(i interface{}) interface{} {
+		return i
+	})
+
+	var attempts []int
+	_, _, err := am.HandleFinalize(context.Background(), middleware.FinalizeInput{},
+		middleware.FinalizeHandlerFunc(func(ctx context.Context, in middleware.FinalizeInput) (
+			out middleware.FinalizeOutput, metadata middleware.Metadata, err error,
+		) {
+			attempts = append(attempts, awsmiddle.GetAttemptNumber(ctx))
+			if len(attempts) < 3 {
+				return out, metadata, mockRetryableError{b: true}
+			}
+			return out, metadata, nil
+		}))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := []int{1, 2, 3}, attempts; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v attempt numbers, got %v", e, a)
+	}
+}
+
+func TestAddRetryTransientNetworkErrors(t *testing.T) {
+	stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+	if err := AddRetryMiddlewares(stack, AddRetryMiddlewaresOptions{Retryer: NewStandard()}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if err := AddRetryTransientNetworkErrors(stack); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	m, ok := stack.Finalize.Get((&Attempt{}).ID())
+	if !ok {
+		t.Fatalf("expect retry middleware")
+	}
+	retryer := m.(*Attempt).retryer
+	if !retryer.IsErrorRetryable(io.ErrUnexpectedEOF) {
+		t.Errorf("expect unexpected EOF to be retryable")
+	}
+	if retryer.IsErrorRetryable(fmt.Errorf("some error")) {
+		t.Errorf("expect other errors to not be retryable")
+	}
+
+	stack = middleware.NewStack("test", smithyhttp.NewStackRequest)
+	if err := AddRetryTransientNetworkErrors(stack); err == nil {
+		t.Errorf("expect error without retry middleware, got none")
+	}
+}
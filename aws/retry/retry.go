@@ -1,6 +1,7 @@
 package retry
 
 import (
+	"context"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -34,6 +35,21 @@ func (r *withIsErrorRetryable) IsErrorRetryable(err error) bool {
 	return r.Retryer.IsErrorRetryable(err)
 }
 
+// AddWithTransientNetworkErrors returns a Retryer wrapping the passed in
+// retryer that also retries transient network errors, such as connection
+// resets, unexpected EOFs, and network timeouts. See
+// RetryableTransientNetworkError. Other errors are retried as determined by
+// the wrapped retryer.
+//
+// Only use the returned retryer for idempotent operations, since the failed
+// request may have been received by the service.
+func AddWithTransientNetworkErrors(r aws.Retryer) aws.Retryer {
+	return &withIsErrorRetryable{
+		Retryer:   r,
+		Retryable: RetryableTransientNetworkError{},
+	}
+}
+
 // AddWithMaxAttempts returns a Retryer with MaxAttempts set to the value
 // specified.
 func AddWithMaxAttempts(r aws.Retryer, max int) aws.Retryer {
@@ -70,3 +86,58 @@ type withMaxBackoffDelay struct {
 func (r *withMaxBackoffDelay) RetryDelay(attempt int, err error) (time.Duration, error) {
 	return r.backoff.BackoffDelay(attempt, err)
 }
+
+// AddWithRetryBudget returns a Retryer wrapping the passed in retryer that
+// must also retrieve a retry token from the budget before each retry attempt.
+// A budget shared by multiple clients, or operations, caps the retry attempts
+// they make collectively, preventing retries from amplifying the load on a
+// service during a broad outage.
+//
+// Each retry attempt costs DefaultRetryCost tokens, that are refunded if the
+// attempt succeeds. Each request that succeeds without being retried adds
+// DefaultNoRetryIncrement tokens back to the budget. When the budget is
+// exhausted the error of the failed attempt is returned without being
+// retried.
+func AddWithRetryBudget(r aws.Retryer, budget RateLimiter) aws.Retryer {
+	return &withRetryBudget{
+		Retryer: r,
+		budget:  budget,
+	}
+}
+
+type withRetryBudget struct {
+	aws.Retryer
+	budget RateLimiter
+}
+
+func (r *withRetryBudget) GetInitialToken() func(error) error {
+	release := r.Retryer.GetInitialToken()
+	return func(err error) error {
+		if err == nil {
+			if budgetErr := r.budget.AddTokens(DefaultNoRetryIncrement); budgetErr != nil {
+				return budgetErr
+			}
+		}
+		return release(err)
+	}
+}
+
+func (r *withRetryBudget) GetRetryToken(ctx context.Context, opErr error) (func(error) error, error) {
+	releaseBudget, err := r.budget.GetToken(ctx, DefaultRetryCost)
+	if err != nil {
+		return nil, opErr
+	}
+
+	release, err := r.Retryer.GetRetryToken(ctx, opErr)
+	if err != nil {
+		releaseBudget()
+		return nil, err
+	}
+
+	return func(err error) error {
+		if budgetErr := releaseToken(releaseBudget).release(err); budgetErr != nil {
+			return budgetErr
+		}
+		return release(err)
+	}, nil
+}
@@ -1,9 +1,13 @@
 package retry_test
 
 import (
+	"context"
+	"fmt"
+	"io"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 )
 
@@ -32,3 +36,97 @@ func TestAddWithErrorCodes(t *testing.T) {
 		})
 	}
 }
+
+func TestAddWithRetryBudget(t *testing.T) {
+	budget := ratelimit.NewTokenRateLimit(2 * retry.DefaultRetryCost)
+	r := retry.AddWithRetryBudget(retry.NewStandard(), budget)
+
+	opErr := fmt.Errorf("service unavailable")
+
+	var releases []func(error) error
+	for i := 0; i < 2; i++ {
+		release, err := r.GetRetryToken(context.Background(), opErr)
+		if err != nil {
+			t.Fatalf("expect retry %d within budget, got %v", i, err)
+		}
+		releases = append(releases, release)
+	}
+
+	// Budget is exhausted, the original error is returned.
+	if _, err := r.GetRetryToken(context.Background(), opErr); err != opErr {
+		t.Fatalf("expect %v error, got %v", opErr, err)
+	}
+
+	// A successful retry attempt refunds its cost to the budget.
+	if err := releases[0](nil); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if _, err := r.GetRetryToken(context.Background(), opErr); err != nil {
+		t.Fatalf("expect refunded retry within budget, got %v", err)
+	}
+
+	// A failed retry attempt does not refund its cost.
+	if err := releases[1](opErr); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if _, err := r.GetRetryToken(context.Background(), opErr); err != opErr {
+		t.Fatalf("expect %v error, got %v", opErr, err)
+	}
+}
+
+func TestAddWithRetryBudget_SharedBudget(t *testing.T) {
+	budget := ratelimit.NewTokenRateLimit(retry.DefaultRetryCost)
+	r1 := retry.AddWithRetryBudget(retry.NewStandard(), budget)
+	r2 := retry.AddWithRetryBudget(retry.NewStandard(), budget)
+
+	opErr := fmt.Errorf("service unavailable")
+	if _, err := r1.GetRetryToken(context.Background(), opErr); err != nil {
+		t.Fatalf("expect retry within budget, got %v", err)
+	}
+	if _, err := r2.GetRetryToken(context.Background(), opErr); err != opErr {
+		t.Fatalf("expect %v error, got %v", opErr, err)
+	}
+
+	// Requests succeeding without retries add tokens back to the budget.
+	for i := uint(0); i < retry.DefaultRetryCost; i++ {
+		if err := r2.GetInitialToken()(nil); err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+	}
+	if _, err := r2.GetRetryToken(context.Background(), opErr); err != nil {
+		t.Fatalf("expect retry within replenished budget, got %v", err)
+	}
+}
+
+func TestAddWithTransientNetworkErrors(t *testing.T) {
+	cases := map[string]struct {
+		Err    error
+		Expect bool
+	}{
+		"unexpected EOF": {
+			Err:    fmt.Errorf("failed to decode response body, %w", io.ErrUnexpectedEOF),
+			Expect: true,
+		},
+		"connection reset": {
+			Err:    fmt.Errorf("request send failed, %w", fmt.Errorf("connection reset")),
+			Expect: true,
+		},
+		"other error not retryable": {
+			Err:    &mockErrorCodeError{code: "ValidationException"},
+			Expect: false,
+		},
+		"other error retryable": {
+			Err:    &mockErrorCodeError{code: "ThrottlingException"},
+			Expect: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := retry.AddWithTransientNetworkErrors(retry.NewStandard())
+			if e, a := c.Expect, r.IsErrorRetryable(c.Err); e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+		})
+	}
+}
@@ -1,10 +1,13 @@
 package retry
 
 import (
+	"context"
 	"errors"
+	"io"
 	"net"
 	"net/url"
 	"strings"
+	"syscall"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 )
@@ -184,3 +187,44 @@ func (r RetryableErrorCode) IsErrorRetryable(err error) aws.Ternary {
 
 	return aws.TrueTernary
 }
+
+// RetryableTransientNetworkError determines if the error is a transient
+// network error, and returns that it should be retried. Transient network
+// errors include connection resets, unexpected EOFs, and network timeouts,
+// including those that occur while the response body is being read.
+//
+// Since the request may have been received by the service before the error
+// occurred, only idempotent operations should be retried with
+// RetryableTransientNetworkError. Network dial errors are not considered
+// transient network errors, since the request was never sent.
+type RetryableTransientNetworkError struct{}
+
+// IsErrorRetryable returns if the error is a transient network error, and
+// should be retried.
+func (RetryableTransientNetworkError) IsErrorRetryable(err error) aws.Ternary {
+	if err == nil {
+		return aws.UnknownTernary
+	}
+
+	var canceledErr interface{ CanceledError() bool }
+	if errors.As(err, &canceledErr) && canceledErr.CanceledError() ||
+		errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return aws.UnknownTernary
+	}
+
+	var netOpErr *net.OpError
+	if errors.As(err, &netOpErr) && strings.EqualFold(netOpErr.Op, "dial") {
+		return aws.UnknownTernary
+	}
+
+	var netErr net.Error
+	switch {
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+	case errors.Is(err, syscall.ECONNRESET), strings.Contains(err.Error(), "connection reset"):
+	case errors.As(err, &netErr) && netErr.Timeout():
+	default:
+		return aws.UnknownTernary
+	}
+
+	return aws.TrueTernary
+}
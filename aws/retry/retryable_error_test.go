@@ -1,9 +1,13 @@
 package retry_test
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net"
 	"net/url"
+	"os"
+	"syscall"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -263,3 +267,74 @@ func TestCanceledError(t *testing.T) {
 		})
 	}
 }
+
+func TestRetryTransientNetworkErrors(t *testing.T) {
+	cases := map[string]struct {
+		Err       error
+		Retryable aws.Ternary
+	}{
+		"nil error": {
+			Retryable: aws.UnknownTernary,
+		},
+		"unexpected EOF": {
+			Retryable: aws.TrueTernary,
+			Err:       fmt.Errorf("failed to decode response body, %w", io.ErrUnexpectedEOF),
+		},
+		"EOF": {
+			Retryable: aws.TrueTernary,
+			Err:       &url.Error{Op: "Post", URL: "https://example.com", Err: io.EOF},
+		},
+		"connection reset": {
+			Retryable: aws.TrueTernary,
+			Err: fmt.Errorf("some error, %w", &net.OpError{
+				Op:  "read",
+				Err: os.NewSyscallError("read", syscall.ECONNRESET),
+			}),
+		},
+		"timeout": {
+			Retryable: aws.TrueTernary,
+			Err:       fmt.Errorf("some error, %w", mockNetTimeoutError{}),
+		},
+		"dial timeout": {
+			Retryable: aws.UnknownTernary,
+			Err:       &net.OpError{Op: "dial", Err: mockNetTimeoutError{}},
+		},
+		"context deadline exceeded": {
+			Retryable: aws.UnknownTernary,
+			Err:       fmt.Errorf("some error, %w", context.DeadlineExceeded),
+		},
+		"canceled": {
+			Retryable: aws.UnknownTernary,
+			Err:       fmt.Errorf("some error, %w", &mockCanceledErrorWrap{err: io.EOF}),
+		},
+		"some other error": {
+			Retryable: aws.UnknownTernary,
+			Err:       fmt.Errorf("some error: %w", fmt.Errorf("something bad")),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var r retry.RetryableTransientNetworkError
+
+			retryable := r.IsErrorRetryable(c.Err)
+			if e, a := c.Retryable, retryable; e != a {
+				t.Errorf("expect %v retryable, got %v", e, a)
+			}
+		})
+	}
+}
+
+type mockNetTimeoutError struct{}
+
+func (mockNetTimeoutError) Timeout() bool   { return true }
+func (mockNetTimeoutError) Temporary() bool { return false }
+func (mockNetTimeoutError) Error() string   { return "mock net timeout" }
+
+type mockCanceledErrorWrap struct{ err error }
+
+func (*mockCanceledErrorWrap) CanceledError() bool { return true }
+func (m *mockCanceledErrorWrap) Error() string {
+	return fmt.Sprintf("mock canceled: %v", m.err)
+}
+func (m *mockCanceledErrorWrap) Unwrap() error { return m.err }
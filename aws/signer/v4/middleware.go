@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
@@ -86,6 +87,67 @@ func (m *unsignedPayload) HandleBuild(
 	return next.HandleBuild(ctx, in)
 }
 
+// unsignedPayloadOverHTTPS sets the SigV4 request payload hash to unsigned
+// for requests sent over HTTPS, and computes the SHA256 payload hash for
+// requests sent over any other scheme.
+//
+// Will not set the Unsigned Payload magic SHA value, if a SHA has already been
+// stored in the context. (e.g. application pre-computed SHA256 before making
+// API call).
+type unsignedPayloadOverHTTPS struct {
+	computePayloadSHA256
+}
+
+// UseUnsignedPayloadOverHTTPS replaces the operation's payload hash middleware
+// with one that signs requests sent over HTTPS with an unsigned payload. The
+// X-Amz-Content-Sha256 header is added to the operation if not already
+// present, as the unsigned payload value must be sent to the service.
+//
+// Requests with an unsigned payload do not include the payload in the
+// signature. The integrity of the payload relies on TLS instead, which is why
+// requests sent over any other scheme continue to have their payload hash
+// computed.
+func UseUnsignedPayloadOverHTTPS(stack *middleware.Stack) error {
+	if _, ok := stack.Build.Get(computePayloadHashMiddlewareID); ok {
+		if _, err := stack.Build.Swap(computePayloadHashMiddlewareID, &unsignedPayloadOverHTTPS{}); err != nil {
+			return err
+		}
+	} else if err := stack.Build.Add(&unsignedPayloadOverHTTPS{}, middleware.After); err != nil {
+		return err
+	}
+
+	if _, ok := stack.Build.Get((*contentSHA256Header)(nil).ID()); ok {
+		return nil
+	}
+	return AddContentSHA256HeaderMiddleware(stack)
+}
+
+// ID returns the unsignedPayloadOverHTTPS identifier
+func (m *unsignedPayloadOverHTTPS) ID() string {
+	return computePayloadHashMiddlewareID
+}
+
+// HandleBuild sets the payload hash to be an unsigned payload if the request
+// is sent over HTTPS, otherwise computes the payload hash.
+func (m *unsignedPayloadOverHTTPS) HandleBuild(
+	ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler,
+) (
+	out middleware.BuildOutput, metadata middleware.Metadata, err error,
+) {
+	req, ok := in.Request.(*smithyHTTP.Request)
+	if !ok {
+		return out, metadata, &HashComputationError{
+			Err: fmt.Errorf("unexpected request middleware type %T", in.Request),
+		}
+	}
+
+	if len(GetPayloadHash(ctx)) == 0 && strings.EqualFold(req.URL.Scheme, "https") {
+		ctx = SetPayloadHash(ctx, v4Internal.UnsignedPayload)
+	}
+
+	return m.computePayloadSHA256.HandleBuild(ctx, in, next)
+}
+
 // computePayloadSHA256 computes SHA256 payload hash to sign.
 //
 // Will not set the Unsigned Payload magic SHA value, if a SHA has already been
@@ -83,6 +83,84 @@ func TestComputePayloadHashMiddleware(t *testing.T) {
 	}
 }
 
+func TestUnsignedPayloadOverHTTPSMiddleware(t *testing.T) {
+	cases := map[string]struct {
+		scheme       string
+		hash         string
+		expectedHash string
+	}{
+		"https": {
+			scheme:       "https",
+			expectedHash: "UNSIGNED-PAYLOAD",
+		},
+		"http": {
+			scheme:       "http",
+			expectedHash: "290f493c44f5d63d06b374d0a5abd292fae38b92cab2fae5efefe1b0e9347f56",
+		},
+		"precomputed": {
+			scheme:       "https",
+			hash:         "0123456789abcdef",
+			expectedHash: "0123456789abcdef",
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+			if err := AddComputePayloadSHA256Middleware(stack); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if err := UseUnsignedPayloadOverHTTPS(stack); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			var actualHash, actualHeader string
+			stack.Build.Add(middleware.BuildMiddlewareFunc("capture",
+				func(ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler) (
+					out middleware.BuildOutput, metadata middleware.Metadata, err error,
+				) {
+					actualHash = GetPayloadHash(ctx)
+					actualHeader = in.Request.(*smithyhttp.Request).Header.Get("X-Amz-Content-Sha256")
+					return out, metadata, nil
+				}), middleware.After)
+
+			ctx := context.Background()
+			if len(tt.hash) != 0 {
+				ctx = SetPayloadHash(ctx, tt.hash)
+			}
+
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(
+				func(ctx context.Context, in interface{}) (interface{}, middleware.Metadata, error) {
+					return nil, middleware.Metadata{}, nil
+				}), stack)
+
+			stack.Serialize.Add(middleware.SerializeMiddlewareFunc("setBody",
+				func(ctx context.Context, in middleware.SerializeInput, next middleware.SerializeHandler) (
+					out middleware.SerializeOutput, metadata middleware.Metadata, err error,
+				) {
+					req := in.Request.(*smithyhttp.Request)
+					req.URL.Scheme = tt.scheme
+					req.URL.Host = "example.amazonaws.com"
+					if in.Request, err = req.SetStream(strings.NewReader("some content")); err != nil {
+						return out, metadata, err
+					}
+					return next.HandleSerialize(ctx, in)
+				}), middleware.After)
+
+			if _, _, err := handler.Handle(ctx, struct{}{}); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := tt.expectedHash, actualHash; e != a {
+				t.Errorf("expect %v payload hash, got %v", e, a)
+			}
+			if e, a := tt.expectedHash, actualHeader; e != a {
+				t.Errorf("expect %v content sha256 header, got %v", e, a)
+			}
+		})
+	}
+}
+
 type httpSignerFunc func(ctx context.Context, credentials aws.Credentials, r *http.Request, payloadHash string, service string, region string, signingTime time.Time, optFns ...func(*SignerOptions)) error
 
 func (f httpSignerFunc) SignHTTP(ctx context.Context, credentials aws.Credentials, r *http.Request, payloadHash string, service string, region string, signingTime time.Time, optFns ...func(*SignerOptions)) error {
@@ -0,0 +1,99 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/aws/smithy-go/logging"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// AddPreserveHeadersMiddleware adds middleware to the stack that restore the
+// headers, such as X-Amz-Target, to their serialized values if they were
+// removed from the request after it was serialized, logging a warning for
+// each header restored. The headers are restored before the request is
+// signed, or just before the request is sent if the stack does not sign
+// requests. If no headers are provided the middleware are not added.
+func AddPreserveHeadersMiddleware(stack *middleware.Stack, headers []string) error {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	if err := stack.Serialize.Add(&capturePreservedHeaders{headers: headers}, middleware.After); err != nil {
+		return err
+	}
+
+	m := &preserveHeaders{}
+	if _, ok := stack.Finalize.Get("Signing"); ok {
+		return stack.Finalize.Insert(m, "Signing", middleware.Before)
+	}
+	return stack.Finalize.Add(m, middleware.After)
+}
+
+type preservedHeadersKey struct{}
+
+// capturePreservedHeaders captures the serialized values of the headers to be
+// preserved.
+type capturePreservedHeaders struct {
+	headers []string
+}
+
+// ID returns the id of the middleware
+func (*capturePreservedHeaders) ID() string {
+	return "CapturePreservedHeaders"
+}
+
+// HandleSerialize implements the SerializeMiddleware interface
+func (m *capturePreservedHeaders) HandleSerialize(
+	ctx context.Context, in middleware.SerializeInput, next middleware.SerializeHandler,
+) (
+	out middleware.SerializeOutput, metadata middleware.Metadata, err error,
+) {
+	req, ok := in.Request.(*smithyhttp.Request)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown transport type %T", in.Request)
+	}
+
+	preserved := http.Header{}
+	for _, h := range m.headers {
+		if values := req.Header.Values(h); len(values) != 0 {
+			preserved[http.CanonicalHeaderKey(h)] = append([]string(nil), values...)
+		}
+	}
+	ctx = middleware.WithStackValue(ctx, preservedHeadersKey{}, preserved)
+
+	return next.HandleSerialize(ctx, in)
+}
+
+// preserveHeaders restores the preserved headers missing from the request.
+type preserveHeaders struct{}
+
+// ID returns the id of the middleware
+func (*preserveHeaders) ID() string {
+	return "PreserveHeaders"
+}
+
+// HandleFinalize implements the FinalizeMiddleware interface
+func (m *preserveHeaders) HandleFinalize(
+	ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler,
+) (
+	out middleware.FinalizeOutput, metadata middleware.Metadata, err error,
+) {
+	req, ok := in.Request.(*smithyhttp.Request)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown transport type %T", in.Request)
+	}
+
+	preserved, _ := middleware.GetStackValue(ctx, preservedHeadersKey{}).(http.Header)
+	for h, values := range preserved {
+		if len(req.Header.Values(h)) != 0 {
+			continue
+		}
+		middleware.GetLogger(ctx).Logf(logging.Warn, "restoring %s header removed from request", h)
+		req.Header[h] = append([]string(nil), values...)
+	}
+
+	return next.HandleFinalize(ctx, in)
+}
@@ -0,0 +1,86 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/smithy-go/logging"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestPreserveHeadersMiddleware(t *testing.T) {
+	cases := map[string]struct {
+		Headers      []string
+		Strip        []string
+		ExpectTarget string
+		ExpectWarn   bool
+	}{
+		"restored": {
+			Headers:      []string{"X-Amz-Target"},
+			Strip:        []string{"X-Amz-Target"},
+			ExpectTarget: "Service.Operation",
+			ExpectWarn:   true,
+		},
+		"not stripped": {
+			Headers:      []string{"x-amz-target"},
+			ExpectTarget: "Service.Operation",
+		},
+		"not preserved": {
+			Headers: []string{"Content-Type"},
+			Strip:   []string{"X-Amz-Target"},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			stack := middleware.NewStack("Operation", smithyhttp.NewStackRequest)
+			stack.Serialize.Add(middleware.SerializeMiddlewareFunc("Serializer", func(
+				ctx context.Context, in middleware.SerializeInput, next middleware.SerializeHandler,
+			) (
+				out middleware.SerializeOutput, metadata middleware.Metadata, err error,
+			) {
+				req := in.Request.(*smithyhttp.Request)
+				req.Header.Set("X-Amz-Target", "Service.Operation")
+				req.Header.Set("Content-Type", "application/x-amz-json-1.0")
+				return next.HandleSerialize(ctx, in)
+			}), middleware.After)
+			if err := AddPreserveHeadersMiddleware(stack, c.Headers); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			stack.Build.Add(middleware.BuildMiddlewareFunc("StripHeaders", func(
+				ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler,
+			) (
+				out middleware.BuildOutput, metadata middleware.Metadata, err error,
+			) {
+				for _, h := range c.Strip {
+					in.Request.(*smithyhttp.Request).Header.Del(h)
+				}
+				return next.HandleBuild(ctx, in)
+			}), middleware.After)
+
+			var logged bytes.Buffer
+			ctx := middleware.SetLogger(context.Background(), logging.NewStandardLogger(&logged))
+
+			var target string
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, in interface{}) (
+				out interface{}, metadata middleware.Metadata, err error,
+			) {
+				target = in.(*smithyhttp.Request).Header.Get("X-Amz-Target")
+				return out, metadata, err
+			}), stack)
+			if _, _, err := handler.Handle(ctx, struct{}{}); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.ExpectTarget, target; e != a {
+				t.Errorf("expect %q target, got %q", e, a)
+			}
+			if e, a := c.ExpectWarn, strings.Contains(logged.String(), "WARN restoring X-Amz-Target"); e != a {
+				t.Errorf("expect %v warning logged, got %v", e, a)
+			}
+		})
+	}
+}
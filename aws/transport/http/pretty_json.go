@@ -0,0 +1,66 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// AddPrettyJSONMiddleware adds a middleware to the end of the stack's
+// serialize step that indents the serialized JSON body of the operation's
+// requests, for debugging. The indented body is the body sent, and the body
+// logged or captured by later middleware. Request bodies whose Content-Type
+// is not JSON are not modified.
+func AddPrettyJSONMiddleware(stack *middleware.Stack) error {
+	return stack.Serialize.Add(&prettyJSON{}, middleware.After)
+}
+
+// prettyJSON indents the serialized JSON request body.
+type prettyJSON struct{}
+
+// ID returns the id of the middleware
+func (*prettyJSON) ID() string {
+	return "PrettyJSON"
+}
+
+// HandleSerialize implements the SerializeMiddleware interface
+func (m *prettyJSON) HandleSerialize(
+	ctx context.Context, in middleware.SerializeInput, next middleware.SerializeHandler,
+) (
+	out middleware.SerializeOutput, metadata middleware.Metadata, err error,
+) {
+	req, ok := in.Request.(*smithyhttp.Request)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown transport type %T", in.Request)
+	}
+
+	stream := req.GetStream()
+	if stream == nil || !strings.Contains(req.Header.Get("Content-Type"), "json") {
+		return next.HandleSerialize(ctx, in)
+	}
+
+	body, err := ioutil.ReadAll(stream)
+	if err != nil {
+		return out, metadata, fmt.Errorf("failed to read request body, %w", err)
+	}
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, body, "", "  "); err != nil {
+		// Not valid JSON, send the body unmodified.
+		indented.Reset()
+		indented.Write(body)
+	}
+
+	if req, err = req.SetStream(bytes.NewReader(indented.Bytes())); err != nil {
+		return out, metadata, fmt.Errorf("failed to set request body, %w", err)
+	}
+	in.Request = req
+
+	return next.HandleSerialize(ctx, in)
+}
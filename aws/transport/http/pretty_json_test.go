@@ -0,0 +1,63 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestPrettyJSONMiddleware(t *testing.T) {
+	cases := map[string]struct {
+		ContentType string
+		Body        string
+		Expect      string
+	}{
+		"json": {
+			ContentType: "application/x-amz-json-1.0",
+			Body:        `{"A":"1","B":[1,2]}`,
+			Expect:      "{\n  \"A\": \"1\",\n  \"B\": [\n    1,\n    2\n  ]\n}",
+		},
+		"not json": {
+			ContentType: "application/x-www-form-urlencoded",
+			Body:        "Action=DescribeVpcs",
+			Expect:      "Action=DescribeVpcs",
+		},
+		"invalid json": {
+			ContentType: "application/json",
+			Body:        `{"A":`,
+			Expect:      `{"A":`,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := smithyhttp.NewStackRequest().(*smithyhttp.Request)
+			req.Header.Set("Content-Type", c.ContentType)
+			req, err := req.SetStream(bytes.NewReader([]byte(c.Body)))
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			var body []byte
+			m := &prettyJSON{}
+			_, _, err = m.HandleSerialize(context.Background(), middleware.SerializeInput{Request: req},
+				middleware.SerializeHandlerFunc(func(ctx context.Context, in middleware.SerializeInput) (
+					out middleware.SerializeOutput, metadata middleware.Metadata, err error,
+				) {
+					body, err = ioutil.ReadAll(in.Request.(*smithyhttp.Request).GetStream())
+					return out, metadata, err
+				}),
+			)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.Expect, string(body); e != a {
+				t.Errorf("expect %v body, got %v", e, a)
+			}
+		})
+	}
+}
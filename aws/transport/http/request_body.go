@@ -0,0 +1,73 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// AddRequestBodyMiddleware adds a middleware to the stack's build step that
+// calls fn with the name of the operation and the serialized body of the
+// operation's request. The body passed to fn is a copy, and is empty if the
+// request has no body. The request body is read into memory in order to be
+// copied. If fn is nil, the middleware is not added.
+func AddRequestBodyMiddleware(stack *middleware.Stack, fn func(opName string, body []byte)) error {
+	if fn == nil {
+		return nil
+	}
+	return stack.Build.Add(&requestBody{
+		operation: stack.ID(),
+		fn:        fn,
+	}, middleware.After)
+}
+
+// requestBody passes a copy of the serialized request body to a function.
+type requestBody struct {
+	operation string
+	fn        func(string, []byte)
+}
+
+// ID returns the id of the middleware
+func (*requestBody) ID() string {
+	return "RequestBody"
+}
+
+// HandleBuild implements the BuildMiddleware interface
+func (m *requestBody) HandleBuild(
+	ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler,
+) (
+	out middleware.BuildOutput, metadata middleware.Metadata, err error,
+) {
+	req, ok := in.Request.(*smithyhttp.Request)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown transport type %T", in.Request)
+	}
+
+	var body []byte
+	if stream := req.GetStream(); stream != nil {
+		body, err = ioutil.ReadAll(stream)
+		if err != nil {
+			return out, metadata, fmt.Errorf("failed to read request body, %w", err)
+		}
+
+		if req.IsStreamSeekable() {
+			err = req.RewindStream()
+		} else {
+			req, err = req.SetStream(bytes.NewReader(body))
+		}
+		if err != nil {
+			return out, metadata, fmt.Errorf("failed to reset request body, %w", err)
+		}
+		in.Request = req
+	}
+
+	bodyCopy := make([]byte, len(body))
+	copy(bodyCopy, body)
+	m.fn(m.operation, bodyCopy)
+
+	return next.HandleBuild(ctx, in)
+}
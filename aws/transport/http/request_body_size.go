@@ -0,0 +1,65 @@
+package http
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// AddRequestBodySizeMiddleware adds a middleware to the stack's build step
+// that records the length, in bytes, of the operation's serialized request
+// body in the operation's metadata. Use GetRequestBodyBytes to retrieve the
+// length from the ResultMetadata of the operation's output. The length is not
+// recorded if it cannot be determined without reading the body, such as for a
+// streaming body that is not seekable.
+func AddRequestBodySizeMiddleware(stack *middleware.Stack) error {
+	return stack.Build.Add(&requestBodySize{}, middleware.After)
+}
+
+// requestBodySize records the length of the serialized request body.
+type requestBodySize struct{}
+
+// ID returns the id of the middleware
+func (*requestBodySize) ID() string {
+	return "RequestBodySize"
+}
+
+// HandleBuild implements the BuildMiddleware interface
+func (m *requestBodySize) HandleBuild(
+	ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler,
+) (
+	out middleware.BuildOutput, metadata middleware.Metadata, err error,
+) {
+	req, ok := in.Request.(*smithyhttp.Request)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown transport type %T", in.Request)
+	}
+
+	size, ok, err := req.StreamLength()
+	if err != nil {
+		return out, metadata, fmt.Errorf("failed to get request body length, %w", err)
+	}
+
+	out, metadata, err = next.HandleBuild(ctx, in)
+	if ok {
+		setRequestBodyBytes(&metadata, size)
+	}
+	return out, metadata, err
+}
+
+type requestBodyBytesKey struct{}
+
+// GetRequestBodyBytes returns the length, in bytes, of the operation's
+// serialized request body, as recorded by the middleware added with
+// AddRequestBodySizeMiddleware.
+func GetRequestBodyBytes(metadata middleware.Metadata) (v int64, ok bool) {
+	v, ok = metadata.Get(requestBodyBytesKey{}).(int64)
+	return v, ok
+}
+
+// setRequestBodyBytes sets the length of the request body on the metadata.
+func setRequestBodyBytes(metadata *middleware.Metadata, v int64) {
+	metadata.Set(requestBodyBytesKey{}, v)
+}
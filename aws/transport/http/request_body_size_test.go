@@ -0,0 +1,72 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestRequestBodySizeMiddleware(t *testing.T) {
+	cases := map[string]struct {
+		Stream     func() io.Reader
+		ExpectSize int64
+		ExpectOK   bool
+	}{
+		"bytes": {
+			Stream:     func() io.Reader { return bytes.NewReader([]byte(`{"a":1}`)) },
+			ExpectSize: 7,
+			ExpectOK:   true,
+		},
+		"seekable": {
+			Stream: func() io.Reader {
+				return struct{ io.ReadSeeker }{strings.NewReader(`Action=Op&Version=1`)}
+			},
+			ExpectSize: 19,
+			ExpectOK:   true,
+		},
+		"not seekable": {
+			Stream: func() io.Reader { return struct{ io.Reader }{strings.NewReader(`Action=Op`)} },
+		},
+		"no body": {
+			ExpectOK: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := smithyhttp.NewStackRequest().(*smithyhttp.Request)
+			if c.Stream != nil {
+				var err error
+				req, err = req.SetStream(c.Stream())
+				if err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+			}
+
+			m := &requestBodySize{}
+			_, metadata, err := m.HandleBuild(context.Background(), middleware.BuildInput{Request: req},
+				middleware.BuildHandlerFunc(func(ctx context.Context, in middleware.BuildInput) (
+					out middleware.BuildOutput, metadata middleware.Metadata, err error,
+				) {
+					return out, metadata, err
+				}),
+			)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			size, ok := GetRequestBodyBytes(metadata)
+			if e, a := c.ExpectOK, ok; e != a {
+				t.Fatalf("expect %v ok, got %v", e, a)
+			}
+			if e, a := c.ExpectSize, size; e != a {
+				t.Errorf("expect %v bytes, got %v", e, a)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package http
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// nonSeekableReader hides the io.Seeker implementation of the reader.
+type nonSeekableReader struct {
+	*strings.Reader
+}
+
+func TestRequestBodyMiddleware(t *testing.T) {
+	cases := map[string]struct {
+		Stream     func() io.Reader
+		ExpectBody string
+	}{
+		"seekable": {
+			Stream:     func() io.Reader { return strings.NewReader(`{"a":1}`) },
+			ExpectBody: `{"a":1}`,
+		},
+		"not seekable": {
+			Stream:     func() io.Reader { return nonSeekableReader{strings.NewReader(`Action=Op`)} },
+			ExpectBody: `Action=Op`,
+		},
+		"no body": {},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := smithyhttp.NewStackRequest().(*smithyhttp.Request)
+			if c.Stream != nil {
+				var err error
+				req, err = req.SetStream(c.Stream())
+				if err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+			}
+
+			var captured []byte
+			var operation string
+			m := &requestBody{
+				operation: "ExampleOperation",
+				fn: func(opName string, body []byte) {
+					operation = opName
+					captured = body
+				},
+			}
+
+			_, _, err := m.HandleBuild(context.Background(), middleware.BuildInput{Request: req},
+				middleware.BuildHandlerFunc(func(ctx context.Context, in middleware.BuildInput) (
+					out middleware.BuildOutput, metadata middleware.Metadata, err error,
+				) {
+					// Modifying the captured body must not modify the request.
+					for i := range captured {
+						captured[i] = 'x'
+					}
+
+					var sent []byte
+					if stream := in.Request.(*smithyhttp.Request).GetStream(); stream != nil {
+						sent, err = ioutil.ReadAll(stream)
+					}
+					if e, a := c.ExpectBody, string(sent); e != a {
+						t.Errorf("expect %q sent body, got %q", e, a)
+					}
+					return out, metadata, err
+				}),
+			)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := "ExampleOperation", operation; e != a {
+				t.Errorf("expect %v operation, got %v", e, a)
+			}
+			if e, a := len(c.ExpectBody), len(captured); e != a {
+				t.Errorf("expect %v captured bytes, got %v", e, a)
+			}
+			if captured == nil {
+				t.Errorf("expect non-nil captured body")
+			}
+		})
+	}
+}
+
+func TestAddRequestBodyMiddleware_Nil(t *testing.T) {
+	stack := middleware.NewStack("ExampleOperation", smithyhttp.NewStackRequest)
+	if err := AddRequestBodyMiddleware(stack, nil); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if _, ok := stack.Build.Get("RequestBody"); ok {
+		t.Errorf("expect middleware not to be added")
+	}
+}
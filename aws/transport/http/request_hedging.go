@@ -0,0 +1,200 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// AddRequestHedgingMiddleware adds a middleware to the stack that sends a
+// second, identical, request if a response to the first request has not been
+// received within hedgeAfter. The first successful response is used, and the
+// other request is canceled. If hedgeAfter is zero or less, the middleware is
+// not added.
+//
+// Hedging sends the request more than once, and must only be used with
+// idempotent operations.
+func AddRequestHedgingMiddleware(stack *middleware.Stack, hedgeAfter time.Duration) error {
+	if hedgeAfter <= 0 {
+		return nil
+	}
+	return stack.Deserialize.Add(&requestHedging{hedgeAfter: hedgeAfter}, middleware.After)
+}
+
+// requestHedging sends a hedged request if the first request is slow to
+// respond.
+type requestHedging struct {
+	hedgeAfter time.Duration
+}
+
+// ID returns the id of the middleware
+func (*requestHedging) ID() string {
+	return "RequestHedging"
+}
+
+type hedgedResult struct {
+	attempt  int
+	out      middleware.DeserializeOutput
+	metadata middleware.Metadata
+	err      error
+}
+
+// HandleDeserialize implements the DeserializeMiddleware interface
+func (m *requestHedging) HandleDeserialize(
+	ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler,
+) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	req, ok := in.Request.(*smithyhttp.Request)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown transport type %T", in.Request)
+	}
+
+	// Each request needs its own reader of the request body, since both may be
+	// read concurrently.
+	var newBody func() io.Reader
+	if stream := req.GetStream(); stream != nil {
+		newBody, err = newHedgedBody(stream)
+		if err != nil {
+			return out, metadata, err
+		}
+	}
+
+	results := make(chan hedgedResult, 2)
+	var cancels []context.CancelFunc
+	send := func() error {
+		attemptIn := in
+		attemptReq := req.Clone()
+		if newBody != nil {
+			var err error
+			if attemptReq, err = attemptReq.SetStream(newBody()); err != nil {
+				return err
+			}
+		}
+		attemptIn.Request = attemptReq
+
+		attempt := len(cancels)
+		attemptCtx, cancel := context.WithCancel(ctx)
+		cancels = append(cancels, cancel)
+		go func() {
+			out, metadata, err := next.HandleDeserialize(attemptCtx, attemptIn)
+			results <- hedgedResult{attempt: attempt, out: out, metadata: metadata, err: err}
+		}()
+		return nil
+	}
+
+	if err := send(); err != nil {
+		return out, metadata, err
+	}
+	pending := 1
+
+	timer := time.NewTimer(m.hedgeAfter)
+	defer timer.Stop()
+
+	var firstErr *hedgedResult
+	for {
+		select {
+		case <-timer.C:
+			if err := send(); err != nil {
+				continue
+			}
+			pending++
+
+		case r := <-results:
+			pending--
+			if r.err != nil {
+				cancels[r.attempt]()
+				if firstErr == nil {
+					firstErr = &r
+				}
+				// Wait for the other request if the hedged request was sent.
+				if pending > 0 {
+					continue
+				}
+				return firstErr.out, firstErr.metadata, firstErr.err
+			}
+
+			for i, cancel := range cancels {
+				if i != r.attempt {
+					cancel()
+				}
+			}
+			for ; pending > 0; pending-- {
+				go discardHedgedResult(results)
+			}
+			return withCancelOnClose(r.out, cancels[r.attempt]), r.metadata, nil
+		}
+	}
+}
+
+// newHedgedBody returns a function creating independent readers of the
+// request body's remaining bytes. A body that can be read at an offset, such
+// as a bytes.Reader or os.File, is read in place by each reader. Other bodies
+// are read into memory.
+func newHedgedBody(stream io.Reader) (func() io.Reader, error) {
+	if r, ok := stream.(interface {
+		io.ReaderAt
+		io.Seeker
+	}); ok {
+		start, err := r.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get request body offset for hedging, %w", err)
+		}
+		end, err := r.Seek(0, io.SeekEnd)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get request body length for hedging, %w", err)
+		}
+		if _, err := r.Seek(start, io.SeekStart); err != nil {
+			return nil, fmt.Errorf("failed to rewind request body, %w", err)
+		}
+		return func() io.Reader {
+			return io.NewSectionReader(r, start, end-start)
+		}, nil
+	}
+
+	body, err := ioutil.ReadAll(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body for hedging, %w", err)
+	}
+	return func() io.Reader {
+		return bytes.NewReader(body)
+	}, nil
+}
+
+// discardHedgedResult closes the response body of the request that lost, if
+// it returned a response before it was canceled.
+func discardHedgedResult(results <-chan hedgedResult) {
+	r := <-results
+	if resp, ok := r.out.RawResponse.(*smithyhttp.Response); ok && resp != nil && resp.Body != nil {
+		resp.Body.Close()
+	}
+}
+
+// withCancelOnClose returns the output with the response body wrapped to
+// release the request's context when the body is closed.
+func withCancelOnClose(out middleware.DeserializeOutput, cancel context.CancelFunc) middleware.DeserializeOutput {
+	resp, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok || resp == nil || resp.Body == nil {
+		cancel()
+		return out
+	}
+	resp.Body = &cancelOnCloseReader{ReadCloser: resp.Body, cancel: cancel}
+	return out
+}
+
+type cancelOnCloseReader struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (r *cancelOnCloseReader) Close() error {
+	err := r.ReadCloser.Close()
+	r.cancel()
+	return err
+}
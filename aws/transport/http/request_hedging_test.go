@@ -0,0 +1,203 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// mockHedgedTransport responds to each request after the delay for the
+// request's attempt, recording the request bodies received.
+type mockHedgedTransport struct {
+	delays []time.Duration
+	errs   []error
+
+	mu       sync.Mutex
+	bodies   []string
+	canceled []int
+}
+
+func (m *mockHedgedTransport) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	req := in.Request.(*smithyhttp.Request)
+	body, _ := ioutil.ReadAll(req.GetStream())
+
+	m.mu.Lock()
+	attempt := len(m.bodies)
+	m.bodies = append(m.bodies, string(body))
+	m.mu.Unlock()
+
+	select {
+	case <-time.After(m.delays[attempt]):
+	case <-ctx.Done():
+		m.mu.Lock()
+		m.canceled = append(m.canceled, attempt)
+		m.mu.Unlock()
+		return out, metadata, ctx.Err()
+	}
+
+	if attempt < len(m.errs) && m.errs[attempt] != nil {
+		return out, metadata, m.errs[attempt]
+	}
+
+	out.RawResponse = &smithyhttp.Response{Response: &http.Response{
+		StatusCode: 200,
+		Header:     http.Header{"Attempt": []string{fmt.Sprintf("%d", attempt)}},
+		Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+	}}
+	return out, metadata, nil
+}
+
+func TestRequestHedging(t *testing.T) {
+	cases := map[string]struct {
+		Delays         []time.Duration
+		Errs           []error
+		ExpectAttempt  string
+		ExpectRequests int
+		ExpectErr      bool
+	}{
+		"fast first request": {
+			Delays:         []time.Duration{0},
+			ExpectAttempt:  "0",
+			ExpectRequests: 1,
+		},
+		"slow then fast": {
+			Delays:         []time.Duration{time.Minute, 0},
+			ExpectAttempt:  "1",
+			ExpectRequests: 2,
+		},
+		"slow error then fast": {
+			Delays:         []time.Duration{100 * time.Millisecond, 0},
+			Errs:           []error{fmt.Errorf("connection reset")},
+			ExpectAttempt:  "1",
+			ExpectRequests: 2,
+		},
+		"fast error": {
+			Delays:         []time.Duration{0},
+			Errs:           []error{fmt.Errorf("connection reset")},
+			ExpectRequests: 1,
+			ExpectErr:      true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			transport := &mockHedgedTransport{delays: c.Delays, errs: c.Errs}
+
+			req := newStackRequestWithBody(t, `{"DatabaseName":"db"}`)
+
+			m := &requestHedging{hedgeAfter: 10 * time.Millisecond}
+			out, _, err := m.HandleDeserialize(context.Background(),
+				middleware.DeserializeInput{Request: req}, transport)
+			if c.ExpectErr {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+				resp := out.RawResponse.(*smithyhttp.Response)
+				if e, a := c.ExpectAttempt, resp.Header.Get("Attempt"); e != a {
+					t.Errorf("expect response from attempt %v, got %v", e, a)
+				}
+				resp.Body.Close()
+			}
+
+			transport.mu.Lock()
+			defer transport.mu.Unlock()
+			if e, a := c.ExpectRequests, len(transport.bodies); e != a {
+				t.Fatalf("expect %v requests, got %v", e, a)
+			}
+			for i, body := range transport.bodies {
+				if e, a := `{"DatabaseName":"db"}`, body; e != a {
+					t.Errorf("expect request %d body %v, got %v", i, e, a)
+				}
+			}
+		})
+	}
+}
+
+func TestRequestHedging_CancelsLoser(t *testing.T) {
+	transport := &mockHedgedTransport{delays: []time.Duration{time.Minute, 0}}
+
+	m := &requestHedging{hedgeAfter: 10 * time.Millisecond}
+	_, _, err := m.HandleDeserialize(context.Background(),
+		middleware.DeserializeInput{Request: newStackRequestWithBody(t, "{}")}, transport)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		transport.mu.Lock()
+		canceled := transport.canceled
+		transport.mu.Unlock()
+		if len(canceled) == 1 && canceled[0] == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expect slow request to be canceled, got %v", canceled)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func newStackRequestWithBody(t *testing.T, body string) *smithyhttp.Request {
+	t.Helper()
+	req, err := smithyhttp.NewStackRequest().(*smithyhttp.Request).SetStream(bytes.NewReader([]byte(body)))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	return req
+}
+
+func TestNewHedgedBody(t *testing.T) {
+	cases := map[string]struct {
+		Stream func() io.Reader
+	}{
+		"seekable": {
+			Stream: func() io.Reader {
+				r := strings.NewReader(`--{"DatabaseName":"db"}`)
+				r.Seek(2, io.SeekStart)
+				return r
+			},
+		},
+		"not seekable": {
+			Stream: func() io.Reader {
+				return struct{ io.Reader }{strings.NewReader(`{"DatabaseName":"db"}`)}
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			newBody, err := newHedgedBody(c.Stream())
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			first, second := newBody(), newBody()
+			for _, r := range []io.Reader{first, second} {
+				b, err := ioutil.ReadAll(r)
+				if err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+				if e, a := `{"DatabaseName":"db"}`, string(b); e != a {
+					t.Errorf("expect %v body, got %v", e, a)
+				}
+			}
+		})
+	}
+}
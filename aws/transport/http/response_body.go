@@ -0,0 +1,72 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// AddResponseBodyMiddleware adds a middleware to the stack's deserialize step
+// that calls fn with the name of the operation, and the status code and body
+// of the operation's response, before the response is deserialized. The body
+// passed to fn is a copy, and is empty if the response has no body. The
+// response body is read into memory in order to be copied. If fn is nil, the
+// middleware is not added.
+func AddResponseBodyMiddleware(stack *middleware.Stack, fn func(opName string, status int, body []byte)) error {
+	if fn == nil {
+		return nil
+	}
+	return stack.Deserialize.Add(&responseBody{
+		operation: stack.ID(),
+		fn:        fn,
+	}, middleware.After)
+}
+
+// responseBody passes a copy of the response body to a function.
+type responseBody struct {
+	operation string
+	fn        func(string, int, []byte)
+}
+
+// ID returns the id of the middleware
+func (*responseBody) ID() string {
+	return "ResponseBody"
+}
+
+// HandleDeserialize implements the DeserializeMiddleware interface
+func (m *responseBody) HandleDeserialize(
+	ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler,
+) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	var body []byte
+	if response.Body != nil {
+		body, err = ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		if err != nil {
+			return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("failed to read response body, %w", err)}
+		}
+		response.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	bodyCopy := make([]byte, len(body))
+	copy(bodyCopy, body)
+	m.fn(m.operation, response.StatusCode, bodyCopy)
+
+	return out, metadata, err
+}
@@ -0,0 +1,93 @@
+package http
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestResponseBodyMiddleware(t *testing.T) {
+	cases := map[string]struct {
+		StatusCode int
+		Body       string
+	}{
+		"success": {
+			StatusCode: 200,
+			Body:       `{"a":1}`,
+		},
+		"error": {
+			StatusCode: 400,
+			Body:       `<Response><Errors><Error><Code>Code</Code></Error></Errors></Response>`,
+		},
+		"no body": {
+			StatusCode: 204,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var captured []byte
+			var operation string
+			var status int
+			m := &responseBody{
+				operation: "ExampleOperation",
+				fn: func(opName string, statusCode int, body []byte) {
+					operation = opName
+					status = statusCode
+					captured = body
+				},
+			}
+
+			out, _, err := m.HandleDeserialize(context.Background(), middleware.DeserializeInput{},
+				middleware.DeserializeHandlerFunc(func(ctx context.Context, in middleware.DeserializeInput) (
+					out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+				) {
+					out.RawResponse = &smithyhttp.Response{Response: &http.Response{
+						StatusCode: c.StatusCode,
+						Body:       ioutil.NopCloser(strings.NewReader(c.Body)),
+					}}
+					return out, metadata, err
+				}),
+			)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := "ExampleOperation", operation; e != a {
+				t.Errorf("expect %v operation, got %v", e, a)
+			}
+			if e, a := c.StatusCode, status; e != a {
+				t.Errorf("expect %v status, got %v", e, a)
+			}
+			if e, a := c.Body, string(captured); e != a {
+				t.Errorf("expect %q captured body, got %q", e, a)
+			}
+
+			// Modifying the captured body must not modify the response.
+			for i := range captured {
+				captured[i] = 'x'
+			}
+			body, err := ioutil.ReadAll(out.RawResponse.(*smithyhttp.Response).Body)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.Body, string(body); e != a {
+				t.Errorf("expect %q response body, got %q", e, a)
+			}
+		})
+	}
+}
+
+func TestAddResponseBodyMiddleware_Nil(t *testing.T) {
+	stack := middleware.NewStack("ExampleOperation", smithyhttp.NewStackRequest)
+	if err := AddResponseBodyMiddleware(stack, nil); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if _, ok := stack.Deserialize.Get("ResponseBody"); ok {
+		t.Errorf("expect middleware not to be added")
+	}
+}
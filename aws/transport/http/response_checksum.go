@@ -0,0 +1,95 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+const crc32ChecksumHeader = "X-Amz-Crc32"
+
+// ErrChecksumMismatch is the error wrapped by the errors returned when the
+// CRC32 checksum of a response body does not match the X-Amz-Crc32 header of
+// the response. Use errors.Is to test for it.
+var ErrChecksumMismatch = errors.New("response did not match expected checksum")
+
+// ValidateResponseCRC32Checksum validates the body of the response against
+// the CRC32 checksum of the response's X-Amz-Crc32 header, if present. The
+// body is read into memory, and replaced with a reader of the bytes read.
+//
+// The checksum is of the body as encoded by the response's Content-Encoding,
+// so a gzip encoded body is validated before it is decoded. A body the HTTP
+// client transparently decoded, reported by the response's Uncompressed field,
+// no longer matches the checksum and is not validated. An error wrapping
+// ErrChecksumMismatch is returned if the checksum does not match.
+func ValidateResponseCRC32Checksum(resp *smithyhttp.Response) error {
+	v := resp.Header.Get(crc32ChecksumHeader)
+	if len(v) == 0 || resp.Uncompressed {
+		return nil
+	}
+	c, err := strconv.ParseUint(v, 10, 32)
+	if err != nil {
+		return fmt.Errorf("unable to parse checksum header %v, %w", v, err)
+	}
+	expect := uint32(c)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return fmt.Errorf("unable to read response body, %w", err)
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	if actual := crc32.ChecksumIEEE(body); actual != expect {
+		return fmt.Errorf("%w, %d, %d", ErrChecksumMismatch, expect, actual)
+	}
+	return nil
+}
+
+// AddValidateResponseCRC32ChecksumMiddleware adds a middleware to the stack
+// that validates the CRC32 checksum of response bodies with
+// ValidateResponseCRC32Checksum. A response whose checksum does not match
+// fails the operation with an error wrapping ErrChecksumMismatch.
+func AddValidateResponseCRC32ChecksumMiddleware(stack *middleware.Stack) error {
+	return stack.Deserialize.Add(&validateResponseCRC32Checksum{}, middleware.After)
+}
+
+// validateResponseCRC32Checksum validates the CRC32 checksum of the response
+// body.
+type validateResponseCRC32Checksum struct{}
+
+// ID returns the id of the middleware
+func (*validateResponseCRC32Checksum) ID() string {
+	return "ValidateResponseCRC32Checksum"
+}
+
+// HandleDeserialize implements the DeserializeMiddleware interface
+func (m *validateResponseCRC32Checksum) HandleDeserialize(
+	ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler,
+) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if err := ValidateResponseCRC32Checksum(response); err != nil {
+		return out, metadata, &smithy.DeserializationError{Err: err}
+	}
+
+	return out, metadata, err
+}
@@ -0,0 +1,121 @@
+package http
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"hash/crc32"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestValidateResponseCRC32ChecksumMiddleware(t *testing.T) {
+	content := []byte(`{"TableNames":["table"]}`)
+
+	var gzipped bytes.Buffer
+	w := gzip.NewWriter(&gzipped)
+	w.Write(content)
+	w.Close()
+
+	corrupted := append([]byte{}, gzipped.Bytes()...)
+	corrupted[len(corrupted)-9] ^= 0xff
+
+	checksum := func(b []byte) string {
+		return strconv.FormatUint(uint64(crc32.ChecksumIEEE(b)), 10)
+	}
+
+	cases := map[string]struct {
+		Header       http.Header
+		Body         []byte
+		Uncompressed bool
+		ExpectErr    bool
+	}{
+		"no checksum": {
+			Header: http.Header{},
+			Body:   content,
+		},
+		"matching checksum": {
+			Header: http.Header{"X-Amz-Crc32": []string{checksum(content)}},
+			Body:   content,
+		},
+		"mismatched checksum": {
+			Header:    http.Header{"X-Amz-Crc32": []string{checksum([]byte("other"))}},
+			Body:      content,
+			ExpectErr: true,
+		},
+		"gzip checksum of encoded body": {
+			Header: http.Header{
+				"Content-Encoding": []string{"gzip"},
+				"X-Amz-Crc32":      []string{checksum(gzipped.Bytes())},
+			},
+			Body: gzipped.Bytes(),
+		},
+		"gzip checksum of decoded content": {
+			Header: http.Header{
+				"Content-Encoding": []string{"gzip"},
+				"X-Amz-Crc32":      []string{checksum(content)},
+			},
+			Body:      gzipped.Bytes(),
+			ExpectErr: true,
+		},
+		"gzip corrupted body": {
+			Header: http.Header{
+				"Content-Encoding": []string{"gzip"},
+				"X-Amz-Crc32":      []string{checksum(gzipped.Bytes())},
+			},
+			Body:      corrupted,
+			ExpectErr: true,
+		},
+		"decoded by http client": {
+			Header: http.Header{
+				"X-Amz-Crc32": []string{checksum(gzipped.Bytes())},
+			},
+			Body:         content,
+			Uncompressed: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := &validateResponseCRC32Checksum{}
+			out, _, err := m.HandleDeserialize(context.Background(), middleware.DeserializeInput{},
+				middleware.DeserializeHandlerFunc(func(ctx context.Context, in middleware.DeserializeInput) (
+					out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+				) {
+					out.RawResponse = &smithyhttp.Response{
+						Response: &http.Response{
+							StatusCode:   200,
+							Header:       c.Header,
+							Body:         ioutil.NopCloser(bytes.NewReader(c.Body)),
+							Uncompressed: c.Uncompressed,
+						},
+					}
+					return out, metadata, err
+				}),
+			)
+			if c.ExpectErr {
+				if !errors.Is(err, ErrChecksumMismatch) {
+					t.Fatalf("expect checksum mismatch error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			body, err := ioutil.ReadAll(out.RawResponse.(*smithyhttp.Response).Body)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.Body, body; !bytes.Equal(e, a) {
+				t.Errorf("expect body to be unmodified, got %q", a)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// ErrResponseTooLarge is an error when the response body is larger than
+// the maximum number of bytes the response was configured to allow.
+type ErrResponseTooLarge struct {
+	MaxBytes int64
+}
+
+func (e *ErrResponseTooLarge) Error() string {
+	return fmt.Sprintf("response body exceeds maximum size of %d bytes", e.MaxBytes)
+}
+
+// limitedReadCloser returns an ErrResponseTooLarge once more than maxBytes
+// have been read from the underlying reader. The error is returned by all
+// reads after the limit is exceeded, without reading the underlying reader.
+type limitedReadCloser struct {
+	reader   io.ReadCloser
+	maxBytes int64
+	read     int64
+	err      error
+}
+
+func (r *limitedReadCloser) Read(b []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	n, err := r.reader.Read(b)
+	r.read += int64(n)
+	if r.read > r.maxBytes {
+		r.err = &ErrResponseTooLarge{MaxBytes: r.maxBytes}
+		return n - int(r.read-r.maxBytes), r.err
+	}
+	return n, err
+}
+
+func (r *limitedReadCloser) Close() error {
+	return r.reader.Close()
+}
+
+// AddResponseSizeLimitMiddleware adds a middleware to the stack that wraps the
+// response body so that reading more than maxBytes from the body will return
+// an ErrResponseTooLarge. If maxBytes is zero or less, the middleware is
+// not added, and the response body size is unlimited.
+func AddResponseSizeLimitMiddleware(stack *middleware.Stack, maxBytes int64) error {
+	if maxBytes <= 0 {
+		return nil
+	}
+	return stack.Deserialize.Add(&responseSizeLimit{maxBytes: maxBytes}, middleware.After)
+}
+
+// responseSizeLimit wraps the response body with a limitedReadCloser
+type responseSizeLimit struct {
+	maxBytes int64
+}
+
+// ID returns the id of the middleware
+func (*responseSizeLimit) ID() string {
+	return "ResponseSizeLimit"
+}
+
+// HandleDeserialize implements the DeserializeMiddleware interface
+func (m *responseSizeLimit) HandleDeserialize(
+	ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler,
+) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+
+	response, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+
+	if response.ContentLength > m.maxBytes {
+		response.Body.Close()
+		return out, metadata, &ErrResponseTooLarge{MaxBytes: m.maxBytes}
+	}
+
+	response.Body = &limitedReadCloser{
+		reader:   response.Body,
+		maxBytes: m.maxBytes,
+	}
+	out.RawResponse = response
+
+	return out, metadata, err
+}
@@ -0,0 +1,123 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestResponseSizeLimitMiddleware(t *testing.T) {
+	const maxBytes = 10
+
+	cases := map[string]struct {
+		Body          string
+		ContentLength int64
+		ExpectErr     bool
+	}{
+		"under limit": {
+			Body: strings.Repeat("a", maxBytes-1),
+		},
+		"at limit": {
+			Body: strings.Repeat("a", maxBytes),
+		},
+		"over limit": {
+			Body:      strings.Repeat("a", maxBytes+1),
+			ExpectErr: true,
+		},
+		"over limit content length": {
+			Body:          strings.Repeat("a", maxBytes+1),
+			ContentLength: maxBytes + 1,
+			ExpectErr:     true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := &responseSizeLimit{maxBytes: maxBytes}
+			out, _, err := m.HandleDeserialize(context.Background(), middleware.DeserializeInput{},
+				middleware.DeserializeHandlerFunc(func(ctx context.Context, in middleware.DeserializeInput) (
+					out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+				) {
+					out.RawResponse = &smithyhttp.Response{
+						Response: &http.Response{
+							StatusCode:    200,
+							ContentLength: c.ContentLength,
+							Body:          ioutil.NopCloser(bytes.NewReader([]byte(c.Body))),
+						},
+					}
+					return out, metadata, err
+				}),
+			)
+			if err == nil {
+				_, err = ioutil.ReadAll(out.RawResponse.(*smithyhttp.Response).Body)
+			}
+
+			if !c.ExpectErr {
+				if err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+				return
+			}
+
+			var tooLargeErr *ErrResponseTooLarge
+			if !errors.As(err, &tooLargeErr) {
+				t.Fatalf("expect %T error, got %v", tooLargeErr, err)
+			}
+			if e, a := int64(maxBytes), tooLargeErr.MaxBytes; e != a {
+				t.Errorf("expect %v max bytes, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestLimitedReadCloser_AfterLimit(t *testing.T) {
+	r := &limitedReadCloser{
+		reader:   ioutil.NopCloser(strings.NewReader(strings.Repeat("a", 20))),
+		maxBytes: 5,
+	}
+
+	b := make([]byte, 8)
+	n, err := r.Read(b)
+	if e, a := 5, n; e != a {
+		t.Errorf("expect %v bytes read, got %v", e, a)
+	}
+	var tooLargeErr *ErrResponseTooLarge
+	if !errors.As(err, &tooLargeErr) {
+		t.Fatalf("expect %T error, got %v", tooLargeErr, err)
+	}
+
+	// Reads after the limit was exceeded return the same error, and no bytes.
+	for i := 0; i < 2; i++ {
+		n, err = r.Read(b)
+		if e, a := 0, n; e != a {
+			t.Errorf("expect %v bytes read, got %v", e, a)
+		}
+		if e, a := error(tooLargeErr), err; e != a {
+			t.Errorf("expect %v error, got %v", e, a)
+		}
+	}
+}
+
+func TestAddResponseSizeLimitMiddleware(t *testing.T) {
+	stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+	if err := AddResponseSizeLimitMiddleware(stack, 0); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if _, ok := stack.Deserialize.Get((*responseSizeLimit)(nil).ID()); ok {
+		t.Errorf("expect middleware not to be added for unlimited size")
+	}
+
+	if err := AddResponseSizeLimitMiddleware(stack, 1024); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if _, ok := stack.Deserialize.Get((*responseSizeLimit)(nil).ID()); !ok {
+		t.Errorf("expect middleware to be added")
+	}
+}
@@ -59,6 +59,7 @@ public class AddAwsConfigFields implements GoIntegration {
                     .name(REGION_CONFIG_NAME)
                     .type(getUniversalSymbol("string"))
                     .documentation("The region to send requests to. (Required)")
+                    .withHelper()
                     .build(),
             AwsConfigField.builder()
                     .name(RETRYER_CONFIG_NAME)
@@ -79,8 +80,10 @@ public class AddAwsConfigFields implements GoIntegration {
             AwsConfigField.builder()
                     .name(CREDENTIALS_CONFIG_NAME)
                     .type(getAwsCoreSymbol("CredentialsProvider"))
-                    .documentation("The credentials object to use when signing requests.")
+                    .documentation("The credentials object to use when signing requests. Set as an operation "
+                            + "option, such as with WithCredentials, to sign that operation's requests only.")
                     .servicePredicate(AwsSignatureVersion4::isSupportedAuthentication)
+                    .withHelper()
                     .build(),
             AwsConfigField.builder()
                     .name(API_OPTIONS_CONFIG_NAME)
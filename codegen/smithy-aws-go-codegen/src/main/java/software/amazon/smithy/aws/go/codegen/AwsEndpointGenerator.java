@@ -53,9 +53,24 @@ public final class AwsEndpointGenerator implements GoIntegration {
                                 .name(ENDPOINT_RESOLVER_CONFIG_NAME)
                                 .type(SymbolUtils.createValueSymbolBuilder(EndpointGenerator.RESOLVER_INTERFACE_NAME)
                                         .build())
-                                .documentation("The service endpoint resolver.")
+                                .documentation("The service endpoint resolver. If the default endpoint resolver "
+                                        + "is used, the endpoint URL can be overridden with the environment "
+                                        + "variable named by aws.ServiceEndpointURLEnvVar, such as for sending "
+                                        + "requests to a local emulator.")
                                 .withHelper(true)
                                 .build(),
+                        ConfigField.builder()
+                                .name(EndpointGenerator.OPERATION_RESOLVER_CONFIG_NAME)
+                                .type(SymbolUtils.createValueSymbolBuilder(
+                                        "func(operation, region string) (aws.Endpoint, error)")
+                                        .addDependency(AwsGoDependency.AWS_CORE)
+                                        .build())
+                                .documentation("The per operation endpoint resolver, called with the name of the "
+                                        + "operation and the client's region before the EndpointResolver. If it "
+                                        + "returns an aws.EndpointNotFoundError the endpoint will be resolved by "
+                                        + "the EndpointResolver instead. Operation endpoint host prefixes are "
+                                        + "applied to the endpoint returned, unless it is HostnameImmutable.")
+                                .build(),
                         ConfigField.builder()
                                 .name("EndpointOptions")
                                 .type(SymbolUtils.createValueSymbolBuilder(EndpointGenerator.RESOLVER_OPTIONS)
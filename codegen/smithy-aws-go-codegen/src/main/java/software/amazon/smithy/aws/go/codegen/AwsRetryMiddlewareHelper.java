@@ -15,6 +15,7 @@
 
 package software.amazon.smithy.aws.go.codegen;
 
+import java.util.List;
 import software.amazon.smithy.codegen.core.Symbol;
 import software.amazon.smithy.codegen.core.SymbolProvider;
 import software.amazon.smithy.go.codegen.GoDelegator;
@@ -22,11 +23,22 @@ import software.amazon.smithy.go.codegen.GoSettings;
 import software.amazon.smithy.go.codegen.GoWriter;
 import software.amazon.smithy.go.codegen.SmithyGoDependency;
 import software.amazon.smithy.go.codegen.SymbolUtils;
+import software.amazon.smithy.go.codegen.integration.ConfigField;
 import software.amazon.smithy.go.codegen.integration.GoIntegration;
+import software.amazon.smithy.go.codegen.integration.MiddlewareRegistrar;
+import software.amazon.smithy.go.codegen.integration.RuntimeClientPlugin;
 import software.amazon.smithy.model.Model;
+import software.amazon.smithy.model.shapes.OperationShape;
+import software.amazon.smithy.model.shapes.ServiceShape;
+import software.amazon.smithy.model.traits.IdempotentTrait;
+import software.amazon.smithy.model.traits.ReadonlyTrait;
+import software.amazon.smithy.utils.ListUtils;
 
 public class AwsRetryMiddlewareHelper implements GoIntegration {
     public static final String ADD_RETRY_MIDDLEWARES_HELPER = "addRetryMiddlewares";
+    public static final String RETRY_BUDGET_CONFIG_NAME = "RetryBudget";
+    public static final String RETRY_TRANSIENT_NETWORK_ERRORS_CONFIG_NAME = "RetryTransientNetworkErrors";
+    private static final String ADD_RETRY_TRANSIENT_NETWORK_ERRORS_HELPER = "addRetryTransientNetworkErrors";
 
     @Override
     public void writeAdditionalFiles(
@@ -48,14 +60,76 @@ public class AwsRetryMiddlewareHelper implements GoIntegration {
 
         writer.openBlock("func $L(stack $P, o Options) error {", "}", ADD_RETRY_MIDDLEWARES_HELPER, stackSymbol,
                 () -> {
+                    writer.write("retryer := o.$L", AddAwsConfigFields.RETRYER_CONFIG_NAME);
+                    writer.openBlock("if o.$L != nil {", "}", RETRY_BUDGET_CONFIG_NAME, () -> {
+                        writer.write("retryer = $T(retryer, o.$L)", SymbolUtils.createValueSymbolBuilder(
+                                "AddWithRetryBudget", AwsGoDependency.AWS_RETRY).build(), RETRY_BUDGET_CONFIG_NAME);
+                    });
                     writer.openBlock("mo := $T{", "}", addOptions, () -> {
-                        writer.write("$L: o.$L,", AddAwsConfigFields.RETRYER_CONFIG_NAME,
-                                AddAwsConfigFields.RETRYER_CONFIG_NAME);
+                        writer.write("$L: retryer,", AddAwsConfigFields.RETRYER_CONFIG_NAME);
                         writer.write("LogRetryAttempts: o.$L.IsRetries(),",
                                 AddAwsConfigFields.LOG_MODE_CONFIG_NAME);
                     });
 
                     writer.write("return $T(stack, mo)", addRetryMiddlewares);
                 });
+        writer.write("");
+
+        writer.openBlock("func $L(stack $P, o Options) error {", "}", ADD_RETRY_TRANSIENT_NETWORK_ERRORS_HELPER,
+                stackSymbol, () -> {
+                    writer.openBlock("if !o.$L {", "}", RETRY_TRANSIENT_NETWORK_ERRORS_CONFIG_NAME, () -> {
+                        writer.write("return nil");
+                    });
+                    writer.write("return $T(stack)", SymbolUtils.createValueSymbolBuilder(
+                            "AddRetryTransientNetworkErrors", AwsGoDependency.AWS_RETRY).build());
+                });
+    }
+
+    // Transient network errors are only retried for operations modeled as read-only or idempotent, since the
+    // failed request may have been received by the service.
+    private static boolean isIdempotent(Model model, ServiceShape service, OperationShape operation) {
+        return operation.hasTrait(ReadonlyTrait.class) || operation.hasTrait(IdempotentTrait.class);
+    }
+
+    @Override
+    public List<RuntimeClientPlugin> getClientPlugins() {
+        return ListUtils.of(
+                RuntimeClientPlugin.builder()
+                        .configFields(ListUtils.of(
+                                ConfigField.builder()
+                                        .name(RETRY_BUDGET_CONFIG_NAME)
+                                        .type(SymbolUtils.createValueSymbolBuilder("RateLimiter",
+                                                AwsGoDependency.AWS_RETRY).build())
+                                        .documentation("The retry token budget every retry attempt of the "
+                                                + "client's operations must retrieve a token from, in addition to "
+                                                + "the Retryer's own retry quota. Share a budget, such as a "
+                                                + "ratelimit.TokenRateLimit, between clients to cap the retry "
+                                                + "attempts they make collectively. When the budget is exhausted "
+                                                + "the error of the failed attempt is returned without being "
+                                                + "retried. Nil means no budget.")
+                                        .build(),
+                                ConfigField.builder()
+                                        .name(RETRY_TRANSIENT_NETWORK_ERRORS_CONFIG_NAME)
+                                        .type(SymbolUtils.createValueSymbolBuilder("bool")
+                                                .putProperty(SymbolUtils.GO_UNIVERSE_TYPE, true)
+                                                .build())
+                                        .documentation("Retry transient network errors, such as connection "
+                                                + "resets, unexpected EOFs, and network timeouts, including those "
+                                                + "that occur while reading the response, for operations modeled as "
+                                                + "read-only or idempotent. Other operations are retried as "
+                                                + "determined by the Retryer, since the failed request may have "
+                                                + "been received by the service.")
+                                        .build()
+                        ))
+                        .build(),
+                RuntimeClientPlugin.builder()
+                        .operationPredicate(AwsRetryMiddlewareHelper::isIdempotent)
+                        .registerMiddleware(MiddlewareRegistrar.builder()
+                                .resolvedFunction(SymbolUtils.createValueSymbolBuilder(
+                                        ADD_RETRY_TRANSIENT_NETWORK_ERRORS_HELPER).build())
+                                .useClientOptions()
+                                .build())
+                        .build()
+        );
     }
 }
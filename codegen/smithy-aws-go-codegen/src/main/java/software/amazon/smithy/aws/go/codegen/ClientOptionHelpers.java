@@ -0,0 +1,95 @@
+/*
+ * Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License").
+ * You may not use this file except in compliance with the License.
+ * A copy of the License is located at
+ *
+ *  http://aws.amazon.com/apache2.0
+ *
+ * or in the "license" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+
+package software.amazon.smithy.aws.go.codegen;
+
+import software.amazon.smithy.codegen.core.Symbol;
+import software.amazon.smithy.codegen.core.SymbolProvider;
+import software.amazon.smithy.go.codegen.GoDelegator;
+import software.amazon.smithy.go.codegen.GoSettings;
+import software.amazon.smithy.go.codegen.GoWriter;
+import software.amazon.smithy.go.codegen.SymbolUtils;
+import software.amazon.smithy.go.codegen.integration.GoIntegration;
+import software.amazon.smithy.model.Model;
+
+/**
+ * Writes NewOptions, composing a client's Options from functional options, and the functional options of the client
+ * options that are not generated with a helper, WithHTTPClient and WithRetryMaxAttempts.
+ */
+public class ClientOptionHelpers implements GoIntegration {
+    @Override
+    public byte getOrder() {
+        return 127;
+    }
+
+    @Override
+    public void writeAdditionalFiles(
+            GoSettings settings,
+            Model model,
+            SymbolProvider symbolProvider,
+            GoDelegator goDelegator
+    ) {
+        goDelegator.useShapeWriter(settings.getService(model), this::writeOptionHelpers);
+    }
+
+    private void writeOptionHelpers(GoWriter writer) {
+        writer.writeDocs("NewOptions returns the Options composed from the functional options, such as "
+                + "WithRegion and WithCredentials. The functional options are applied in order, so later "
+                + "functional options override earlier ones.");
+        writer.write("//");
+        writer.write("//    client := New(NewOptions(");
+        writer.write("//        WithRegion(\"us-west-2\"),");
+        writer.write("//        WithRetryMaxAttempts(5),");
+        writer.write("//    ))");
+        writer.openBlock("func NewOptions(optFns ...func(*Options)) Options {", "}", () -> {
+            writer.write("var o Options");
+            writer.openBlock("for _, fn := range optFns {", "}", () -> writer.write("fn(&o)"));
+            writer.write("return o");
+        });
+        writer.write("");
+
+        writer.writeDocs(String.format("With%s returns a functional option for setting the Client's %s option.",
+                AddAwsConfigFields.HTTP_CLIENT_CONFIG_NAME, AddAwsConfigFields.HTTP_CLIENT_CONFIG_NAME));
+        writer.openBlock("func With$L(v $L) func(*Options) {", "}", AddAwsConfigFields.HTTP_CLIENT_CONFIG_NAME,
+                AddAwsConfigFields.HTTP_CLIENT_CONFIG_NAME, () -> {
+                    writer.openBlock("return func(o *Options) {", "}", () -> {
+                        writer.write("o.$L = v", AddAwsConfigFields.HTTP_CLIENT_CONFIG_NAME);
+                    });
+                });
+        writer.write("");
+
+        Symbol newStandard = SymbolUtils.createValueSymbolBuilder("NewStandard", AwsGoDependency.AWS_RETRY).build();
+        Symbol standardOptions = SymbolUtils.createPointableSymbolBuilder("StandardOptions",
+                AwsGoDependency.AWS_RETRY).build();
+        Symbol addWithMaxAttempts = SymbolUtils.createValueSymbolBuilder("AddWithMaxAttempts",
+                AwsGoDependency.AWS_RETRY).build();
+        writer.writeDocs("WithRetryMaxAttempts returns a functional option for setting the maximum number of "
+                + "attempts of the Client's Retryer option, wrapping the Retryer if one is set, or the default "
+                + "standard retryer otherwise.");
+        writer.openBlock("func WithRetryMaxAttempts(v int) func(*Options) {", "}", () -> {
+            writer.openBlock("return func(o *Options) {", "}", () -> {
+                writer.openBlock("if o.$L == nil {", "}", AddAwsConfigFields.RETRYER_CONFIG_NAME, () -> {
+                    writer.openBlock("o.$L = $T(func(so $P) {", "})", AddAwsConfigFields.RETRYER_CONFIG_NAME,
+                            newStandard, standardOptions, () -> writer.write("so.MaxAttempts = v"));
+                    writer.write("return");
+                });
+                writer.write("o.$L = $T(o.$L, v)", AddAwsConfigFields.RETRYER_CONFIG_NAME, addWithMaxAttempts,
+                        AddAwsConfigFields.RETRYER_CONFIG_NAME);
+            });
+        });
+        writer.write("");
+    }
+}
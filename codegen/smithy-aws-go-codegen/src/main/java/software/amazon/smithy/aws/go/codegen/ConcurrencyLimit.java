@@ -0,0 +1,104 @@
+/*
+ * Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License").
+ * You may not use this file except in compliance with the License.
+ * A copy of the License is located at
+ *
+ *  http://aws.amazon.com/apache2.0
+ *
+ * or in the "license" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package software.amazon.smithy.aws.go.codegen;
+
+import java.util.List;
+import software.amazon.smithy.codegen.core.Symbol;
+import software.amazon.smithy.codegen.core.SymbolProvider;
+import software.amazon.smithy.go.codegen.GoDelegator;
+import software.amazon.smithy.go.codegen.GoSettings;
+import software.amazon.smithy.go.codegen.SmithyGoDependency;
+import software.amazon.smithy.go.codegen.SymbolUtils;
+import software.amazon.smithy.go.codegen.integration.ConfigField;
+import software.amazon.smithy.go.codegen.integration.GoIntegration;
+import software.amazon.smithy.go.codegen.integration.MiddlewareRegistrar;
+import software.amazon.smithy.go.codegen.integration.RuntimeClientPlugin;
+import software.amazon.smithy.model.Model;
+import software.amazon.smithy.utils.ListUtils;
+
+/**
+ * Adds the MaxConcurrentRequests client option, limiting the number of operations the client invokes
+ * concurrently.
+ */
+public class ConcurrencyLimit implements GoIntegration {
+    private static final String MAX_CONCURRENT_REQUESTS_OPTION = "MaxConcurrentRequests";
+    private static final String CONCURRENCY_LIMITER_OPTION = "concurrencyLimiter";
+    private static final String MIDDLEWARE_HELPER = "addConcurrencyLimit";
+    private static final String RESOLVER_HELPER = "resolveConcurrencyLimiter";
+
+    @Override
+    public byte getOrder() {
+        return 127;
+    }
+
+    @Override
+    public void writeAdditionalFiles(
+            GoSettings settings,
+            Model model,
+            SymbolProvider symbolProvider,
+            GoDelegator goDelegator
+    ) {
+        goDelegator.useShapeWriter(settings.getService(model), writer -> {
+            Symbol stackSymbol = SymbolUtils.createPointableSymbolBuilder("Stack", SmithyGoDependency.SMITHY_MIDDLEWARE)
+                    .build();
+            Symbol sharedLimiter = SymbolUtils.createValueSymbolBuilder("SharedConcurrencyLimiter",
+                    AwsGoDependency.AWS_MIDDLEWARE).build();
+            Symbol addLimit = SymbolUtils.createValueSymbolBuilder("AddConcurrencyLimitMiddleware",
+                    AwsGoDependency.AWS_MIDDLEWARE).build();
+
+            writer.openBlock("func $L(o *Options) {", "}", RESOLVER_HELPER, () -> {
+                writer.write("o.$L = &$T{}", CONCURRENCY_LIMITER_OPTION, sharedLimiter);
+            });
+            writer.write("");
+
+            writer.openBlock("func $L(stack $P, o Options) error {", "}", MIDDLEWARE_HELPER, stackSymbol, () -> {
+                writer.write("return $T(stack, o.$L.Get(o.$L))", addLimit, CONCURRENCY_LIMITER_OPTION,
+                        MAX_CONCURRENT_REQUESTS_OPTION);
+            });
+            writer.write("");
+        });
+    }
+
+    @Override
+    public List<RuntimeClientPlugin> getClientPlugins() {
+        return ListUtils.of(RuntimeClientPlugin.builder()
+                .configFields(ListUtils.of(
+                        ConfigField.builder()
+                                .name(MAX_CONCURRENT_REQUESTS_OPTION)
+                                .type(SymbolUtils.createValueSymbolBuilder("int")
+                                        .putProperty(SymbolUtils.GO_UNIVERSE_TYPE, true)
+                                        .build())
+                                .documentation("The maximum number of operations the client invokes concurrently. "
+                                        + "Operations over the limit wait until another operation completes, or "
+                                        + "their context is done. The limit is shared by all operations of the "
+                                        + "client, and cannot be changed per operation. Zero means unlimited.")
+                                .build(),
+                        ConfigField.builder()
+                                .name(CONCURRENCY_LIMITER_OPTION)
+                                .type(SymbolUtils.createPointableSymbolBuilder("SharedConcurrencyLimiter",
+                                        AwsGoDependency.AWS_MIDDLEWARE).build())
+                                .documentation("The limiter enforcing MaxConcurrentRequests, shared by the "
+                                        + "client's operations.")
+                                .build()
+                ))
+                .resolveFunction(SymbolUtils.createValueSymbolBuilder(RESOLVER_HELPER).build())
+                .registerMiddleware(MiddlewareRegistrar.builder()
+                        .resolvedFunction(SymbolUtils.createValueSymbolBuilder(MIDDLEWARE_HELPER).build())
+                        .useClientOptions()
+                        .build())
+                .build());
+    }
+}
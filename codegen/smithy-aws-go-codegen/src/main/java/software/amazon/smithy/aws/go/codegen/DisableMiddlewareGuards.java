@@ -0,0 +1,75 @@
+/*
+ * Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License").
+ * You may not use this file except in compliance with the License.
+ * A copy of the License is located at
+ *
+ *  http://aws.amazon.com/apache2.0
+ *
+ * or in the "license" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+
+package software.amazon.smithy.aws.go.codegen;
+
+import java.util.List;
+import software.amazon.smithy.codegen.core.Symbol;
+import software.amazon.smithy.codegen.core.SymbolProvider;
+import software.amazon.smithy.go.codegen.GoDelegator;
+import software.amazon.smithy.go.codegen.GoSettings;
+import software.amazon.smithy.go.codegen.SmithyGoDependency;
+import software.amazon.smithy.go.codegen.SymbolUtils;
+import software.amazon.smithy.go.codegen.integration.GoIntegration;
+import software.amazon.smithy.go.codegen.integration.MiddlewareRegistrar;
+import software.amazon.smithy.go.codegen.integration.RuntimeClientPlugin;
+import software.amazon.smithy.model.Model;
+import software.amazon.smithy.utils.ListUtils;
+
+/**
+ * Registers the guards allowing the removable middleware of an operation, such as the endpoint host prefix, to be
+ * disabled for a call with awsmiddleware.DisableMiddleware.
+ *
+ * <p>The guards wrap the middleware already added to the operation's stack, so this integration must be listed
+ * after every other integration that registers middleware.
+ */
+public class DisableMiddlewareGuards implements GoIntegration {
+    private static final String MIDDLEWARE_HELPER = "addDisableMiddlewareGuards";
+
+    @Override
+    public byte getOrder() {
+        return 127;
+    }
+
+    @Override
+    public void writeAdditionalFiles(
+            GoSettings settings,
+            Model model,
+            SymbolProvider symbolProvider,
+            GoDelegator goDelegator
+    ) {
+        goDelegator.useShapeWriter(settings.getService(model), writer -> {
+            Symbol stackSymbol = SymbolUtils.createPointableSymbolBuilder("Stack", SmithyGoDependency.SMITHY_MIDDLEWARE)
+                    .build();
+            Symbol addGuards = SymbolUtils.createValueSymbolBuilder("AddDisableMiddlewareGuards",
+                    AwsGoDependency.AWS_MIDDLEWARE).build();
+
+            writer.openBlock("func $L(stack $P) error {", "}", MIDDLEWARE_HELPER, stackSymbol, () -> {
+                writer.write("return $T(stack)", addGuards);
+            });
+            writer.write("");
+        });
+    }
+
+    @Override
+    public List<RuntimeClientPlugin> getClientPlugins() {
+        return ListUtils.of(RuntimeClientPlugin.builder()
+                .registerMiddleware(MiddlewareRegistrar.builder()
+                        .resolvedFunction(SymbolUtils.createValueSymbolBuilder(MIDDLEWARE_HELPER).build())
+                        .build())
+                .build());
+    }
+}
@@ -53,6 +53,8 @@ public class EndpointGenerator implements Runnable {
     public static final String CLIENT_CONFIG_RESOLVER = "resolveDefaultEndpointConfiguration";
     public static final String RESOLVER_CONSTRUCTOR_NAME = "NewDefaultEndpointResolver";
     public static final String AWS_ENDPOINT_RESOLVER_HELPER = "withEndpointResolver";
+    public static final String OPERATION_RESOLVER_CONFIG_NAME = "EndpointResolverFunc";
+    private static final String OPERATION_ENDPOINT_RESOLVER_HELPER = "withOperationEndpointResolver";
     private static final String EndpointResolverFromURL = "EndpointResolverFromURL";
     private static final String ENDPOINT_SOURCE_CUSTOM = "EndpointSourceCustom";
     private static final Symbol AWS_ENDPOINT = SymbolUtils.createPointableSymbolBuilder(
@@ -158,6 +160,7 @@ public class EndpointGenerator implements Runnable {
                 generatePublicResolverTypes(writer);
                 generateMiddleware(writer);
                 generateAwsEndpointResolverWrapper(writer);
+                generateOperationEndpointResolverWrapper(writer);
             });
         }
 
@@ -227,6 +230,45 @@ public class EndpointGenerator implements Runnable {
                 });
     }
 
+    private void generateOperationEndpointResolverWrapper(GoWriter writer) {
+        Symbol resolverInterface = SymbolUtils.createValueSymbolBuilder(RESOLVER_INTERFACE_NAME).build();
+        Symbol resolverFunc = SymbolUtils.createValueSymbolBuilder(RESOLVER_FUNC_NAME).build();
+        Symbol resolverOptions = SymbolUtils.createValueSymbolBuilder(RESOLVER_OPTIONS).build();
+        Symbol awsEndpoint = SymbolUtils.createValueSymbolBuilder("Endpoint", AwsGoDependency.AWS_CORE).build();
+
+        writer.write("");
+        writer.writeDocs(String.format("%s returns an EndpointResolver that first delegates endpoint resolution "
+                        + "to the operation resolver fn. If fn returns aws.EndpointNotFoundError error, the resolver "
+                        + "will use the provided fallbackResolver for resolution. The endpoint's signing region "
+                        + "defaults to the region if not set by fn.",
+                OPERATION_ENDPOINT_RESOLVER_HELPER));
+        writer.openBlock("func $L(operation string, fn func(operation, region string) ($T, error), "
+                        + "fallbackResolver $T) $T {", "}", OPERATION_ENDPOINT_RESOLVER_HELPER, awsEndpoint,
+                resolverInterface, resolverInterface, () -> {
+                    writer.openBlock("return $T(func(region string, options $T) ($T, error) {", "})",
+                            resolverFunc, resolverOptions, awsEndpoint, () -> {
+                                writer.write("endpoint, err := fn(operation, region)");
+                                writer.openBlock("if err == nil {", "}", () -> {
+                                    writer.openBlock("if len(endpoint.SigningRegion) == 0 {", "}", () -> {
+                                        writer.write("endpoint.SigningRegion = region");
+                                    });
+                                    writer.write("return endpoint, nil");
+                                });
+                                writer.addUseImports(SmithyGoDependency.ERRORS);
+                                writer.openBlock("if nf := (&$T{}); !errors.As(err, &nf) {", "}",
+                                        SymbolUtils.createValueSymbolBuilder("EndpointNotFoundError",
+                                                AwsGoDependency.AWS_CORE).build(),
+                                        () -> writer.write("return endpoint, err"));
+                                writer.openBlock("if fallbackResolver == nil {", "}", () -> {
+                                    writer.addUseImports(SmithyGoDependency.FMT);
+                                    writer.write("return endpoint, fmt.Errorf(\"default endpoint resolver provided "
+                                            + "was nil\")");
+                                });
+                                writer.write("return fallbackResolver.ResolveEndpoint(region, options)");
+                            });
+                });
+    }
+
     private void generateMiddleware(GoWriter writer) {
         // Generate middleware definition
         GoStackStepMiddlewareGenerator middleware = GoStackStepMiddlewareGenerator.createSerializeStepMiddleware(
@@ -240,12 +282,30 @@ public class EndpointGenerator implements Runnable {
         // Generate Middleware Adder Helper
         writer.openBlock("func $L(stack $P, o Options) error {", "}", ADD_MIDDLEWARE_HELPER_NAME, stackSymbol, () -> {
             writer.addUseImports(SmithyGoDependency.SMITHY_MIDDLEWARE);
+            writer.write("resolver := o.$L", RESOLVER_INTERFACE_NAME);
+            writer.openBlock("if o.$L != nil {", "", OPERATION_RESOLVER_CONFIG_NAME, () -> {
+                writer.write("resolver = $L(stack.ID(), o.$L, resolver)", OPERATION_ENDPOINT_RESOLVER_HELPER,
+                        OPERATION_RESOLVER_CONFIG_NAME);
+            });
+            writer.openBlock("} else if _, ok := resolver.($P); ok {", "}",
+                    getInternalEndpointsSymbol(INTERNAL_RESOLVER_NAME, true).build(), () -> {
+                        writer.write("// The endpoint URL from the environment overrides the default endpoint");
+                        writer.write("// resolver, but not an endpoint resolver configured by the caller.");
+                        writer.openBlock("if endpointURL := $T(ServiceID); len(endpointURL) != 0 {", "}",
+                                SymbolUtils.createValueSymbolBuilder("ServiceEndpointURLFromEnv",
+                                        AwsGoDependency.AWS_CORE).build(), () -> {
+                                    writer.openBlock("resolver = $L(endpointURL, func(e $P) {", "})",
+                                            EndpointResolverFromURL, AWS_ENDPOINT, () -> {
+                                                writer.write("e.HostnameImmutable = true");
+                                            });
+                                });
+                    });
             String closeBlock = String.format("}, \"%s\", middleware.Before)",
                     ProtocolUtils.OPERATION_SERIALIZER_MIDDLEWARE_ID);
             writer.openBlock("return stack.Serialize.Insert(&$T{", closeBlock,
                     middleware.getMiddlewareSymbol(),
                     () -> {
-                        writer.write("Resolver: o.EndpointResolver,");
+                        writer.write("Resolver: resolver,");
                         writer.write("Options: o.EndpointOptions,");
                     });
         });
@@ -0,0 +1,88 @@
+/*
+ * Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License").
+ * You may not use this file except in compliance with the License.
+ * A copy of the License is located at
+ *
+ *  http://aws.amazon.com/apache2.0
+ *
+ * or in the "license" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package software.amazon.smithy.aws.go.codegen;
+
+import java.util.List;
+import software.amazon.smithy.codegen.core.Symbol;
+import software.amazon.smithy.codegen.core.SymbolProvider;
+import software.amazon.smithy.go.codegen.GoDelegator;
+import software.amazon.smithy.go.codegen.GoSettings;
+import software.amazon.smithy.go.codegen.SmithyGoDependency;
+import software.amazon.smithy.go.codegen.SymbolUtils;
+import software.amazon.smithy.go.codegen.integration.ConfigField;
+import software.amazon.smithy.go.codegen.integration.GoIntegration;
+import software.amazon.smithy.go.codegen.integration.MiddlewareRegistrar;
+import software.amazon.smithy.go.codegen.integration.RuntimeClientPlugin;
+import software.amazon.smithy.model.Model;
+import software.amazon.smithy.utils.ListUtils;
+
+/**
+ * Adds the OnResponseBody client option, called with a copy of the body of each response the client
+ * receives.
+ */
+public class ResponseBodyObserver implements GoIntegration {
+    private static final String ON_RESPONSE_BODY_OPTION = "OnResponseBody";
+    private static final String MIDDLEWARE_HELPER = "addOnResponseBody";
+
+    @Override
+    public byte getOrder() {
+        return 127;
+    }
+
+    @Override
+    public void writeAdditionalFiles(
+            GoSettings settings,
+            Model model,
+            SymbolProvider symbolProvider,
+            GoDelegator goDelegator
+    ) {
+        goDelegator.useShapeWriter(settings.getService(model), writer -> {
+            Symbol stackSymbol = SymbolUtils.createPointableSymbolBuilder("Stack", SmithyGoDependency.SMITHY_MIDDLEWARE)
+                    .build();
+            Symbol addResponseBody = SymbolUtils.createValueSymbolBuilder("AddResponseBodyMiddleware",
+                    AwsGoDependency.AWS_HTTP_TRANSPORT).build();
+
+            writer.openBlock("func $L(stack $P, o Options) error {", "}", MIDDLEWARE_HELPER, stackSymbol, () -> {
+                writer.write("return $T(stack, o.$L)", addResponseBody, ON_RESPONSE_BODY_OPTION);
+            });
+            writer.write("");
+        });
+    }
+
+    @Override
+    public List<RuntimeClientPlugin> getClientPlugins() {
+        return ListUtils.of(RuntimeClientPlugin.builder()
+                .configFields(ListUtils.of(
+                        ConfigField.builder()
+                                .name(ON_RESPONSE_BODY_OPTION)
+                                .type(SymbolUtils.createValueSymbolBuilder(
+                                        "func(opName string, status int, body []byte)")
+                                        .putProperty(SymbolUtils.GO_UNIVERSE_TYPE, true)
+                                        .build())
+                                .documentation("The function called with the name of the operation, and the "
+                                        + "status code and a copy of the body of each response the client "
+                                        + "receives, before the response is deserialized, such as for auditing. "
+                                        + "The body is empty if the response has no body. The response body is "
+                                        + "read into memory in order to be copied.")
+                                .build()
+                ))
+                .registerMiddleware(MiddlewareRegistrar.builder()
+                        .resolvedFunction(SymbolUtils.createValueSymbolBuilder(MIDDLEWARE_HELPER).build())
+                        .useClientOptions()
+                        .build())
+                .build());
+    }
+}
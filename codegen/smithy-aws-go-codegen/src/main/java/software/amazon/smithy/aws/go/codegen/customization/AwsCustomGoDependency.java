@@ -42,6 +42,8 @@ public final class AwsCustomGoDependency extends AwsGoDependency {
             "service/route53/internal/customizations", "route53cust");
     public static final GoDependency PRESIGNEDURL_CUSTOMIZATION = awsModuleDep(
             "service/internal/presigned-url", null, Versions.INTERNAL_PRESIGNURL, "presignedurlcust");
+    public static final GoDependency TIME_RATE = GoDependency.moduleDependency(
+            "golang.org/x/time", "golang.org/x/time/rate", Versions.TIME_RATE, "rate");
 
     private AwsCustomGoDependency() {
         super();
@@ -51,5 +53,6 @@ public final class AwsCustomGoDependency extends AwsGoDependency {
         private static final String INTERNAL_S3SHARED = "v1.0.0";
         private static final String INTERNAL_ACCEPTENCODING = "v1.0.0";
         private static final String INTERNAL_PRESIGNURL = "v1.0.0";
+        private static final String TIME_RATE = "v0.0.0-20210220033141-f8bda1e9f3ba";
     }
 }
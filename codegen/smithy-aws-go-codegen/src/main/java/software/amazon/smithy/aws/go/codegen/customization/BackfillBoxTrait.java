@@ -24,6 +24,7 @@ import software.amazon.smithy.go.codegen.GoSettings;
 import software.amazon.smithy.go.codegen.integration.GoIntegration;
 import software.amazon.smithy.model.Model;
 import software.amazon.smithy.model.shapes.IntegerShape;
+import software.amazon.smithy.model.shapes.Shape;
 import software.amazon.smithy.model.shapes.ShapeId;
 import software.amazon.smithy.model.traits.BoxTrait;
 import software.amazon.smithy.utils.MapUtils;
@@ -42,6 +43,10 @@ public class BackfillBoxTrait implements GoIntegration {
     public static final Map<ShapeId, Set<ShapeId>> SERVICE_TO_MEMBER_MAP = MapUtils.of(
             ShapeId.from("com.amazonaws.s3control#AWSS3ControlServiceV20180820"), SetUtils.of(
                     ShapeId.from("com.amazonaws.s3control#S3ExpirationInDays")
+            ),
+            // Boxed so an explicit Record Version of 0 is serialized.
+            ShapeId.from("com.amazonaws.timestreamwrite#Timestream_20181101"), SetUtils.of(
+                    ShapeId.from("com.amazonaws.timestreamwrite#RecordVersion")
             ));
 
     /**
@@ -63,14 +68,20 @@ public class BackfillBoxTrait implements GoIntegration {
 
         Set<ShapeId> shapeIds = SERVICE_TO_MEMBER_MAP.get(serviceId);
         for (ShapeId shapeId : shapeIds) {
-            IntegerShape shape = model.expectShape(shapeId, IntegerShape.class);
+            Shape shape = model.expectShape(shapeId);
             if (shape.getTrait(BoxTrait.class).isPresent()) {
                 LOGGER.warning("BoxTrait is present in model and does not require backfill");
                 continue;
             }
-            builder.addShape(shape.toBuilder()
-                    .addTrait(new BoxTrait())
-                    .build());
+            if (shape.isLongShape()) {
+                builder.addShape(shape.asLongShape().get().toBuilder()
+                        .addTrait(new BoxTrait())
+                        .build());
+            } else {
+                builder.addShape(model.expectShape(shapeId, IntegerShape.class).toBuilder()
+                        .addTrait(new BoxTrait())
+                        .build());
+            }
         }
 
         return builder.build();
@@ -0,0 +1,80 @@
+/*
+ * Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License").
+ * You may not use this file except in compliance with the License.
+ * A copy of the License is located at
+ *
+ *  http://aws.amazon.com/apache2.0
+ *
+ * or in the "license" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package software.amazon.smithy.aws.go.codegen.customization;
+
+import java.util.List;
+import software.amazon.smithy.aws.traits.ServiceTrait;
+import software.amazon.smithy.go.codegen.SymbolUtils;
+import software.amazon.smithy.go.codegen.integration.ConfigField;
+import software.amazon.smithy.go.codegen.integration.GoIntegration;
+import software.amazon.smithy.go.codegen.integration.MiddlewareRegistrar;
+import software.amazon.smithy.go.codegen.integration.RuntimeClientPlugin;
+import software.amazon.smithy.model.Model;
+import software.amazon.smithy.model.shapes.OperationShape;
+import software.amazon.smithy.model.shapes.ServiceShape;
+import software.amazon.smithy.utils.ListUtils;
+
+/**
+ * Adds the DialOutAllowlist client option to Chime. The addDialOutAllowlist helper, checking the phone
+ * numbers called by CreateMeetingDialOut, is hand written in the service's package.
+ */
+public class ChimeDialOutAllowlist implements GoIntegration {
+    private static final String DIAL_OUT_ALLOWLIST_OPTION = "DialOutAllowlist";
+    private static final String DIAL_OUT_ALLOWLIST_ADDER = "addDialOutAllowlist";
+
+    @Override
+    public byte getOrder() {
+        return 127;
+    }
+
+    @Override
+    public List<RuntimeClientPlugin> getClientPlugins() {
+        return ListUtils.of(
+                RuntimeClientPlugin.builder()
+                        .servicePredicate(ChimeDialOutAllowlist::isChime)
+                        .configFields(ListUtils.of(
+                                ConfigField.builder()
+                                        .name(DIAL_OUT_ALLOWLIST_OPTION)
+                                        .type(SymbolUtils.createValueSymbolBuilder("[]string")
+                                                .putProperty(SymbolUtils.GO_UNIVERSE_TYPE, true)
+                                                .build())
+                                        .documentation("The phone number prefixes, including the country code, such as "
+                                                + "\"+1\", that CreateMeetingDialOut is allowed to call. Calls to a "
+                                                + "ToPhoneNumber that does not start with one of the prefixes fail "
+                                                + "with a *DialOutNotAllowedError without being made. If empty, any "
+                                                + "phone number is allowed.")
+                                        .build()
+                        ))
+                        .build(),
+                RuntimeClientPlugin.builder()
+                        .operationPredicate(ChimeDialOutAllowlist::isCreateMeetingDialOut)
+                        .registerMiddleware(MiddlewareRegistrar.builder()
+                                .resolvedFunction(SymbolUtils.createValueSymbolBuilder(DIAL_OUT_ALLOWLIST_ADDER)
+                                        .build())
+                                .useClientOptions()
+                                .build())
+                        .build()
+        );
+    }
+
+    private static boolean isCreateMeetingDialOut(Model model, ServiceShape service, OperationShape operation) {
+        return isChime(model, service) && operation.getId().getName().equals("CreateMeetingDialOut");
+    }
+
+    private static boolean isChime(Model model, ServiceShape service) {
+        return service.expectTrait(ServiceTrait.class).getSdkId().equalsIgnoreCase("Chime");
+    }
+}
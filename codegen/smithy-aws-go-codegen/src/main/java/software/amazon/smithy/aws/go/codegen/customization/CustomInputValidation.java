@@ -0,0 +1,74 @@
+/*
+ * Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License").
+ * You may not use this file except in compliance with the License.
+ * A copy of the License is located at
+ *
+ *  http://aws.amazon.com/apache2.0
+ *
+ * or in the "license" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package software.amazon.smithy.aws.go.codegen.customization;
+
+import java.util.ArrayList;
+import java.util.List;
+import java.util.Map;
+import java.util.Set;
+import software.amazon.smithy.aws.traits.ServiceTrait;
+import software.amazon.smithy.go.codegen.SymbolUtils;
+import software.amazon.smithy.go.codegen.integration.GoIntegration;
+import software.amazon.smithy.go.codegen.integration.MiddlewareRegistrar;
+import software.amazon.smithy.go.codegen.integration.RuntimeClientPlugin;
+import software.amazon.smithy.model.shapes.OperationShape;
+import software.amazon.smithy.model.shapes.ServiceShape;
+import software.amazon.smithy.utils.MapUtils;
+import software.amazon.smithy.utils.SetUtils;
+
+/**
+ * Registers the input validation of operations whose constraints are not modeled, such as that one of two members
+ * must be set. The addOp{Operation}CustomValidationMiddleware helper of each operation is hand written in the
+ * service's validators_custom.go, and runs after the operation's modeled input validation.
+ */
+public class CustomInputValidation implements GoIntegration {
+    // operations with custom input validation, by service sdkId.
+    private static final Map<String, Set<String>> CUSTOMIZED_OPERATIONS = MapUtils.of(
+            "EC2", SetUtils.of("CreateVpcEndpointServiceConfiguration"),
+            "IoTSiteWise", SetUtils.of("DescribeAsset"),
+            "Network Firewall", SetUtils.of("UpdateFirewallDeleteProtection"),
+            "Timestream Write", SetUtils.of("CreateTable", "UpdateTable", "WriteRecords"));
+
+    @Override
+    public byte getOrder() {
+        return 127;
+    }
+
+    @Override
+    public List<RuntimeClientPlugin> getClientPlugins() {
+        List<RuntimeClientPlugin> plugins = new ArrayList<>();
+        CUSTOMIZED_OPERATIONS.forEach((sdkId, operations) -> operations.forEach(operationName -> plugins.add(
+                RuntimeClientPlugin.builder()
+                        .operationPredicate((model, service, operation) ->
+                                isOperation(service, operation, sdkId, operationName))
+                        .registerMiddleware(MiddlewareRegistrar.builder()
+                                .resolvedFunction(SymbolUtils.createValueSymbolBuilder(
+                                        "addOp" + operationName + "CustomValidationMiddleware").build())
+                                .build())
+                        .build())));
+        return plugins;
+    }
+
+    private static boolean isOperation(
+            ServiceShape service,
+            OperationShape operation,
+            String sdkId,
+            String operationName
+    ) {
+        return service.expectTrait(ServiceTrait.class).getSdkId().equalsIgnoreCase(sdkId)
+                && operation.getId().getName().equals(operationName);
+    }
+}
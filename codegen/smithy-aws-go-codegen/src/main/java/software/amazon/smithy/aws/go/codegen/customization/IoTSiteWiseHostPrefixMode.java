@@ -0,0 +1,65 @@
+/*
+ * Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License").
+ * You may not use this file except in compliance with the License.
+ * A copy of the License is located at
+ *
+ *  http://aws.amazon.com/apache2.0
+ *
+ * or in the "license" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package software.amazon.smithy.aws.go.codegen.customization;
+
+import java.util.List;
+import software.amazon.smithy.aws.traits.ServiceTrait;
+import software.amazon.smithy.go.codegen.SymbolUtils;
+import software.amazon.smithy.go.codegen.integration.ConfigField;
+import software.amazon.smithy.go.codegen.integration.GoIntegration;
+import software.amazon.smithy.go.codegen.integration.MiddlewareRegistrar;
+import software.amazon.smithy.go.codegen.integration.RuntimeClientPlugin;
+import software.amazon.smithy.model.Model;
+import software.amazon.smithy.model.shapes.ServiceShape;
+import software.amazon.smithy.utils.ListUtils;
+
+/**
+ * Adds the HostPrefixMode client option to IoT SiteWise. The HostPrefixMode type and the
+ * addHostPrefixMode helper are hand written in the service's package.
+ */
+public class IoTSiteWiseHostPrefixMode implements GoIntegration {
+    private static final String HOST_PREFIX_MODE_OPTION = "HostPrefixMode";
+    private static final String HOST_PREFIX_MODE_ADDER = "addHostPrefixMode";
+
+    @Override
+    public byte getOrder() {
+        return 127;
+    }
+
+    @Override
+    public List<RuntimeClientPlugin> getClientPlugins() {
+        return ListUtils.of(RuntimeClientPlugin.builder()
+                .servicePredicate(IoTSiteWiseHostPrefixMode::isIoTSiteWise)
+                .configFields(ListUtils.of(
+                        ConfigField.builder()
+                                .name(HOST_PREFIX_MODE_OPTION)
+                                .type(SymbolUtils.createValueSymbolBuilder("HostPrefixMode").build())
+                                .documentation("The mode the host prefix of an operation's endpoint, such as the "
+                                        + "\"model.\" prefix of DescribeAsset, is applied with. Defaults to "
+                                        + "HostPrefixModeAuto.")
+                                .build()
+                ))
+                .registerMiddleware(MiddlewareRegistrar.builder()
+                        .resolvedFunction(SymbolUtils.createValueSymbolBuilder(HOST_PREFIX_MODE_ADDER).build())
+                        .useClientOptions()
+                        .build())
+                .build());
+    }
+
+    private static boolean isIoTSiteWise(Model model, ServiceShape service) {
+        return service.expectTrait(ServiceTrait.class).getSdkId().equalsIgnoreCase("IoTSiteWise");
+    }
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License").
+ * You may not use this file except in compliance with the License.
+ * A copy of the License is located at
+ *
+ *  http://aws.amazon.com/apache2.0
+ *
+ * or in the "license" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package software.amazon.smithy.aws.go.codegen.customization;
+
+import java.util.List;
+import software.amazon.smithy.aws.go.codegen.AwsGoDependency;
+import software.amazon.smithy.aws.traits.ServiceTrait;
+import software.amazon.smithy.codegen.core.Symbol;
+import software.amazon.smithy.codegen.core.SymbolProvider;
+import software.amazon.smithy.go.codegen.GoDelegator;
+import software.amazon.smithy.go.codegen.GoSettings;
+import software.amazon.smithy.go.codegen.SmithyGoDependency;
+import software.amazon.smithy.go.codegen.SymbolUtils;
+import software.amazon.smithy.go.codegen.integration.ConfigField;
+import software.amazon.smithy.go.codegen.integration.GoIntegration;
+import software.amazon.smithy.go.codegen.integration.MiddlewareRegistrar;
+import software.amazon.smithy.go.codegen.integration.RuntimeClientPlugin;
+import software.amazon.smithy.model.Model;
+import software.amazon.smithy.model.shapes.ServiceShape;
+import software.amazon.smithy.utils.ListUtils;
+
+/**
+ * Adds the PrettyJSON client option to DynamoDB and Timestream Write, indenting the serialized JSON
+ * body of the client's requests when enabled.
+ */
+public class JsonPrettyPrint implements GoIntegration {
+    private static final String PRETTY_JSON_OPTION = "PrettyJSON";
+    private static final String MIDDLEWARE_HELPER = "addPrettyJSON";
+
+    @Override
+    public byte getOrder() {
+        return 127;
+    }
+
+    @Override
+    public void writeAdditionalFiles(
+            GoSettings settings,
+            Model model,
+            SymbolProvider symbolProvider,
+            GoDelegator goDelegator
+    ) {
+        if (!hasPrettyJSON(model, settings.getService(model))) {
+            return;
+        }
+
+        goDelegator.useShapeWriter(settings.getService(model), writer -> {
+            Symbol stackSymbol = SymbolUtils.createPointableSymbolBuilder("Stack", SmithyGoDependency.SMITHY_MIDDLEWARE)
+                    .build();
+            Symbol addPrettyJSON = SymbolUtils.createValueSymbolBuilder("AddPrettyJSONMiddleware",
+                    AwsGoDependency.AWS_HTTP_TRANSPORT).build();
+
+            writer.openBlock("func $L(stack $P, o Options) error {", "}", MIDDLEWARE_HELPER, stackSymbol, () -> {
+                writer.openBlock("if !o.$L {", "}", PRETTY_JSON_OPTION, () -> writer.write("return nil"));
+                writer.write("return $T(stack)", addPrettyJSON);
+            });
+            writer.write("");
+        });
+    }
+
+    @Override
+    public List<RuntimeClientPlugin> getClientPlugins() {
+        return ListUtils.of(RuntimeClientPlugin.builder()
+                .servicePredicate(JsonPrettyPrint::hasPrettyJSON)
+                .configFields(ListUtils.of(
+                        ConfigField.builder()
+                                .name(PRETTY_JSON_OPTION)
+                                .type(SymbolUtils.createValueSymbolBuilder("bool")
+                                        .putProperty(SymbolUtils.GO_UNIVERSE_TYPE, true)
+                                        .build())
+                                .documentation("Indents the serialized JSON body of requests, for debugging. The "
+                                        + "indented body is sent, and is the body logged and passed to "
+                                        + "OnRequestBody. Disabled by default.")
+                                .build()
+                ))
+                .registerMiddleware(MiddlewareRegistrar.builder()
+                        .resolvedFunction(SymbolUtils.createValueSymbolBuilder(MIDDLEWARE_HELPER).build())
+                        .useClientOptions()
+                        .build())
+                .build());
+    }
+
+    private static boolean hasPrettyJSON(Model model, ServiceShape service) {
+        String sdkId = service.expectTrait(ServiceTrait.class).getSdkId();
+        return sdkId.equalsIgnoreCase("DynamoDB")
+                || sdkId.equalsIgnoreCase("Timestream Write");
+    }
+}
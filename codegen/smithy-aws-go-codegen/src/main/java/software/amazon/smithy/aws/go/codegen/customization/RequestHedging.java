@@ -0,0 +1,120 @@
+/*
+ * Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License").
+ * You may not use this file except in compliance with the License.
+ * A copy of the License is located at
+ *
+ *  http://aws.amazon.com/apache2.0
+ *
+ * or in the "license" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package software.amazon.smithy.aws.go.codegen.customization;
+
+import java.util.List;
+import java.util.Map;
+import java.util.Set;
+import software.amazon.smithy.aws.traits.ServiceTrait;
+import software.amazon.smithy.go.codegen.SmithyGoDependency;
+import software.amazon.smithy.go.codegen.SymbolUtils;
+import software.amazon.smithy.go.codegen.integration.ConfigField;
+import software.amazon.smithy.go.codegen.integration.GoIntegration;
+import software.amazon.smithy.go.codegen.integration.MiddlewareRegistrar;
+import software.amazon.smithy.go.codegen.integration.RuntimeClientPlugin;
+import software.amazon.smithy.model.Model;
+import software.amazon.smithy.model.shapes.OperationShape;
+import software.amazon.smithy.model.shapes.ServiceShape;
+import software.amazon.smithy.utils.ListUtils;
+import software.amazon.smithy.utils.MapUtils;
+import software.amazon.smithy.utils.SetUtils;
+
+/**
+ * Adds the HedgeAfter client option to the services with hedged operations, and registers the
+ * addRequestHedging helper, hand written for each of the services, on the hedged operations.
+ */
+public class RequestHedging implements GoIntegration {
+    private static final String HEDGE_AFTER_OPTION = "HedgeAfter";
+    private static final String HEDGING_ADDER = "addRequestHedging";
+
+    // The idempotent operations, by service sdkId, that requests are hedged for.
+    private static final Map<String, Set<String>> HEDGED_OPERATIONS = MapUtils.of(
+            "IoTSiteWise", SetUtils.of(
+                    "DescribeAccessPolicy",
+                    "DescribeAsset",
+                    "DescribeAssetModel",
+                    "DescribeAssetProperty",
+                    "DescribeDashboard",
+                    "DescribeDefaultEncryptionConfiguration",
+                    "DescribeGateway",
+                    "DescribeGatewayCapabilityConfiguration",
+                    "DescribeLoggingOptions",
+                    "DescribePortal",
+                    "DescribeProject",
+                    "GetAssetPropertyAggregates",
+                    "GetAssetPropertyValue",
+                    "GetAssetPropertyValueHistory",
+                    "ListAccessPolicies",
+                    "ListAssetModels",
+                    "ListAssetRelationships",
+                    "ListAssets",
+                    "ListAssociatedAssets",
+                    "ListDashboards",
+                    "ListGateways",
+                    "ListPortals",
+                    "ListProjectAssets",
+                    "ListProjects",
+                    "ListTagsForResource"),
+            "Timestream Write", SetUtils.of(
+                    "DescribeDatabase",
+                    "DescribeEndpoints",
+                    "DescribeTable",
+                    "ListDatabases",
+                    "ListTables",
+                    "ListTagsForResource"));
+
+    @Override
+    public byte getOrder() {
+        return 127;
+    }
+
+    @Override
+    public List<RuntimeClientPlugin> getClientPlugins() {
+        return ListUtils.of(
+                RuntimeClientPlugin.builder()
+                        .servicePredicate(RequestHedging::hasHedgedOperations)
+                        .configFields(ListUtils.of(
+                                ConfigField.builder()
+                                        .name(HEDGE_AFTER_OPTION)
+                                        .type(SymbolUtils.createValueSymbolBuilder("Duration",
+                                                SmithyGoDependency.TIME).build())
+                                        .documentation("The duration after which a second, identical, request is "
+                                                + "sent if a response to the first request has not been received. "
+                                                + "The first response received is used, and the other request is "
+                                                + "canceled. Only applies to the idempotent Describe, Get, and List "
+                                                + "operations of the client. Zero disables hedging.")
+                                        .build()
+                        ))
+                        .build(),
+                RuntimeClientPlugin.builder()
+                        .operationPredicate(RequestHedging::isHedgedOperation)
+                        .registerMiddleware(MiddlewareRegistrar.builder()
+                                .resolvedFunction(SymbolUtils.createValueSymbolBuilder(HEDGING_ADDER).build())
+                                .useClientOptions()
+                                .build())
+                        .build()
+        );
+    }
+
+    private static boolean isHedgedOperation(Model model, ServiceShape service, OperationShape operation) {
+        String sdkId = service.expectTrait(ServiceTrait.class).getSdkId();
+        return HEDGED_OPERATIONS.getOrDefault(sdkId, SetUtils.of()).contains(operation.getId().getName());
+    }
+
+    private static boolean hasHedgedOperations(Model model, ServiceShape service) {
+        return HEDGED_OPERATIONS.containsKey(service.expectTrait(ServiceTrait.class).getSdkId());
+    }
+}
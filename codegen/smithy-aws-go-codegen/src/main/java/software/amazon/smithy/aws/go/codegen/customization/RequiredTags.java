@@ -0,0 +1,96 @@
+/*
+ * Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License").
+ * You may not use this file except in compliance with the License.
+ * A copy of the License is located at
+ *
+ *  http://aws.amazon.com/apache2.0
+ *
+ * or in the "license" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+
+package software.amazon.smithy.aws.go.codegen.customization;
+
+import java.util.ArrayList;
+import java.util.List;
+import java.util.Set;
+import software.amazon.smithy.aws.traits.ServiceTrait;
+import software.amazon.smithy.codegen.core.Symbol;
+import software.amazon.smithy.go.codegen.SymbolUtils;
+import software.amazon.smithy.go.codegen.integration.ConfigField;
+import software.amazon.smithy.go.codegen.integration.GoIntegration;
+import software.amazon.smithy.go.codegen.integration.MiddlewareRegistrar;
+import software.amazon.smithy.go.codegen.integration.RuntimeClientPlugin;
+import software.amazon.smithy.model.Model;
+import software.amazon.smithy.model.shapes.ServiceShape;
+import software.amazon.smithy.utils.ListUtils;
+import software.amazon.smithy.utils.SetUtils;
+
+/**
+ * Adds the RequiredTags client option to EC2 and Timestream Write, and registers the addRequiredTags helper,
+ * validating the tags of the services' create operations, on those operations. The helper is hand written in each
+ * service's package.
+ */
+public class RequiredTags implements GoIntegration {
+    private static final String REQUIRED_TAGS_OPTION = "RequiredTags";
+    private static final String REQUIRED_TAGS_ADDER = "addRequiredTags";
+
+    @Override
+    public byte getOrder() {
+        return 127;
+    }
+
+    @Override
+    public List<RuntimeClientPlugin> getClientPlugins() {
+        List<RuntimeClientPlugin> plugins = new ArrayList<>();
+        plugins.addAll(requiredTagsPlugins("EC2", SetUtils.of("CreateVpcEndpointServiceConfiguration"),
+                "The tag keys the TagSpecifications of the client's CreateVpcEndpointServiceConfiguration "
+                        + "operations must include. Operations missing any of the keys fail with a parameter "
+                        + "validation error, without the request being sent."));
+        plugins.addAll(requiredTagsPlugins("Timestream Write", SetUtils.of("CreateDatabase", "CreateTable"),
+                "The tag keys the Tags of the client's CreateDatabase and CreateTable operations must include. "
+                        + "Operations missing any of the keys fail with a parameter validation error, without the "
+                        + "request being sent."));
+        return plugins;
+    }
+
+    private static List<RuntimeClientPlugin> requiredTagsPlugins(
+            String sdkId,
+            Set<String> operations,
+            String documentation
+    ) {
+        Symbol stringSlice = SymbolUtils.createValueSymbolBuilder("[]string")
+                .putProperty(SymbolUtils.GO_UNIVERSE_TYPE, true)
+                .build();
+
+        return ListUtils.of(
+                RuntimeClientPlugin.builder()
+                        .servicePredicate((model, service) -> isService(model, service, sdkId))
+                        .configFields(ListUtils.of(
+                                ConfigField.builder()
+                                        .name(REQUIRED_TAGS_OPTION)
+                                        .type(stringSlice)
+                                        .documentation(documentation)
+                                        .build()
+                        ))
+                        .build(),
+                RuntimeClientPlugin.builder()
+                        .operationPredicate((model, service, operation) -> isService(model, service, sdkId)
+                                && operations.contains(operation.getId().getName()))
+                        .registerMiddleware(MiddlewareRegistrar.builder()
+                                .resolvedFunction(SymbolUtils.createValueSymbolBuilder(REQUIRED_TAGS_ADDER).build())
+                                .useClientOptions()
+                                .build())
+                        .build()
+        );
+    }
+
+    private static boolean isService(Model model, ServiceShape service, String sdkId) {
+        return service.expectTrait(ServiceTrait.class).getSdkId().equalsIgnoreCase(sdkId);
+    }
+}
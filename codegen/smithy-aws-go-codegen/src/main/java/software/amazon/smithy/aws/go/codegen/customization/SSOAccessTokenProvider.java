@@ -0,0 +1,65 @@
+/*
+ * Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License").
+ * You may not use this file except in compliance with the License.
+ * A copy of the License is located at
+ *
+ *  http://aws.amazon.com/apache2.0
+ *
+ * or in the "license" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package software.amazon.smithy.aws.go.codegen.customization;
+
+import java.util.List;
+import software.amazon.smithy.aws.traits.ServiceTrait;
+import software.amazon.smithy.go.codegen.SymbolUtils;
+import software.amazon.smithy.go.codegen.integration.ConfigField;
+import software.amazon.smithy.go.codegen.integration.GoIntegration;
+import software.amazon.smithy.go.codegen.integration.MiddlewareRegistrar;
+import software.amazon.smithy.go.codegen.integration.RuntimeClientPlugin;
+import software.amazon.smithy.model.Model;
+import software.amazon.smithy.model.shapes.ServiceShape;
+import software.amazon.smithy.utils.ListUtils;
+
+/**
+ * Adds the AccessTokenProvider client option to SSO. The AccessTokenProvider interface and the
+ * addAccessTokenProvider helper are hand written in the service's package.
+ */
+public class SSOAccessTokenProvider implements GoIntegration {
+    private static final String ACCESS_TOKEN_PROVIDER_OPTION = "AccessTokenProvider";
+    private static final String ACCESS_TOKEN_PROVIDER_ADDER = "addAccessTokenProvider";
+
+    @Override
+    public byte getOrder() {
+        return 127;
+    }
+
+    @Override
+    public List<RuntimeClientPlugin> getClientPlugins() {
+        return ListUtils.of(RuntimeClientPlugin.builder()
+                .servicePredicate(SSOAccessTokenProvider::isSSO)
+                .configFields(ListUtils.of(
+                        ConfigField.builder()
+                                .name(ACCESS_TOKEN_PROVIDER_OPTION)
+                                .type(SymbolUtils.createValueSymbolBuilder("AccessTokenProvider").build())
+                                .documentation("The provider of the SSO access token used by operations whose "
+                                        + "AccessToken input parameter is nil, such as a FileAccessTokenProvider. "
+                                        + "Nil means the AccessToken must be set on each operation's input.")
+                                .build()
+                ))
+                .registerMiddleware(MiddlewareRegistrar.builder()
+                        .resolvedFunction(SymbolUtils.createValueSymbolBuilder(ACCESS_TOKEN_PROVIDER_ADDER).build())
+                        .useClientOptions()
+                        .build())
+                .build());
+    }
+
+    private static boolean isSSO(Model model, ServiceShape service) {
+        return service.expectTrait(ServiceTrait.class).getSdkId().equalsIgnoreCase("SSO");
+    }
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License").
+ * You may not use this file except in compliance with the License.
+ * A copy of the License is located at
+ *
+ *  http://aws.amazon.com/apache2.0
+ *
+ * or in the "license" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package software.amazon.smithy.aws.go.codegen.customization;
+
+import java.util.List;
+import software.amazon.smithy.aws.traits.ServiceTrait;
+import software.amazon.smithy.go.codegen.SymbolUtils;
+import software.amazon.smithy.go.codegen.integration.ConfigField;
+import software.amazon.smithy.go.codegen.integration.GoIntegration;
+import software.amazon.smithy.go.codegen.integration.MiddlewareRegistrar;
+import software.amazon.smithy.go.codegen.integration.RuntimeClientPlugin;
+import software.amazon.smithy.model.Model;
+import software.amazon.smithy.model.shapes.OperationShape;
+import software.amazon.smithy.model.shapes.ServiceShape;
+import software.amazon.smithy.utils.ListUtils;
+
+/**
+ * Adds the DefaultTimeUnit client option to Timestream Write. The addDefaultTimeUnit helper, setting
+ * the TimeUnit of WriteRecords records, is hand written in the service's package.
+ */
+public class TimestreamWriteDefaultTimeUnit implements GoIntegration {
+    private static final String DEFAULT_TIME_UNIT_OPTION = "DefaultTimeUnit";
+    private static final String DEFAULT_TIME_UNIT_ADDER = "addDefaultTimeUnit";
+    private static final String TYPES_PACKAGE = "github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types";
+
+    @Override
+    public byte getOrder() {
+        return 127;
+    }
+
+    @Override
+    public List<RuntimeClientPlugin> getClientPlugins() {
+        return ListUtils.of(
+                RuntimeClientPlugin.builder()
+                        .servicePredicate(TimestreamWriteDefaultTimeUnit::isTimestreamWrite)
+                        .configFields(ListUtils.of(
+                                ConfigField.builder()
+                                        .name(DEFAULT_TIME_UNIT_OPTION)
+                                        .type(SymbolUtils.createValueSymbolBuilder("TimeUnit", TYPES_PACKAGE).build())
+                                        .documentation("The TimeUnit of WriteRecords records that do not set a "
+                                                + "TimeUnit, either themselves or in the request's CommonAttributes. "
+                                                + "If empty, the service assumes MILLISECONDS for such records.")
+                                        .build()
+                        ))
+                        .build(),
+                RuntimeClientPlugin.builder()
+                        .operationPredicate(TimestreamWriteDefaultTimeUnit::isWriteRecords)
+                        .registerMiddleware(MiddlewareRegistrar.builder()
+                                .resolvedFunction(SymbolUtils.createValueSymbolBuilder(DEFAULT_TIME_UNIT_ADDER).build())
+                                .useClientOptions()
+                                .build())
+                        .build()
+        );
+    }
+
+    private static boolean isWriteRecords(Model model, ServiceShape service, OperationShape operation) {
+        return isTimestreamWrite(model, service) && operation.getId().getName().equals("WriteRecords");
+    }
+
+    private static boolean isTimestreamWrite(Model model, ServiceShape service) {
+        return service.expectTrait(ServiceTrait.class).getSdkId().equalsIgnoreCase("Timestream Write");
+    }
+}
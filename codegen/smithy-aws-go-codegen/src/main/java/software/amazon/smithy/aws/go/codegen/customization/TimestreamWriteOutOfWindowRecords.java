@@ -0,0 +1,89 @@
+/*
+ * Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License").
+ * You may not use this file except in compliance with the License.
+ * A copy of the License is located at
+ *
+ *  http://aws.amazon.com/apache2.0
+ *
+ * or in the "license" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package software.amazon.smithy.aws.go.codegen.customization;
+
+import java.util.List;
+import software.amazon.smithy.aws.go.codegen.AwsGoDependency;
+import software.amazon.smithy.aws.traits.ServiceTrait;
+import software.amazon.smithy.go.codegen.SymbolUtils;
+import software.amazon.smithy.go.codegen.integration.ConfigField;
+import software.amazon.smithy.go.codegen.integration.GoIntegration;
+import software.amazon.smithy.go.codegen.integration.MiddlewareRegistrar;
+import software.amazon.smithy.go.codegen.integration.RuntimeClientPlugin;
+import software.amazon.smithy.model.Model;
+import software.amazon.smithy.model.shapes.OperationShape;
+import software.amazon.smithy.model.shapes.ServiceShape;
+import software.amazon.smithy.utils.ListUtils;
+
+/**
+ * Adds the RejectOutOfWindowRecords client option to Timestream Write. The resolveTableRetentionCache
+ * and addRejectOutOfWindowRecords helpers are hand written in the service's package.
+ */
+public class TimestreamWriteOutOfWindowRecords implements GoIntegration {
+    private static final String OUT_OF_WINDOW_RECORDS_OPTION = "RejectOutOfWindowRecords";
+    private static final String TABLE_RETENTION_CACHE_OPTION = "tableRetentionCache";
+    private static final String TABLE_RETENTION_CACHE_RESOLVER = "resolveTableRetentionCache";
+    private static final String OUT_OF_WINDOW_RECORDS_ADDER = "addRejectOutOfWindowRecords";
+
+    @Override
+    public byte getOrder() {
+        return 127;
+    }
+
+    @Override
+    public List<RuntimeClientPlugin> getClientPlugins() {
+        return ListUtils.of(
+                RuntimeClientPlugin.builder()
+                        .servicePredicate(TimestreamWriteOutOfWindowRecords::isTimestreamWrite)
+                        .configFields(ListUtils.of(
+                                ConfigField.builder()
+                                        .name(OUT_OF_WINDOW_RECORDS_OPTION)
+                                        .type(SymbolUtils.createValueSymbolBuilder("OutOfWindowRecordsMode").build())
+                                        .documentation("How WriteRecords handles records with a Time before the end of "
+                                                + "the table's memory store window, which the service rejects. The "
+                                                + "window is computed from the table's memory store retention, "
+                                                + "retrieved with DescribeTable and cached by the client. Defaults to "
+                                                + "OutOfWindowRecordsModeSend, sending every record.")
+                                        .build(),
+                                ConfigField.builder()
+                                        .name(TABLE_RETENTION_CACHE_OPTION)
+                                        .type(SymbolUtils.createPointableSymbolBuilder("DescribeCache",
+                                                AwsGoDependency.AWS_CORE).build())
+                                        .documentation("The cache of described tables used by "
+                                                + "RejectOutOfWindowRecords, shared by the client's operations.")
+                                        .build()
+                        ))
+                        .resolveFunction(SymbolUtils.createValueSymbolBuilder(TABLE_RETENTION_CACHE_RESOLVER).build())
+                        .build(),
+                RuntimeClientPlugin.builder()
+                        .operationPredicate(TimestreamWriteOutOfWindowRecords::isWriteRecords)
+                        .registerMiddleware(MiddlewareRegistrar.builder()
+                                .resolvedFunction(SymbolUtils.createValueSymbolBuilder(OUT_OF_WINDOW_RECORDS_ADDER)
+                                        .build())
+                                .useClientOptions()
+                                .build())
+                        .build()
+        );
+    }
+
+    private static boolean isWriteRecords(Model model, ServiceShape service, OperationShape operation) {
+        return isTimestreamWrite(model, service) && operation.getId().getName().equals("WriteRecords");
+    }
+
+    private static boolean isTimestreamWrite(Model model, ServiceShape service) {
+        return service.expectTrait(ServiceTrait.class).getSdkId().equalsIgnoreCase("Timestream Write");
+    }
+}
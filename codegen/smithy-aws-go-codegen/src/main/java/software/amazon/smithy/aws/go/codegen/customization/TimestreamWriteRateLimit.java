@@ -0,0 +1,88 @@
+/*
+ * Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License").
+ * You may not use this file except in compliance with the License.
+ * A copy of the License is located at
+ *
+ *  http://aws.amazon.com/apache2.0
+ *
+ * or in the "license" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package software.amazon.smithy.aws.go.codegen.customization;
+
+import java.util.List;
+import software.amazon.smithy.aws.traits.ServiceTrait;
+import software.amazon.smithy.go.codegen.SymbolUtils;
+import software.amazon.smithy.go.codegen.integration.ConfigField;
+import software.amazon.smithy.go.codegen.integration.GoIntegration;
+import software.amazon.smithy.go.codegen.integration.MiddlewareRegistrar;
+import software.amazon.smithy.go.codegen.integration.RuntimeClientPlugin;
+import software.amazon.smithy.model.Model;
+import software.amazon.smithy.model.shapes.OperationShape;
+import software.amazon.smithy.model.shapes.ServiceShape;
+import software.amazon.smithy.utils.ListUtils;
+
+/**
+ * Adds the WriteRateLimit client option to Timestream Write. The resolveWriteRateLimiter and
+ * addWriteRateLimit helpers are hand written in the service's package.
+ */
+public class TimestreamWriteRateLimit implements GoIntegration {
+    private static final String WRITE_RATE_LIMIT_OPTION = "WriteRateLimit";
+    private static final String WRITE_RATE_LIMITER_OPTION = "writeRateLimiter";
+    private static final String WRITE_RATE_LIMITER_RESOLVER = "resolveWriteRateLimiter";
+    private static final String WRITE_RATE_LIMIT_ADDER = "addWriteRateLimit";
+
+    @Override
+    public byte getOrder() {
+        return 127;
+    }
+
+    @Override
+    public List<RuntimeClientPlugin> getClientPlugins() {
+        return ListUtils.of(
+                RuntimeClientPlugin.builder()
+                        .servicePredicate(TimestreamWriteRateLimit::isTimestreamWrite)
+                        .configFields(ListUtils.of(
+                                ConfigField.builder()
+                                        .name(WRITE_RATE_LIMIT_OPTION)
+                                        .type(SymbolUtils.createValueSymbolBuilder("Limit",
+                                                AwsCustomGoDependency.TIME_RATE).build())
+                                        .documentation("The maximum rate, in requests per second, the client will send "
+                                                + "WriteRecords requests at, including retry attempts. Requests that "
+                                                + "would exceed the rate wait until they are allowed, or the "
+                                                + "operation's context is done. The limit is shared by all "
+                                                + "WriteRecords operations of the client, and cannot be changed per "
+                                                + "operation other than to zero. Zero means unlimited.")
+                                        .build(),
+                                ConfigField.builder()
+                                        .name(WRITE_RATE_LIMITER_OPTION)
+                                        .type(SymbolUtils.createPointableSymbolBuilder("sharedRateLimiter").build())
+                                        .documentation("The limiter enforcing WriteRateLimit, shared by the client's "
+                                                + "operations.")
+                                        .build()
+                        ))
+                        .resolveFunction(SymbolUtils.createValueSymbolBuilder(WRITE_RATE_LIMITER_RESOLVER).build())
+                        .build(),
+                RuntimeClientPlugin.builder()
+                        .operationPredicate(TimestreamWriteRateLimit::isWriteRecords)
+                        .registerMiddleware(MiddlewareRegistrar.builder()
+                                .resolvedFunction(SymbolUtils.createValueSymbolBuilder(WRITE_RATE_LIMIT_ADDER).build())
+                                .useClientOptions()
+                                .build())
+                        .build()
+        );
+    }
+
+    private static boolean isWriteRecords(Model model, ServiceShape service, OperationShape operation) {
+        return isTimestreamWrite(model, service) && operation.getId().getName().equals("WriteRecords");
+    }
+
+    private static boolean isTimestreamWrite(Model model, ServiceShape service) {
+        return service.expectTrait(ServiceTrait.class).getSdkId().equalsIgnoreCase("Timestream Write");
+    }
+}
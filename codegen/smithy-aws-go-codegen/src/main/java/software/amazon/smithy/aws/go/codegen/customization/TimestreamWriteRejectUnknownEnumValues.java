@@ -0,0 +1,80 @@
+/*
+ * Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License").
+ * You may not use this file except in compliance with the License.
+ * A copy of the License is located at
+ *
+ *  http://aws.amazon.com/apache2.0
+ *
+ * or in the "license" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package software.amazon.smithy.aws.go.codegen.customization;
+
+import java.util.List;
+import software.amazon.smithy.aws.traits.ServiceTrait;
+import software.amazon.smithy.go.codegen.SymbolUtils;
+import software.amazon.smithy.go.codegen.integration.ConfigField;
+import software.amazon.smithy.go.codegen.integration.GoIntegration;
+import software.amazon.smithy.go.codegen.integration.MiddlewareRegistrar;
+import software.amazon.smithy.go.codegen.integration.RuntimeClientPlugin;
+import software.amazon.smithy.model.Model;
+import software.amazon.smithy.model.shapes.OperationShape;
+import software.amazon.smithy.model.shapes.ServiceShape;
+import software.amazon.smithy.utils.ListUtils;
+
+/**
+ * Adds the RejectUnknownEnumValues client option to Timestream Write. The addRejectUnknownEnumValues helper,
+ * validating the enum values of WriteRecords records, is hand written in the service's package.
+ */
+public class TimestreamWriteRejectUnknownEnumValues implements GoIntegration {
+    private static final String REJECT_UNKNOWN_ENUM_VALUES_OPTION = "RejectUnknownEnumValues";
+    private static final String REJECT_UNKNOWN_ENUM_VALUES_ADDER = "addRejectUnknownEnumValues";
+
+    @Override
+    public byte getOrder() {
+        return 127;
+    }
+
+    @Override
+    public List<RuntimeClientPlugin> getClientPlugins() {
+        return ListUtils.of(
+                RuntimeClientPlugin.builder()
+                        .servicePredicate(TimestreamWriteRejectUnknownEnumValues::isTimestreamWrite)
+                        .configFields(ListUtils.of(
+                                ConfigField.builder()
+                                        .name(REJECT_UNKNOWN_ENUM_VALUES_OPTION)
+                                        .type(SymbolUtils.createValueSymbolBuilder("bool")
+                                                .putProperty(SymbolUtils.GO_UNIVERSE_TYPE, true)
+                                                .build())
+                                        .documentation("Reject WriteRecords operations with a record, or common "
+                                                + "attributes, whose MeasureValueType or TimeUnit is not one of the "
+                                                + "values known to the client, with a parameter validation error, "
+                                                + "without the request being sent. Values the service added after "
+                                                + "the client was released are rejected as well.")
+                                        .build()
+                        ))
+                        .build(),
+                RuntimeClientPlugin.builder()
+                        .operationPredicate(TimestreamWriteRejectUnknownEnumValues::isWriteRecords)
+                        .registerMiddleware(MiddlewareRegistrar.builder()
+                                .resolvedFunction(SymbolUtils.createValueSymbolBuilder(
+                                        REJECT_UNKNOWN_ENUM_VALUES_ADDER).build())
+                                .useClientOptions()
+                                .build())
+                        .build()
+        );
+    }
+
+    private static boolean isWriteRecords(Model model, ServiceShape service, OperationShape operation) {
+        return isTimestreamWrite(model, service) && operation.getId().getName().equals("WriteRecords");
+    }
+
+    private static boolean isTimestreamWrite(Model model, ServiceShape service) {
+        return service.expectTrait(ServiceTrait.class).getSdkId().equalsIgnoreCase("Timestream Write");
+    }
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License").
+ * You may not use this file except in compliance with the License.
+ * A copy of the License is located at
+ *
+ *  http://aws.amazon.com/apache2.0
+ *
+ * or in the "license" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package software.amazon.smithy.aws.go.codegen.customization;
+
+import java.util.List;
+import software.amazon.smithy.aws.traits.ServiceTrait;
+import software.amazon.smithy.go.codegen.SymbolUtils;
+import software.amazon.smithy.go.codegen.integration.ConfigField;
+import software.amazon.smithy.go.codegen.integration.GoIntegration;
+import software.amazon.smithy.go.codegen.integration.MiddlewareRegistrar;
+import software.amazon.smithy.go.codegen.integration.RuntimeClientPlugin;
+import software.amazon.smithy.model.Model;
+import software.amazon.smithy.model.shapes.OperationShape;
+import software.amazon.smithy.model.shapes.ServiceShape;
+import software.amazon.smithy.utils.ListUtils;
+
+/**
+ * Adds the SimulateOnly client option to Timestream Write. The addSimulateOnly helper, completing
+ * WriteRecords operations without sending the request, is hand written in the service's package.
+ */
+public class TimestreamWriteSimulateOnly implements GoIntegration {
+    private static final String SIMULATE_ONLY_OPTION = "SimulateOnly";
+    private static final String SIMULATE_ONLY_ADDER = "addSimulateOnly";
+
+    @Override
+    public byte getOrder() {
+        return 127;
+    }
+
+    @Override
+    public List<RuntimeClientPlugin> getClientPlugins() {
+        return ListUtils.of(
+                RuntimeClientPlugin.builder()
+                        .servicePredicate(TimestreamWriteSimulateOnly::isTimestreamWrite)
+                        .configFields(ListUtils.of(
+                                ConfigField.builder()
+                                        .name(SIMULATE_ONLY_OPTION)
+                                        .type(SymbolUtils.createValueSymbolBuilder("bool")
+                                                .putProperty(SymbolUtils.GO_UNIVERSE_TYPE, true)
+                                                .build())
+                                        .documentation("Completes WriteRecords operations without sending the request, "
+                                                + "after the input is validated and serialized, such as for testing "
+                                                + "how records are shaped. The request that would have been sent is "
+                                                + "returned by GetSimulatedRequest from the output's ResultMetadata. "
+                                                + "The RejectOutOfWindowRecords check is still made, which may "
+                                                + "describe the table.")
+                                        .build()
+                        ))
+                        .build(),
+                RuntimeClientPlugin.builder()
+                        .operationPredicate(TimestreamWriteSimulateOnly::isWriteRecords)
+                        .registerMiddleware(MiddlewareRegistrar.builder()
+                                .resolvedFunction(SymbolUtils.createValueSymbolBuilder(SIMULATE_ONLY_ADDER).build())
+                                .useClientOptions()
+                                .build())
+                        .build()
+        );
+    }
+
+    private static boolean isWriteRecords(Model model, ServiceShape service, OperationShape operation) {
+        return isTimestreamWrite(model, service) && operation.getId().getName().equals("WriteRecords");
+    }
+
+    private static boolean isTimestreamWrite(Model model, ServiceShape service) {
+        return service.expectTrait(ServiceTrait.class).getSdkId().equalsIgnoreCase("Timestream Write");
+    }
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License").
+ * You may not use this file except in compliance with the License.
+ * A copy of the License is located at
+ *
+ *  http://aws.amazon.com/apache2.0
+ *
+ * or in the "license" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package software.amazon.smithy.aws.go.codegen.customization;
+
+import java.util.List;
+import software.amazon.smithy.aws.traits.ServiceTrait;
+import software.amazon.smithy.go.codegen.SymbolUtils;
+import software.amazon.smithy.go.codegen.integration.ConfigField;
+import software.amazon.smithy.go.codegen.integration.GoIntegration;
+import software.amazon.smithy.go.codegen.integration.MiddlewareRegistrar;
+import software.amazon.smithy.go.codegen.integration.RuntimeClientPlugin;
+import software.amazon.smithy.model.Model;
+import software.amazon.smithy.model.shapes.ServiceShape;
+import software.amazon.smithy.utils.ListUtils;
+
+/**
+ * Adds the TargetPrefixOverride client option to Timestream Write. The addTargetPrefixOverride helper,
+ * replacing the prefix of the X-Amz-Target header, is hand written in the service's package.
+ */
+public class TimestreamWriteTargetPrefixOverride implements GoIntegration {
+    private static final String TARGET_PREFIX_OVERRIDE_OPTION = "TargetPrefixOverride";
+    private static final String TARGET_PREFIX_OVERRIDE_ADDER = "addTargetPrefixOverride";
+
+    @Override
+    public byte getOrder() {
+        return 127;
+    }
+
+    @Override
+    public List<RuntimeClientPlugin> getClientPlugins() {
+        return ListUtils.of(RuntimeClientPlugin.builder()
+                .servicePredicate(TimestreamWriteTargetPrefixOverride::isTimestreamWrite)
+                .configFields(ListUtils.of(
+                        ConfigField.builder()
+                                .name(TARGET_PREFIX_OVERRIDE_OPTION)
+                                .type(SymbolUtils.createValueSymbolBuilder("string")
+                                        .putProperty(SymbolUtils.GO_UNIVERSE_TYPE, true)
+                                        .build())
+                                .documentation("The target prefix, in place of Timestream_20181101, of the "
+                                        + "X-Amz-Target header of the client's requests, such as for testing "
+                                        + "against a mock server that expects a different API version. If empty, "
+                                        + "the prefix is unchanged.")
+                                .build()
+                ))
+                .registerMiddleware(MiddlewareRegistrar.builder()
+                        .resolvedFunction(SymbolUtils.createValueSymbolBuilder(TARGET_PREFIX_OVERRIDE_ADDER).build())
+                        .useClientOptions()
+                        .build())
+                .build());
+    }
+
+    private static boolean isTimestreamWrite(Model model, ServiceShape service) {
+        return service.expectTrait(ServiceTrait.class).getSdkId().equalsIgnoreCase("Timestream Write");
+    }
+}
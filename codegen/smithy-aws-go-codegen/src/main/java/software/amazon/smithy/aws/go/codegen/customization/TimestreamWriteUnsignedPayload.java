@@ -0,0 +1,95 @@
+/*
+ * Copyright 2020 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License").
+ * You may not use this file except in compliance with the License.
+ * A copy of the License is located at
+ *
+ *  http://aws.amazon.com/apache2.0
+ *
+ * or in the "license" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package software.amazon.smithy.aws.go.codegen.customization;
+
+import java.util.List;
+import java.util.Set;
+import software.amazon.smithy.aws.traits.ServiceTrait;
+import software.amazon.smithy.go.codegen.SymbolUtils;
+import software.amazon.smithy.go.codegen.integration.ConfigField;
+import software.amazon.smithy.go.codegen.integration.GoIntegration;
+import software.amazon.smithy.go.codegen.integration.MiddlewareRegistrar;
+import software.amazon.smithy.go.codegen.integration.RuntimeClientPlugin;
+import software.amazon.smithy.model.Model;
+import software.amazon.smithy.model.shapes.OperationShape;
+import software.amazon.smithy.model.shapes.ServiceShape;
+import software.amazon.smithy.utils.ListUtils;
+import software.amazon.smithy.utils.SetUtils;
+
+/**
+ * Adds the UseUnsignedPayload client option to Timestream Write, and registers the addUnsignedPayload
+ * helper, hand written in the service's package, on the write operations.
+ */
+public class TimestreamWriteUnsignedPayload implements GoIntegration {
+    private static final String UNSIGNED_PAYLOAD_OPTION = "UseUnsignedPayload";
+    private static final String UNSIGNED_PAYLOAD_ADDER = "addUnsignedPayload";
+
+    // The write operations whose requests are signed with an unsigned payload.
+    private static final Set<String> WRITE_OPERATIONS = SetUtils.of(
+            "CreateDatabase",
+            "CreateTable",
+            "DeleteDatabase",
+            "DeleteTable",
+            "TagResource",
+            "UntagResource",
+            "UpdateDatabase",
+            "UpdateTable",
+            "WriteRecords");
+
+    @Override
+    public byte getOrder() {
+        return 127;
+    }
+
+    @Override
+    public List<RuntimeClientPlugin> getClientPlugins() {
+        return ListUtils.of(
+                RuntimeClientPlugin.builder()
+                        .servicePredicate(TimestreamWriteUnsignedPayload::isTimestreamWrite)
+                        .configFields(ListUtils.of(
+                                ConfigField.builder()
+                                        .name(UNSIGNED_PAYLOAD_OPTION)
+                                        .type(SymbolUtils.createValueSymbolBuilder("bool")
+                                                .putProperty(SymbolUtils.GO_UNIVERSE_TYPE, true)
+                                                .build())
+                                        .documentation("Sign the requests of the client's write operations with "
+                                                + "an unsigned payload, when sent over HTTPS, instead of the SHA256 "
+                                                + "hash of the payload. This avoids hashing large WriteRecords "
+                                                + "payloads, but the payload is no longer covered by the request's "
+                                                + "signature, and its integrity is protected by TLS alone. Requests "
+                                                + "sent over HTTP are always signed with the payload hash.")
+                                        .build()
+                        ))
+                        .build(),
+                RuntimeClientPlugin.builder()
+                        .operationPredicate(TimestreamWriteUnsignedPayload::isWriteOperation)
+                        .registerMiddleware(MiddlewareRegistrar.builder()
+                                .resolvedFunction(SymbolUtils.createValueSymbolBuilder(UNSIGNED_PAYLOAD_ADDER)
+                                        .build())
+                                .useClientOptions()
+                                .build())
+                        .build()
+        );
+    }
+
+    private static boolean isWriteOperation(Model model, ServiceShape service, OperationShape operation) {
+        return isTimestreamWrite(model, service) && WRITE_OPERATIONS.contains(operation.getId().getName());
+    }
+
+    private static boolean isTimestreamWrite(Model model, ServiceShape service) {
+        return service.expectTrait(ServiceTrait.class).getSdkId().equalsIgnoreCase("Timestream Write");
+    }
+}
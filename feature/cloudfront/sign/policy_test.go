@@ -137,3 +137,20 @@ func TestAWSEscape(t *testing.T) {
 		t.Errorf("expect: %s, actual: %s", expect, string(actual))
 	}
 }
+
+// verifyAWSEncodedSignature verifies the URL safe base64 encoded signature is
+// the RSA-SHA1 signature of the JSON policy for the public key.
+func verifyAWSEncodedSignature(t *testing.T, pubKey *rsa.PublicKey, jsonPolicy, b64Signature string) {
+	t.Helper()
+
+	unescaped := strings.NewReplacer("-", "+", "_", "=", "~", "/").Replace(b64Signature)
+	sig, err := base64.StdEncoding.DecodeString(unescaped)
+	if err != nil {
+		t.Fatalf("expect no error decoding signature, got %v", err)
+	}
+
+	hash := sha1.Sum([]byte(jsonPolicy))
+	if err := rsa.VerifyPKCS1v15(pubKey, crypto.SHA1, hash[:], sig); err != nil {
+		t.Errorf("expect signature to verify with public key, got %v", err)
+	}
+}
@@ -60,7 +60,8 @@ type CookieSigner struct {
 }
 
 // NewCookieSigner constructs and returns a new CookieSigner to be used to for
-// signing Amazon CloudFront URL resources with.
+// signing Amazon CloudFront URL resources with. The keyID is either the public
+// key ID of a public key in a trusted key group, or a CloudFront key pair ID.
 func NewCookieSigner(keyID string, privKey *rsa.PrivateKey, opts ...func(*CookieOptions)) *CookieSigner {
 	signer := &CookieSigner{
 		keyID:   keyID,
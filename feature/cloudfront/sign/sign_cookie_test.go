@@ -2,6 +2,8 @@ package sign
 
 import (
 	"crypto/rsa"
+	"encoding/base64"
+	"strings"
 	"testing"
 	"time"
 )
@@ -119,3 +121,33 @@ func TestSignCookie_WithCookieOptions(t *testing.T) {
 		}
 	}
 }
+
+func TestCookieSigner_VerifyWithPublicKey(t *testing.T) {
+	privKey, err := rsa.GenerateKey(randReader, 1024)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	const keyID = "K2JCJMDEHXQW5F"
+
+	signer := NewCookieSigner(keyID, privKey)
+	cookies, err := signer.Sign("https://example.com/*", testSignTime)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expectPolicy := `{"Statement":[{"Resource":"https://example.com/*","Condition":{"DateLessThan":{"AWS:EpochTime":1257894000}}}]}`
+	b64Policy := strings.NewReplacer("-", "+", "_", "=", "~", "/").Replace(cookies[0].Value)
+	policy, err := base64.StdEncoding.DecodeString(b64Policy)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := expectPolicy, string(policy); e != a {
+		t.Errorf("expect %v policy, got %v", e, a)
+	}
+	if e, a := keyID, cookies[2].Value; e != a {
+		t.Errorf("expect %v key ID, got %v", e, a)
+	}
+
+	verifyAWSEncodedSignature(t, &privKey.PublicKey, expectPolicy, cookies[1].Value)
+}
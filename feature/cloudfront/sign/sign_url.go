@@ -7,6 +7,12 @@
 // Once you have a URLSigner instance you can call Sign or SignWithPolicy to
 // sign the URLs.
 //
+// When using trusted key groups, the key ID is the ID of the public key
+// returned by the Amazon CloudFront CreatePublicKey API, and the public key
+// must be included in a key group created with CreateKeyGroup that is trusted
+// by the distribution's cache behavior. The private key is the key pair's
+// private key used to create the public key.
+//
 // Example:
 //
 //    // Sign URL to be valid for 1 hour from now.
@@ -37,7 +43,8 @@ type URLSigner struct {
 }
 
 // NewURLSigner constructs and returns a new URLSigner to be used to for signing
-// Amazon CloudFront URL resources with.
+// Amazon CloudFront URL resources with. The keyID is either the public key ID
+// of a public key in a trusted key group, or a CloudFront key pair ID.
 func NewURLSigner(keyID string, privKey *rsa.PrivateKey) *URLSigner {
 	return &URLSigner{
 		keyID:   keyID,
@@ -1,6 +1,8 @@
 package sign
 
 import (
+	"crypto/rsa"
+	"net/url"
 	"strings"
 	"testing"
 	"time"
@@ -137,3 +139,36 @@ func TestValidateURL(t *testing.T) {
 		}
 	}
 }
+
+func TestURLSigner_VerifyWithPublicKey(t *testing.T) {
+	privKey, err := rsa.GenerateKey(randReader, 1024)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	// Public key ID of a key registered with CreatePublicKey, and added to a
+	// key group with CreateKeyGroup.
+	const keyID = "K2JCJMDEHXQW5F"
+
+	s := NewURLSigner(keyID, privKey)
+	signed, err := s.Sign("https://example.com/a", testSignTime)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	u, err := url.Parse(signed)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	query := u.Query()
+	if e, a := keyID, query.Get("Key-Pair-Id"); e != a {
+		t.Errorf("expect %v key ID, got %v", e, a)
+	}
+	if e, a := "1257894000", query.Get("Expires"); e != a {
+		t.Errorf("expect %v expires, got %v", e, a)
+	}
+
+	verifyAWSEncodedSignature(t, &privKey.PublicKey,
+		`{"Statement":[{"Resource":"https://example.com/a","Condition":{"DateLessThan":{"AWS:EpochTime":1257894000}}}]}`,
+		query.Get("Signature"))
+}
@@ -0,0 +1,145 @@
+package awstesting
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// StubResponse is a canned response returned by the StubTransport.
+type StubResponse struct {
+	// The HTTP status code of the response. Defaults to 200.
+	StatusCode int
+
+	Header http.Header
+	Body   string
+}
+
+// StubRequest is a request sent to the StubTransport.
+type StubRequest struct {
+	// The operation of the request, or the path of the request if the
+	// operation could not be determined.
+	Operation string
+
+	Method string
+	URL    *url.URL
+	Header http.Header
+	Body   []byte
+}
+
+// StubTransport is an HTTP client that returns canned responses to requests,
+// and records the requests it is sent, for testing code using the service
+// API clients without sending requests. Use the StubTransport as the
+// HTTPClient of a service client's options.
+//
+// Responses are matched to requests by the request's operation, taken from
+// the X-Amz-Target header of JSON protocol requests, or the Action parameter
+// of query protocol requests, such as "WriteRecords" or "DescribeVpcs".
+// Requests of other protocols are matched by their path. Requests without a
+// matching response receive a 404 response with an empty body.
+//
+//    stub := awstesting.NewStubTransport()
+//    stub.Add("DescribeTable", awstesting.StubResponse{Body: `{"Table":{}}`})
+//    client := timestreamwrite.New(timestreamwrite.Options{
+//        Region:     "us-west-2",
+//        HTTPClient: stub,
+//    })
+type StubTransport struct {
+	mu        sync.Mutex
+	responses map[string][]StubResponse
+	requests  []StubRequest
+}
+
+// NewStubTransport returns a StubTransport without any responses.
+func NewStubTransport() *StubTransport {
+	return &StubTransport{
+		responses: map[string][]StubResponse{},
+	}
+}
+
+// Add adds the responses for the operation, or path, to the responses
+// returned for it. The responses are returned in order, with the last
+// response returned for any further requests.
+func (t *StubTransport) Add(operation string, responses ...StubResponse) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.responses[operation] = append(t.responses[operation], responses...)
+}
+
+// Requests returns the requests sent to the StubTransport, in the order they
+// were sent.
+func (t *StubTransport) Requests() []StubRequest {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]StubRequest(nil), t.requests...)
+}
+
+// Do records the request and returns the response for the request's
+// operation.
+func (t *StubTransport) Do(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body, %w", err)
+		}
+	}
+
+	operation := stubOperation(req, body)
+
+	t.mu.Lock()
+	t.requests = append(t.requests, StubRequest{
+		Operation: operation,
+		Method:    req.Method,
+		URL:       req.URL,
+		Header:    req.Header.Clone(),
+		Body:      body,
+	})
+	resp := StubResponse{StatusCode: http.StatusNotFound}
+	if responses := t.responses[operation]; len(responses) != 0 {
+		resp = responses[0]
+		if len(responses) > 1 {
+			t.responses[operation] = responses[1:]
+		}
+	}
+	t.mu.Unlock()
+
+	if resp.StatusCode == 0 {
+		resp.StatusCode = http.StatusOK
+	}
+	header := resp.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode)),
+		StatusCode:    resp.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader([]byte(resp.Body))),
+		ContentLength: int64(len(resp.Body)),
+		Request:       req,
+	}, nil
+}
+
+// stubOperation returns the name of the request's operation, or its path.
+func stubOperation(req *http.Request, body []byte) string {
+	if v := req.Header.Get("X-Amz-Target"); len(v) != 0 {
+		return v[strings.LastIndex(v, ".")+1:]
+	}
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(body)); err == nil && len(values.Get("Action")) != 0 {
+			return values.Get("Action")
+		}
+	}
+	return req.URL.Path
+}
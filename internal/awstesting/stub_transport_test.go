@@ -0,0 +1,93 @@
+package awstesting
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestStubTransport(t *testing.T) {
+	stub := NewStubTransport()
+	stub.Add("DescribeTable",
+		StubResponse{StatusCode: 500, Body: `{"__type":"InternalServerException"}`},
+		StubResponse{Body: `{"Table":{}}`},
+	)
+	stub.Add("/2020-05-31/key-group", StubResponse{StatusCode: 201, Body: `<KeyGroup/>`})
+
+	cases := []struct {
+		Request      func() *http.Request
+		ExpectOp     string
+		ExpectStatus int
+		ExpectBody   string
+	}{
+		{
+			Request: func() *http.Request {
+				r, _ := http.NewRequest("POST", "https://ingest.timestream.us-west-2.amazonaws.com/",
+					strings.NewReader(`{}`))
+				r.Header.Set("X-Amz-Target", "Timestream_20181101.DescribeTable")
+				return r
+			},
+			ExpectOp:     "DescribeTable",
+			ExpectStatus: 500,
+			ExpectBody:   `{"__type":"InternalServerException"}`,
+		},
+		{
+			Request: func() *http.Request {
+				r, _ := http.NewRequest("POST", "https://ingest.timestream.us-west-2.amazonaws.com/",
+					strings.NewReader(`{}`))
+				r.Header.Set("X-Amz-Target", "Timestream_20181101.DescribeTable")
+				return r
+			},
+			ExpectOp:     "DescribeTable",
+			ExpectStatus: 200,
+			ExpectBody:   `{"Table":{}}`,
+		},
+		{
+			Request: func() *http.Request {
+				r, _ := http.NewRequest("POST", "https://ec2.us-west-2.amazonaws.com/",
+					strings.NewReader(`Action=DescribeVpcs&Version=2016-11-15`))
+				r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+				return r
+			},
+			ExpectOp:     "DescribeVpcs",
+			ExpectStatus: 404,
+		},
+		{
+			Request: func() *http.Request {
+				r, _ := http.NewRequest("POST", "https://cloudfront.amazonaws.com/2020-05-31/key-group", nil)
+				return r
+			},
+			ExpectOp:     "/2020-05-31/key-group",
+			ExpectStatus: 201,
+			ExpectBody:   `<KeyGroup/>`,
+		},
+	}
+
+	for i, c := range cases {
+		resp, err := stub.Do(c.Request())
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if e, a := c.ExpectStatus, resp.StatusCode; e != a {
+			t.Errorf("%d, expect %v status code, got %v", i, e, a)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		if e, a := c.ExpectBody, string(body); e != a {
+			t.Errorf("%d, expect %v body, got %v", i, e, a)
+		}
+	}
+
+	requests := stub.Requests()
+	if e, a := len(cases), len(requests); e != a {
+		t.Fatalf("expect %v requests, got %v", e, a)
+	}
+	for i, c := range cases {
+		if e, a := c.ExpectOp, requests[i].Operation; e != a {
+			t.Errorf("%d, expect %v operation, got %v", i, e, a)
+		}
+	}
+	if e, a := `Action=DescribeVpcs&Version=2016-11-15`, string(requests[2].Body); e != a {
+		t.Errorf("expect %v body, got %v", e, a)
+	}
+}
@@ -0,0 +1,78 @@
+// Package validation provides invalid parameter errors for the SDK's hand
+// written API client input validation, complementing the required parameter
+// errors provided by smithy-go.
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	smithy "github.com/aws/smithy-go"
+)
+
+// InvalidValueError is an invalid parameter error for a parameter whose value
+// is not valid. Satisfies the smithy.InvalidParamError interface, so it can be
+// added to a smithy.InvalidParamsError.
+type InvalidValueError struct {
+	context       string
+	nestedContext string
+	field         string
+	reason        string
+}
+
+var _ smithy.InvalidParamError = (*InvalidValueError)(nil)
+
+// NewErrInvalidValue returns an InvalidValueError for the field, with the
+// reason the value is not valid.
+func NewErrInvalidValue(field, reason string) *InvalidValueError {
+	return &InvalidValueError{
+		field:  field,
+		reason: reason,
+	}
+}
+
+// Error returns the string version of the invalid parameter error.
+func (e *InvalidValueError) Error() string {
+	return fmt.Sprintf("%s, %s.", e.reason, e.Field())
+}
+
+// Reason returns the reason the parameter's value is not valid.
+func (e *InvalidValueError) Reason() string {
+	return e.reason
+}
+
+// Field returns the field and context the error occurred.
+func (e *InvalidValueError) Field() string {
+	sb := &strings.Builder{}
+	sb.WriteString(e.context)
+	if sb.Len() > 0 {
+		if len(e.nestedContext) == 0 || (len(e.nestedContext) > 0 && e.nestedContext[:1] != "[") {
+			sb.WriteRune('.')
+		}
+	}
+	if len(e.nestedContext) > 0 {
+		sb.WriteString(e.nestedContext)
+		sb.WriteRune('.')
+	}
+	sb.WriteString(e.field)
+	return sb.String()
+}
+
+// SetContext updates the base context of the error.
+func (e *InvalidValueError) SetContext(ctx string) {
+	e.context = ctx
+}
+
+// AddNestedContext prepends a context to the field's path.
+func (e *InvalidValueError) AddNestedContext(ctx string) {
+	if len(e.nestedContext) == 0 {
+		e.nestedContext = ctx
+		return
+	}
+	// Check if our nested context is an index into a slice or map
+	if e.nestedContext[:1] != "[" {
+		e.nestedContext = fmt.Sprintf("%s.%s", ctx, e.nestedContext)
+		return
+	}
+	e.nestedContext = ctx + e.nestedContext
+}
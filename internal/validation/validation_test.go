@@ -0,0 +1,33 @@
+package validation
+
+import (
+	"testing"
+
+	smithy "github.com/aws/smithy-go"
+)
+
+func TestInvalidValueError(t *testing.T) {
+	nested := smithy.InvalidParamsError{Context: "Record"}
+	nested.Add(NewErrInvalidValue("TimeUnit", "must not be empty"))
+
+	records := smithy.InvalidParamsError{Context: "Records"}
+	records.AddNested("[1]", nested)
+
+	input := smithy.InvalidParamsError{Context: "WriteRecordsInput"}
+	input.AddNested("Records", records)
+
+	errs := input.Errs()
+	if e, a := 1, len(errs); e != a {
+		t.Fatalf("expect %v errors, got %v", e, a)
+	}
+
+	paramErr := errs[0].(*InvalidValueError)
+	if e, a := "WriteRecordsInput.Records[1].TimeUnit", paramErr.Field(); e != a {
+		t.Errorf("expect %v field, got %v", e, a)
+	}
+
+	expect := "must not be empty, WriteRecordsInput.Records[1].TimeUnit."
+	if e, a := expect, paramErr.Error(); e != a {
+		t.Errorf("expect %v error, got %v", e, a)
+	}
+}
@@ -0,0 +1,126 @@
+// Package waiter provides the polling loop shared by the SDK's hand written
+// API client waiters. The loop polls a resource, passing each result to an
+// acceptor that decides if the waiter has reached a terminal state, and delays
+// between polls with a jittered exponential backoff bounded by the waiter's
+// minimum and maximum delay.
+package waiter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/internal/sdk"
+	smithywaiter "github.com/aws/smithy-go/waiter"
+)
+
+// Options provides the options for the Wait polling loop.
+type Options struct {
+	// Name of the waiter, used when reporting the waiter exceeded its max
+	// wait time.
+	Name string
+
+	// MinDelay is the minimum amount of time to delay between polls. Must be
+	// greater than zero, and lesser than or equal to MaxDelay.
+	MinDelay time.Duration
+
+	// MaxDelay is the maximum amount of time to delay between polls. Must be
+	// greater than zero, and greater than or equal to MinDelay.
+	MaxDelay time.Duration
+
+	// MaxWait is the maximum amount of time the waiter will wait for the
+	// resource to reach a terminal state. Must be greater than zero.
+	MaxWait time.Duration
+}
+
+// AcceptorFunc is called with the result of each poll. Returning a non-nil
+// waitErr stops the waiter in a failure state. Returning done as true, with a
+// nil waitErr, stops the waiter in a success state. Otherwise the resource
+// will be polled again.
+type AcceptorFunc func(out interface{}, err error) (done bool, waitErr error)
+
+// PollFunc polls the resource the waiter is waiting on.
+type PollFunc func(ctx context.Context) (out interface{}, err error)
+
+// MaxWaitExceededError is returned by Wait when the resource did not reach a
+// terminal state within the waiter's max wait time.
+type MaxWaitExceededError struct {
+	Name    string
+	MaxWait time.Duration
+}
+
+func (e *MaxWaitExceededError) Error() string {
+	return fmt.Sprintf("exceeded max wait time of %v for %s waiter", e.MaxWait, e.Name)
+}
+
+// Wait polls the resource using poll until acceptor reports a terminal state,
+// the max wait time elapses, or the context is canceled. The context is
+// checked between each poll, returning an error if it was canceled while the
+// waiter was delaying.
+func Wait(ctx context.Context, opts Options, acceptor AcceptorFunc, poll PollFunc) error {
+	if err := validateOptions(opts); err != nil {
+		return err
+	}
+
+	ctx, cancelFn := context.WithTimeout(ctx, opts.MaxWait)
+	defer cancelFn()
+
+	remainingTime := opts.MaxWait
+
+	var attempt int64
+	for {
+		attempt++
+		start := sdk.NowTime()
+
+		out, err := poll(ctx)
+
+		done, waitErr := acceptor(out, err)
+		if waitErr != nil {
+			return waitErr
+		}
+		if done {
+			return nil
+		}
+
+		remainingTime -= sdk.NowTime().Sub(start)
+		if remainingTime < opts.MinDelay || remainingTime <= 0 {
+			break
+		}
+
+		delay, err := ComputeDelay(attempt, opts.MinDelay, opts.MaxDelay, remainingTime)
+		if err != nil {
+			return fmt.Errorf("error computing waiter delay, %w", err)
+		}
+
+		remainingTime -= delay
+		if err := sdk.SleepWithContext(ctx, delay); err != nil {
+			return fmt.Errorf("request cancelled while waiting, %w", err)
+		}
+	}
+
+	return &MaxWaitExceededError{Name: opts.Name, MaxWait: opts.MaxWait}
+}
+
+// ComputeDelay returns the jittered exponential delay before the next poll
+// attempt. The delay will always be between minDelay and maxDelay, and will
+// not exceed the remaining time of the waiter.
+func ComputeDelay(attempt int64, minDelay, maxDelay, remainingTime time.Duration) (time.Duration, error) {
+	return smithywaiter.ComputeDelay(attempt, minDelay, maxDelay, remainingTime)
+}
+
+func validateOptions(opts Options) error {
+	if opts.MaxWait <= 0 {
+		return fmt.Errorf("maximum wait time for waiter must be greater than zero")
+	}
+	if opts.MinDelay <= 0 {
+		return fmt.Errorf("minimum waiter delay must be greater than zero")
+	}
+	if opts.MaxDelay <= 0 {
+		return fmt.Errorf("maximum waiter delay must be greater than zero")
+	}
+	if opts.MinDelay > opts.MaxDelay {
+		return fmt.Errorf("minimum waiter delay %v must be lesser than or equal to maximum waiter delay of %v",
+			opts.MinDelay, opts.MaxDelay)
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package waiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/internal/sdk"
+)
+
+func TestWait(t *testing.T) {
+	errFailure := fmt.Errorf("resource failed")
+
+	cases := map[string]struct {
+		States       []string
+		ExpectPolls  int
+		ExpectErr    error
+		ExpectErrMsg string
+	}{
+		"success first poll": {
+			States:      []string{"done"},
+			ExpectPolls: 1,
+		},
+		"success after retries": {
+			States:      []string{"pending", "pending", "done"},
+			ExpectPolls: 3,
+		},
+		"failure state": {
+			States:      []string{"pending", "failed"},
+			ExpectPolls: 2,
+			ExpectErr:   errFailure,
+		},
+		"retry on poll error": {
+			States:      []string{"error", "done"},
+			ExpectPolls: 2,
+		},
+		"max wait exceeded": {
+			States:       []string{"pending"},
+			ExpectErrMsg: "exceeded max wait time",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			restoreSleep := sdk.TestingUseNopSleep()
+			defer restoreSleep()
+
+			var polls int
+			poll := func(ctx context.Context) (interface{}, error) {
+				idx := polls
+				if idx >= len(c.States) {
+					idx = len(c.States) - 1
+				}
+				polls++
+				if c.States[idx] == "error" {
+					return nil, fmt.Errorf("transient error")
+				}
+				return c.States[idx], nil
+			}
+			acceptor := func(out interface{}, err error) (bool, error) {
+				if err != nil {
+					return false, nil
+				}
+				switch out.(string) {
+				case "done":
+					return true, nil
+				case "failed":
+					return false, errFailure
+				}
+				return false, nil
+			}
+
+			err := Wait(context.Background(), Options{
+				Name:     "Mock",
+				MinDelay: time.Second,
+				MaxDelay: 10 * time.Second,
+				MaxWait:  time.Minute,
+			}, acceptor, poll)
+
+			if c.ExpectErr != nil {
+				if !errors.Is(err, c.ExpectErr) {
+					t.Fatalf("expect %v error, got %v", c.ExpectErr, err)
+				}
+			} else if len(c.ExpectErrMsg) != 0 {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				if e, a := c.ExpectErrMsg, err.Error(); !strings.Contains(a, e) {
+					t.Fatalf("expect error to contain %v, got %v", e, a)
+				}
+				var maxWaitErr *MaxWaitExceededError
+				if !errors.As(err, &maxWaitErr) {
+					t.Fatalf("expect %T error, got %T", maxWaitErr, err)
+				}
+				return
+			} else if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.ExpectPolls, polls; e != a {
+				t.Errorf("expect %v polls, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestWait_BackoffBounds(t *testing.T) {
+	const minDelay, maxDelay = time.Second, 8 * time.Second
+
+	var delays []time.Duration
+	origSleep := sdk.SleepWithContext
+	defer func() { sdk.SleepWithContext = origSleep }()
+	sdk.SleepWithContext = func(ctx context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return nil
+	}
+
+	var polls int
+	err := Wait(context.Background(), Options{
+		MinDelay: minDelay,
+		MaxDelay: maxDelay,
+		MaxWait:  time.Hour,
+	}, func(interface{}, error) (bool, error) {
+		return polls == 20, nil
+	}, func(context.Context) (interface{}, error) {
+		polls++
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := 19, len(delays); e != a {
+		t.Fatalf("expect %v delays, got %v", e, a)
+	}
+	for i, d := range delays {
+		if d < minDelay || d > maxDelay {
+			t.Errorf("%d, expect delay between %v and %v, got %v", i, minDelay, maxDelay, d)
+		}
+	}
+}
+
+func TestWait_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var polls int
+	err := Wait(ctx, Options{
+		MinDelay: time.Minute,
+		MaxDelay: time.Minute,
+		MaxWait:  time.Hour,
+	}, func(interface{}, error) (bool, error) {
+		return false, nil
+	}, func(context.Context) (interface{}, error) {
+		polls++
+		cancel()
+		return nil, nil
+	})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expect context canceled error, got %v", err)
+	}
+	if e, a := 1, polls; e != a {
+		t.Errorf("expect %v polls, got %v", e, a)
+	}
+}
+
+func TestWait_InvalidOptions(t *testing.T) {
+	cases := map[string]Options{
+		"no max wait":     {MinDelay: time.Second, MaxDelay: time.Second},
+		"no min delay":    {MaxDelay: time.Second, MaxWait: time.Minute},
+		"no max delay":    {MinDelay: time.Second, MaxWait: time.Minute},
+		"min exceeds max": {MinDelay: time.Minute, MaxDelay: time.Second, MaxWait: time.Hour},
+	}
+
+	for name, opts := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := Wait(context.Background(), opts,
+				func(interface{}, error) (bool, error) { return true, nil },
+				func(context.Context) (interface{}, error) {
+					t.Fatalf("expect no poll")
+					return nil, nil
+				})
+			if err == nil {
+				t.Fatalf("expect error, got none")
+			}
+		})
+	}
+}
@@ -107,6 +107,9 @@ func addOperationCreateAnalyzerMiddlewares(stack *middleware.Stack, options Opti
 	if err = smithyhttp.AddCloseResponseBodyMiddleware(stack); err != nil {
 		return err
 	}
+	if err = addRetryTransientNetworkErrors(stack, options); err != nil {
+		return err
+	}
 	if err = addOpCreateAnalyzerValidationMiddleware(stack); err != nil {
 		return err
 	}
@@ -119,5 +122,32 @@ func addOperationCreateAnalyzerMiddlewares(stack *middleware.Stack, options Opti
 	if err = addRequestResponseLogging(stack, options); err != nil {
 		return err
 	}
+	if err = addUserAgentAppID(stack, options); err != nil {
+		return err
+	}
+	if err = addResponseSizeLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addOperationSpan(stack, options); err != nil {
+		return err
+	}
+	if err = addOnRequestBody(stack, options); err != nil {
+		return err
+	}
+	if err = addConcurrencyLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addCorrelationID(stack, options); err != nil {
+		return err
+	}
+	if err = addOnResponseBody(stack, options); err != nil {
+		return err
+	}
+	if err = addRequestBodySize(stack); err != nil {
+		return err
+	}
+	if err = addDisableMiddlewareGuards(stack); err != nil {
+		return err
+	}
 	return nil
 }
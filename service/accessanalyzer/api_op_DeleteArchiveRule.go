@@ -93,6 +93,9 @@ func addOperationDeleteArchiveRuleMiddlewares(stack *middleware.Stack, options O
 	if err = smithyhttp.AddCloseResponseBodyMiddleware(stack); err != nil {
 		return err
 	}
+	if err = addRetryTransientNetworkErrors(stack, options); err != nil {
+		return err
+	}
 	if err = addOpDeleteArchiveRuleValidationMiddleware(stack); err != nil {
 		return err
 	}
@@ -105,5 +108,32 @@ func addOperationDeleteArchiveRuleMiddlewares(stack *middleware.Stack, options O
 	if err = addRequestResponseLogging(stack, options); err != nil {
 		return err
 	}
+	if err = addUserAgentAppID(stack, options); err != nil {
+		return err
+	}
+	if err = addResponseSizeLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addOperationSpan(stack, options); err != nil {
+		return err
+	}
+	if err = addOnRequestBody(stack, options); err != nil {
+		return err
+	}
+	if err = addConcurrencyLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addCorrelationID(stack, options); err != nil {
+		return err
+	}
+	if err = addOnResponseBody(stack, options); err != nil {
+		return err
+	}
+	if err = addRequestBodySize(stack); err != nil {
+		return err
+	}
+	if err = addDisableMiddlewareGuards(stack); err != nil {
+		return err
+	}
 	return nil
 }
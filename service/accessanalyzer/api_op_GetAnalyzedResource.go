@@ -97,6 +97,9 @@ func addOperationGetAnalyzedResourceMiddlewares(stack *middleware.Stack, options
 	if err = smithyhttp.AddCloseResponseBodyMiddleware(stack); err != nil {
 		return err
 	}
+	if err = addRetryTransientNetworkErrors(stack, options); err != nil {
+		return err
+	}
 	if err = addOpGetAnalyzedResourceValidationMiddleware(stack); err != nil {
 		return err
 	}
@@ -112,6 +115,33 @@ func addOperationGetAnalyzedResourceMiddlewares(stack *middleware.Stack, options
 	if err = addRequestResponseLogging(stack, options); err != nil {
 		return err
 	}
+	if err = addUserAgentAppID(stack, options); err != nil {
+		return err
+	}
+	if err = addResponseSizeLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addOperationSpan(stack, options); err != nil {
+		return err
+	}
+	if err = addOnRequestBody(stack, options); err != nil {
+		return err
+	}
+	if err = addConcurrencyLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addCorrelationID(stack, options); err != nil {
+		return err
+	}
+	if err = addOnResponseBody(stack, options); err != nil {
+		return err
+	}
+	if err = addRequestBodySize(stack); err != nil {
+		return err
+	}
+	if err = addDisableMiddlewareGuards(stack); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -107,6 +107,9 @@ func addOperationListAnalyzedResourcesMiddlewares(stack *middleware.Stack, optio
 	if err = smithyhttp.AddCloseResponseBodyMiddleware(stack); err != nil {
 		return err
 	}
+	if err = addRetryTransientNetworkErrors(stack, options); err != nil {
+		return err
+	}
 	if err = addOpListAnalyzedResourcesValidationMiddleware(stack); err != nil {
 		return err
 	}
@@ -122,6 +125,33 @@ func addOperationListAnalyzedResourcesMiddlewares(stack *middleware.Stack, optio
 	if err = addRequestResponseLogging(stack, options); err != nil {
 		return err
 	}
+	if err = addUserAgentAppID(stack, options); err != nil {
+		return err
+	}
+	if err = addResponseSizeLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addOperationSpan(stack, options); err != nil {
+		return err
+	}
+	if err = addOnRequestBody(stack, options); err != nil {
+		return err
+	}
+	if err = addConcurrencyLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addCorrelationID(stack, options); err != nil {
+		return err
+	}
+	if err = addOnResponseBody(stack, options); err != nil {
+		return err
+	}
+	if err = addRequestBodySize(stack); err != nil {
+		return err
+	}
+	if err = addDisableMiddlewareGuards(stack); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -142,6 +172,17 @@ type ListAnalyzedResourcesPaginatorOptions struct {
 	// Set to true if pagination should stop if the service returns a pagination token
 	// that matches the most recent token provided to the service.
 	StopOnDuplicateToken bool
+
+	// OnPage is called after each page is successfully retrieved, with the page
+	// number starting at 1, the number of items in the page, and the page's
+	// result metadata.
+	OnPage func(pageNum int, itemCount int, metadata middleware.Metadata)
+
+	// The limit of the first page, if less than Limit. The limit is doubled for
+	// each following page, up to Limit, so the first page is retrieved quickly
+	// while later pages retrieve more items at once. Ignored if zero, or if
+	// Limit is not set.
+	AdaptiveLimit int32
 }
 
 // ListAnalyzedResourcesPaginator is a paginator for ListAnalyzedResources
@@ -151,6 +192,9 @@ type ListAnalyzedResourcesPaginator struct {
 	params    *ListAnalyzedResourcesInput
 	nextToken *string
 	firstPage bool
+	pageNum   int
+
+	adaptiveLimit int32
 }
 
 // NewListAnalyzedResourcesPaginator returns a new ListAnalyzedResourcesPaginator
@@ -173,6 +217,8 @@ func NewListAnalyzedResourcesPaginator(client ListAnalyzedResourcesAPIClient, pa
 		client:    client,
 		params:    params,
 		firstPage: true,
+
+		adaptiveLimit: options.AdaptiveLimit,
 	}
 }
 
@@ -193,6 +239,10 @@ func (p *ListAnalyzedResourcesPaginator) NextPage(ctx context.Context, optFns ..
 	var limit *int32
 	if p.options.Limit > 0 {
 		limit = &p.options.Limit
+		if p.adaptiveLimit > 0 && p.adaptiveLimit < p.options.Limit {
+			adaptiveLimit := p.adaptiveLimit
+			limit = &adaptiveLimit
+		}
 	}
 	params.MaxResults = limit
 
@@ -202,6 +252,10 @@ func (p *ListAnalyzedResourcesPaginator) NextPage(ctx context.Context, optFns ..
 	}
 	p.firstPage = false
 
+	if p.adaptiveLimit > 0 && p.adaptiveLimit < p.options.Limit {
+		p.adaptiveLimit *= 2
+	}
+
 	prevToken := p.nextToken
 	p.nextToken = result.NextToken
 
@@ -209,6 +263,11 @@ func (p *ListAnalyzedResourcesPaginator) NextPage(ctx context.Context, optFns ..
 		p.nextToken = nil
 	}
 
+	p.pageNum++
+	if p.options.OnPage != nil {
+		p.options.OnPage(p.pageNum, len(result.AnalyzedResources), result.ResultMetadata)
+	}
+
 	return result, nil
 }
 
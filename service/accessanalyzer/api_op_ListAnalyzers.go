@@ -101,6 +101,9 @@ func addOperationListAnalyzersMiddlewares(stack *middleware.Stack, options Optio
 	if err = smithyhttp.AddCloseResponseBodyMiddleware(stack); err != nil {
 		return err
 	}
+	if err = addRetryTransientNetworkErrors(stack, options); err != nil {
+		return err
+	}
 	if err = addRequestIDRetrieverMiddleware(stack); err != nil {
 		return err
 	}
@@ -110,6 +113,33 @@ func addOperationListAnalyzersMiddlewares(stack *middleware.Stack, options Optio
 	if err = addRequestResponseLogging(stack, options); err != nil {
 		return err
 	}
+	if err = addUserAgentAppID(stack, options); err != nil {
+		return err
+	}
+	if err = addResponseSizeLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addOperationSpan(stack, options); err != nil {
+		return err
+	}
+	if err = addOnRequestBody(stack, options); err != nil {
+		return err
+	}
+	if err = addConcurrencyLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addCorrelationID(stack, options); err != nil {
+		return err
+	}
+	if err = addOnResponseBody(stack, options); err != nil {
+		return err
+	}
+	if err = addRequestBodySize(stack); err != nil {
+		return err
+	}
+	if err = addDisableMiddlewareGuards(stack); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -128,6 +158,17 @@ type ListAnalyzersPaginatorOptions struct {
 	// Set to true if pagination should stop if the service returns a pagination token
 	// that matches the most recent token provided to the service.
 	StopOnDuplicateToken bool
+
+	// OnPage is called after each page is successfully retrieved, with the page
+	// number starting at 1, the number of items in the page, and the page's
+	// result metadata.
+	OnPage func(pageNum int, itemCount int, metadata middleware.Metadata)
+
+	// The limit of the first page, if less than Limit. The limit is doubled for
+	// each following page, up to Limit, so the first page is retrieved quickly
+	// while later pages retrieve more items at once. Ignored if zero, or if
+	// Limit is not set.
+	AdaptiveLimit int32
 }
 
 // ListAnalyzersPaginator is a paginator for ListAnalyzers
@@ -137,6 +178,9 @@ type ListAnalyzersPaginator struct {
 	params    *ListAnalyzersInput
 	nextToken *string
 	firstPage bool
+	pageNum   int
+
+	adaptiveLimit int32
 }
 
 // NewListAnalyzersPaginator returns a new ListAnalyzersPaginator
@@ -159,6 +203,8 @@ func NewListAnalyzersPaginator(client ListAnalyzersAPIClient, params *ListAnalyz
 		client:    client,
 		params:    params,
 		firstPage: true,
+
+		adaptiveLimit: options.AdaptiveLimit,
 	}
 }
 
@@ -179,6 +225,10 @@ func (p *ListAnalyzersPaginator) NextPage(ctx context.Context, optFns ...func(*O
 	var limit *int32
 	if p.options.Limit > 0 {
 		limit = &p.options.Limit
+		if p.adaptiveLimit > 0 && p.adaptiveLimit < p.options.Limit {
+			adaptiveLimit := p.adaptiveLimit
+			limit = &adaptiveLimit
+		}
 	}
 	params.MaxResults = limit
 
@@ -188,6 +238,10 @@ func (p *ListAnalyzersPaginator) NextPage(ctx context.Context, optFns ...func(*O
 	}
 	p.firstPage = false
 
+	if p.adaptiveLimit > 0 && p.adaptiveLimit < p.options.Limit {
+		p.adaptiveLimit *= 2
+	}
+
 	prevToken := p.nextToken
 	p.nextToken = result.NextToken
 
@@ -195,5 +249,10 @@ func (p *ListAnalyzersPaginator) NextPage(ctx context.Context, optFns ...func(*O
 		p.nextToken = nil
 	}
 
+	p.pageNum++
+	if p.options.OnPage != nil {
+		p.options.OnPage(p.pageNum, len(result.Analyzers), result.ResultMetadata)
+	}
+
 	return result, nil
 }
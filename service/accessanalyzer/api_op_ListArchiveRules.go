@@ -103,6 +103,9 @@ func addOperationListArchiveRulesMiddlewares(stack *middleware.Stack, options Op
 	if err = smithyhttp.AddCloseResponseBodyMiddleware(stack); err != nil {
 		return err
 	}
+	if err = addRetryTransientNetworkErrors(stack, options); err != nil {
+		return err
+	}
 	if err = addOpListArchiveRulesValidationMiddleware(stack); err != nil {
 		return err
 	}
@@ -115,6 +118,33 @@ func addOperationListArchiveRulesMiddlewares(stack *middleware.Stack, options Op
 	if err = addRequestResponseLogging(stack, options); err != nil {
 		return err
 	}
+	if err = addUserAgentAppID(stack, options); err != nil {
+		return err
+	}
+	if err = addResponseSizeLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addOperationSpan(stack, options); err != nil {
+		return err
+	}
+	if err = addOnRequestBody(stack, options); err != nil {
+		return err
+	}
+	if err = addConcurrencyLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addCorrelationID(stack, options); err != nil {
+		return err
+	}
+	if err = addOnResponseBody(stack, options); err != nil {
+		return err
+	}
+	if err = addRequestBodySize(stack); err != nil {
+		return err
+	}
+	if err = addDisableMiddlewareGuards(stack); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -134,6 +164,17 @@ type ListArchiveRulesPaginatorOptions struct {
 	// Set to true if pagination should stop if the service returns a pagination token
 	// that matches the most recent token provided to the service.
 	StopOnDuplicateToken bool
+
+	// OnPage is called after each page is successfully retrieved, with the page
+	// number starting at 1, the number of items in the page, and the page's
+	// result metadata.
+	OnPage func(pageNum int, itemCount int, metadata middleware.Metadata)
+
+	// The limit of the first page, if less than Limit. The limit is doubled for
+	// each following page, up to Limit, so the first page is retrieved quickly
+	// while later pages retrieve more items at once. Ignored if zero, or if
+	// Limit is not set.
+	AdaptiveLimit int32
 }
 
 // ListArchiveRulesPaginator is a paginator for ListArchiveRules
@@ -143,6 +184,9 @@ type ListArchiveRulesPaginator struct {
 	params    *ListArchiveRulesInput
 	nextToken *string
 	firstPage bool
+	pageNum   int
+
+	adaptiveLimit int32
 }
 
 // NewListArchiveRulesPaginator returns a new ListArchiveRulesPaginator
@@ -165,6 +209,8 @@ func NewListArchiveRulesPaginator(client ListArchiveRulesAPIClient, params *List
 		client:    client,
 		params:    params,
 		firstPage: true,
+
+		adaptiveLimit: options.AdaptiveLimit,
 	}
 }
 
@@ -185,6 +231,10 @@ func (p *ListArchiveRulesPaginator) NextPage(ctx context.Context, optFns ...func
 	var limit *int32
 	if p.options.Limit > 0 {
 		limit = &p.options.Limit
+		if p.adaptiveLimit > 0 && p.adaptiveLimit < p.options.Limit {
+			adaptiveLimit := p.adaptiveLimit
+			limit = &adaptiveLimit
+		}
 	}
 	params.MaxResults = limit
 
@@ -194,6 +244,10 @@ func (p *ListArchiveRulesPaginator) NextPage(ctx context.Context, optFns ...func
 	}
 	p.firstPage = false
 
+	if p.adaptiveLimit > 0 && p.adaptiveLimit < p.options.Limit {
+		p.adaptiveLimit *= 2
+	}
+
 	prevToken := p.nextToken
 	p.nextToken = result.NextToken
 
@@ -201,5 +255,10 @@ func (p *ListArchiveRulesPaginator) NextPage(ctx context.Context, optFns ...func
 		p.nextToken = nil
 	}
 
+	p.pageNum++
+	if p.options.OnPage != nil {
+		p.options.OnPage(p.pageNum, len(result.ArchiveRules), result.ResultMetadata)
+	}
+
 	return result, nil
 }
@@ -110,6 +110,9 @@ func addOperationListFindingsMiddlewares(stack *middleware.Stack, options Option
 	if err = smithyhttp.AddCloseResponseBodyMiddleware(stack); err != nil {
 		return err
 	}
+	if err = addRetryTransientNetworkErrors(stack, options); err != nil {
+		return err
+	}
 	if err = addOpListFindingsValidationMiddleware(stack); err != nil {
 		return err
 	}
@@ -125,6 +128,33 @@ func addOperationListFindingsMiddlewares(stack *middleware.Stack, options Option
 	if err = addRequestResponseLogging(stack, options); err != nil {
 		return err
 	}
+	if err = addUserAgentAppID(stack, options); err != nil {
+		return err
+	}
+	if err = addResponseSizeLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addOperationSpan(stack, options); err != nil {
+		return err
+	}
+	if err = addOnRequestBody(stack, options); err != nil {
+		return err
+	}
+	if err = addConcurrencyLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addCorrelationID(stack, options); err != nil {
+		return err
+	}
+	if err = addOnResponseBody(stack, options); err != nil {
+		return err
+	}
+	if err = addRequestBodySize(stack); err != nil {
+		return err
+	}
+	if err = addDisableMiddlewareGuards(stack); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -143,6 +173,17 @@ type ListFindingsPaginatorOptions struct {
 	// Set to true if pagination should stop if the service returns a pagination token
 	// that matches the most recent token provided to the service.
 	StopOnDuplicateToken bool
+
+	// OnPage is called after each page is successfully retrieved, with the page
+	// number starting at 1, the number of items in the page, and the page's
+	// result metadata.
+	OnPage func(pageNum int, itemCount int, metadata middleware.Metadata)
+
+	// The limit of the first page, if less than Limit. The limit is doubled for
+	// each following page, up to Limit, so the first page is retrieved quickly
+	// while later pages retrieve more items at once. Ignored if zero, or if
+	// Limit is not set.
+	AdaptiveLimit int32
 }
 
 // ListFindingsPaginator is a paginator for ListFindings
@@ -152,6 +193,9 @@ type ListFindingsPaginator struct {
 	params    *ListFindingsInput
 	nextToken *string
 	firstPage bool
+	pageNum   int
+
+	adaptiveLimit int32
 }
 
 // NewListFindingsPaginator returns a new ListFindingsPaginator
@@ -174,6 +218,8 @@ func NewListFindingsPaginator(client ListFindingsAPIClient, params *ListFindings
 		client:    client,
 		params:    params,
 		firstPage: true,
+
+		adaptiveLimit: options.AdaptiveLimit,
 	}
 }
 
@@ -194,6 +240,10 @@ func (p *ListFindingsPaginator) NextPage(ctx context.Context, optFns ...func(*Op
 	var limit *int32
 	if p.options.Limit > 0 {
 		limit = &p.options.Limit
+		if p.adaptiveLimit > 0 && p.adaptiveLimit < p.options.Limit {
+			adaptiveLimit := p.adaptiveLimit
+			limit = &adaptiveLimit
+		}
 	}
 	params.MaxResults = limit
 
@@ -203,6 +253,10 @@ func (p *ListFindingsPaginator) NextPage(ctx context.Context, optFns ...func(*Op
 	}
 	p.firstPage = false
 
+	if p.adaptiveLimit > 0 && p.adaptiveLimit < p.options.Limit {
+		p.adaptiveLimit *= 2
+	}
+
 	prevToken := p.nextToken
 	p.nextToken = result.NextToken
 
@@ -210,6 +264,11 @@ func (p *ListFindingsPaginator) NextPage(ctx context.Context, optFns ...func(*Op
 		p.nextToken = nil
 	}
 
+	p.pageNum++
+	if p.options.OnPage != nil {
+		p.options.OnPage(p.pageNum, len(result.Findings), result.ResultMetadata)
+	}
+
 	return result, nil
 }
 
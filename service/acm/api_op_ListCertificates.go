@@ -121,6 +121,33 @@ func addOperationListCertificatesMiddlewares(stack *middleware.Stack, options Op
 	if err = addRequestResponseLogging(stack, options); err != nil {
 		return err
 	}
+	if err = addUserAgentAppID(stack, options); err != nil {
+		return err
+	}
+	if err = addResponseSizeLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addOperationSpan(stack, options); err != nil {
+		return err
+	}
+	if err = addOnRequestBody(stack, options); err != nil {
+		return err
+	}
+	if err = addConcurrencyLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addCorrelationID(stack, options); err != nil {
+		return err
+	}
+	if err = addOnResponseBody(stack, options); err != nil {
+		return err
+	}
+	if err = addRequestBodySize(stack); err != nil {
+		return err
+	}
+	if err = addDisableMiddlewareGuards(stack); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -143,6 +170,17 @@ type ListCertificatesPaginatorOptions struct {
 	// Set to true if pagination should stop if the service returns a pagination token
 	// that matches the most recent token provided to the service.
 	StopOnDuplicateToken bool
+
+	// OnPage is called after each page is successfully retrieved, with the page
+	// number starting at 1, the number of items in the page, and the page's
+	// result metadata.
+	OnPage func(pageNum int, itemCount int, metadata middleware.Metadata)
+
+	// The limit of the first page, if less than Limit. The limit is doubled for
+	// each following page, up to Limit, so the first page is retrieved quickly
+	// while later pages retrieve more items at once. Ignored if zero, or if
+	// Limit is not set.
+	AdaptiveLimit int32
 }
 
 // ListCertificatesPaginator is a paginator for ListCertificates
@@ -152,6 +190,9 @@ type ListCertificatesPaginator struct {
 	params    *ListCertificatesInput
 	nextToken *string
 	firstPage bool
+	pageNum   int
+
+	adaptiveLimit int32
 }
 
 // NewListCertificatesPaginator returns a new ListCertificatesPaginator
@@ -174,6 +215,8 @@ func NewListCertificatesPaginator(client ListCertificatesAPIClient, params *List
 		client:    client,
 		params:    params,
 		firstPage: true,
+
+		adaptiveLimit: options.AdaptiveLimit,
 	}
 }
 
@@ -194,6 +237,10 @@ func (p *ListCertificatesPaginator) NextPage(ctx context.Context, optFns ...func
 	var limit *int32
 	if p.options.Limit > 0 {
 		limit = &p.options.Limit
+		if p.adaptiveLimit > 0 && p.adaptiveLimit < p.options.Limit {
+			adaptiveLimit := p.adaptiveLimit
+			limit = &adaptiveLimit
+		}
 	}
 	params.MaxItems = limit
 
@@ -203,6 +250,10 @@ func (p *ListCertificatesPaginator) NextPage(ctx context.Context, optFns ...func
 	}
 	p.firstPage = false
 
+	if p.adaptiveLimit > 0 && p.adaptiveLimit < p.options.Limit {
+		p.adaptiveLimit *= 2
+	}
+
 	prevToken := p.nextToken
 	p.nextToken = result.NextToken
 
@@ -210,6 +261,11 @@ func (p *ListCertificatesPaginator) NextPage(ctx context.Context, optFns ...func
 		p.nextToken = nil
 	}
 
+	p.pageNum++
+	if p.options.OnPage != nil {
+		p.options.OnPage(p.pageNum, len(result.CertificateSummaryList), result.ResultMetadata)
+	}
+
 	return result, nil
 }
 
@@ -108,6 +108,33 @@ func addOperationListDeploymentStrategiesMiddlewares(stack *middleware.Stack, op
 	if err = addRequestResponseLogging(stack, options); err != nil {
 		return err
 	}
+	if err = addUserAgentAppID(stack, options); err != nil {
+		return err
+	}
+	if err = addResponseSizeLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addOperationSpan(stack, options); err != nil {
+		return err
+	}
+	if err = addOnRequestBody(stack, options); err != nil {
+		return err
+	}
+	if err = addConcurrencyLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addCorrelationID(stack, options); err != nil {
+		return err
+	}
+	if err = addOnResponseBody(stack, options); err != nil {
+		return err
+	}
+	if err = addRequestBodySize(stack); err != nil {
+		return err
+	}
+	if err = addDisableMiddlewareGuards(stack); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -129,6 +156,17 @@ type ListDeploymentStrategiesPaginatorOptions struct {
 	// Set to true if pagination should stop if the service returns a pagination token
 	// that matches the most recent token provided to the service.
 	StopOnDuplicateToken bool
+
+	// OnPage is called after each page is successfully retrieved, with the page
+	// number starting at 1, the number of items in the page, and the page's
+	// result metadata.
+	OnPage func(pageNum int, itemCount int, metadata middleware.Metadata)
+
+	// The limit of the first page, if less than Limit. The limit is doubled for
+	// each following page, up to Limit, so the first page is retrieved quickly
+	// while later pages retrieve more items at once. Ignored if zero, or if
+	// Limit is not set.
+	AdaptiveLimit int32
 }
 
 // ListDeploymentStrategiesPaginator is a paginator for ListDeploymentStrategies
@@ -138,6 +176,9 @@ type ListDeploymentStrategiesPaginator struct {
 	params    *ListDeploymentStrategiesInput
 	nextToken *string
 	firstPage bool
+	pageNum   int
+
+	adaptiveLimit int32
 }
 
 // NewListDeploymentStrategiesPaginator returns a new
@@ -161,6 +202,8 @@ func NewListDeploymentStrategiesPaginator(client ListDeploymentStrategiesAPIClie
 		client:    client,
 		params:    params,
 		firstPage: true,
+
+		adaptiveLimit: options.AdaptiveLimit,
 	}
 }
 
@@ -178,7 +221,11 @@ func (p *ListDeploymentStrategiesPaginator) NextPage(ctx context.Context, optFns
 	params := *p.params
 	params.NextToken = p.nextToken
 
-	params.MaxResults = p.options.Limit
+	limit := p.options.Limit
+	if p.adaptiveLimit > 0 && p.adaptiveLimit < limit {
+		limit = p.adaptiveLimit
+	}
+	params.MaxResults = limit
 
 	result, err := p.client.ListDeploymentStrategies(ctx, &params, optFns...)
 	if err != nil {
@@ -186,6 +233,10 @@ func (p *ListDeploymentStrategiesPaginator) NextPage(ctx context.Context, optFns
 	}
 	p.firstPage = false
 
+	if p.adaptiveLimit > 0 && p.adaptiveLimit < p.options.Limit {
+		p.adaptiveLimit *= 2
+	}
+
 	prevToken := p.nextToken
 	p.nextToken = result.NextToken
 
@@ -193,6 +244,11 @@ func (p *ListDeploymentStrategiesPaginator) NextPage(ctx context.Context, optFns
 		p.nextToken = nil
 	}
 
+	p.pageNum++
+	if p.options.OnPage != nil {
+		p.options.OnPage(p.pageNum, len(result.Items), result.ResultMetadata)
+	}
+
 	return result, nil
 }
 
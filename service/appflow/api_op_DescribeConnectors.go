@@ -110,6 +110,33 @@ func addOperationDescribeConnectorsMiddlewares(stack *middleware.Stack, options
 	if err = addRequestResponseLogging(stack, options); err != nil {
 		return err
 	}
+	if err = addUserAgentAppID(stack, options); err != nil {
+		return err
+	}
+	if err = addResponseSizeLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addOperationSpan(stack, options); err != nil {
+		return err
+	}
+	if err = addOnRequestBody(stack, options); err != nil {
+		return err
+	}
+	if err = addConcurrencyLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addCorrelationID(stack, options); err != nil {
+		return err
+	}
+	if err = addOnResponseBody(stack, options); err != nil {
+		return err
+	}
+	if err = addRequestBodySize(stack); err != nil {
+		return err
+	}
+	if err = addDisableMiddlewareGuards(stack); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -127,6 +154,11 @@ type DescribeConnectorsPaginatorOptions struct {
 	// Set to true if pagination should stop if the service returns a pagination token
 	// that matches the most recent token provided to the service.
 	StopOnDuplicateToken bool
+
+	// OnPage is called after each page is successfully retrieved, with the page
+	// number starting at 1, the number of items in the page, and the page's
+	// result metadata.
+	OnPage func(pageNum int, itemCount int, metadata middleware.Metadata)
 }
 
 // DescribeConnectorsPaginator is a paginator for DescribeConnectors
@@ -136,6 +168,7 @@ type DescribeConnectorsPaginator struct {
 	params    *DescribeConnectorsInput
 	nextToken *string
 	firstPage bool
+	pageNum   int
 }
 
 // NewDescribeConnectorsPaginator returns a new DescribeConnectorsPaginator
@@ -185,6 +218,11 @@ func (p *DescribeConnectorsPaginator) NextPage(ctx context.Context, optFns ...fu
 		p.nextToken = nil
 	}
 
+	p.pageNum++
+	if p.options.OnPage != nil {
+		p.options.OnPage(p.pageNum, len(result.ConnectorConfigurations), result.ResultMetadata)
+	}
+
 	return result, nil
 }
 
@@ -0,0 +1,65 @@
+package chime
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/smithy-go/middleware"
+)
+
+// DialOutNotAllowedError is returned by CreateMeetingDialOut when the
+// ToPhoneNumber does not start with any of the prefixes of the client's
+// DialOutAllowlist. The call is not made.
+type DialOutNotAllowedError struct {
+	PhoneNumber string
+}
+
+func (e *DialOutNotAllowedError) Error() string {
+	return fmt.Sprintf("dial out to %s not allowed, phone number does not match any DialOutAllowlist prefix",
+		e.PhoneNumber)
+}
+
+// addDialOutAllowlist adds the middleware checking the ToPhoneNumber of
+// CreateMeetingDialOut operations against the client's DialOutAllowlist.
+func addDialOutAllowlist(stack *middleware.Stack, o Options) error {
+	if len(o.DialOutAllowlist) == 0 {
+		return nil
+	}
+	return stack.Initialize.Add(&dialOutAllowlist{prefixes: o.DialOutAllowlist}, middleware.After)
+}
+
+// dialOutAllowlist fails CreateMeetingDialOut operations with a ToPhoneNumber
+// that does not start with one of the allowed prefixes.
+type dialOutAllowlist struct {
+	prefixes []string
+}
+
+// ID returns the id of the middleware
+func (*dialOutAllowlist) ID() string {
+	return "DialOutAllowlist"
+}
+
+// HandleInitialize implements the InitializeMiddleware interface
+func (m *dialOutAllowlist) HandleInitialize(
+	ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	input, ok := in.Parameters.(*CreateMeetingDialOutInput)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown input parameters type %T", in.Parameters)
+	}
+
+	var phoneNumber string
+	if input.ToPhoneNumber != nil {
+		phoneNumber = *input.ToPhoneNumber
+	}
+	for _, prefix := range m.prefixes {
+		if len(prefix) != 0 && strings.HasPrefix(phoneNumber, prefix) {
+			return next.HandleInitialize(ctx, in)
+		}
+	}
+
+	return out, metadata, &DialOutNotAllowedError{PhoneNumber: phoneNumber}
+}
@@ -0,0 +1,82 @@
+package chime
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting/unit"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestClient_DialOutAllowlist(t *testing.T) {
+	cases := map[string]struct {
+		Allowlist     []string
+		PhoneNumber   string
+		ExpectAllowed bool
+	}{
+		"allowed prefix": {
+			Allowlist:     []string{"+44", "+1"},
+			PhoneNumber:   "+12065550100",
+			ExpectAllowed: true,
+		},
+		"blocked prefix": {
+			Allowlist:   []string{"+1"},
+			PhoneNumber: "+442079460000",
+		},
+		"no allowlist": {
+			PhoneNumber:   "+442079460000",
+			ExpectAllowed: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls int
+			client := New(Options{
+				Region:           "us-east-1",
+				Credentials:      unit.StubCredentialsProvider{},
+				DialOutAllowlist: c.Allowlist,
+				HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+					calls++
+					return &http.Response{
+						StatusCode: 201,
+						Header:     http.Header{},
+						Body:       ioutil.NopCloser(strings.NewReader(`{"TransactionId":"txn-1234"}`)),
+					}, nil
+				}),
+			})
+
+			_, err := client.CreateMeetingDialOut(context.Background(), &CreateMeetingDialOutInput{
+				MeetingId:       aws.String("meeting-1234"),
+				FromPhoneNumber: aws.String("+12065550199"),
+				ToPhoneNumber:   aws.String(c.PhoneNumber),
+				JoinToken:       aws.String("token"),
+			})
+			if c.ExpectAllowed {
+				if err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+				if e, a := 1, calls; e != a {
+					t.Errorf("expect %v calls, got %v", e, a)
+				}
+				return
+			}
+
+			var notAllowed *DialOutNotAllowedError
+			if !errors.As(err, &notAllowed) {
+				t.Fatalf("expect %T error, got %v", notAllowed, err)
+			}
+			if e, a := c.PhoneNumber, notAllowed.PhoneNumber; e != a {
+				t.Errorf("expect %v phone number, got %v", e, a)
+			}
+			if e, a := 0, calls; e != a {
+				t.Errorf("expect %v calls, got %v", e, a)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package chime
+
+import (
+	"reflect"
+)
+
+// Operations returns the names of the API operations of the client, sorted by
+// name, such as for building command line tools dynamically. The names are
+// the operation names registered with each operation's service metadata.
+func (c *Client) Operations() []string {
+	t := reflect.TypeOf(c)
+
+	var names []string
+	for i := 0; i < t.NumMethod(); i++ {
+		m := t.Method(i)
+		// API operations take a context, the operation's input, and the
+		// functional options, and return the operation's output and an error.
+		if m.Type.NumIn() != 4 || m.Type.NumOut() != 2 || !m.Type.IsVariadic() {
+			continue
+		}
+		input := m.Type.In(2)
+		if input.Kind() != reflect.Ptr || input.Elem().Name() != m.Name+"Input" {
+			continue
+		}
+		names = append(names, m.Name)
+	}
+	return names
+}
@@ -0,0 +1,93 @@
+package cloud9
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting/unit"
+	"github.com/aws/aws-sdk-go-v2/internal/sdk"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestClient_RetryTransientNetworkErrors(t *testing.T) {
+	restoreSleep := sdk.TestingUseNopSleep()
+	defer restoreSleep()
+
+	createEnvironment := func(ctx context.Context, client *Client) error {
+		_, err := client.CreateEnvironmentEC2(ctx, &CreateEnvironmentEC2Input{
+			Name:         aws.String("environment"),
+			InstanceType: aws.String("t2.micro"),
+		})
+		return err
+	}
+
+	cases := map[string]struct {
+		Retry       bool
+		Operation   func(context.Context, *Client) error
+		ExpectCalls int
+		ExpectErr   error
+	}{
+		"idempotent operation": {
+			Retry:       true,
+			Operation:   createEnvironment,
+			ExpectCalls: 2,
+		},
+		"not retried without option": {
+			Operation:   createEnvironment,
+			ExpectCalls: 1,
+			ExpectErr:   io.ErrUnexpectedEOF,
+		},
+		"not retried for other operations": {
+			Retry: true,
+			Operation: func(ctx context.Context, client *Client) error {
+				_, err := client.DescribeEnvironments(ctx, &DescribeEnvironmentsInput{
+					EnvironmentIds: []string{"environment"},
+				})
+				return err
+			},
+			ExpectCalls: 1,
+			ExpectErr:   io.ErrUnexpectedEOF,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls int
+			client := New(Options{
+				Region:                      "us-west-2",
+				Credentials:                 unit.StubCredentialsProvider{},
+				RetryTransientNetworkErrors: c.Retry,
+				HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+					calls++
+					body := `{}`
+					if calls == 1 {
+						body = `{"environmentId":`
+					}
+					return &http.Response{
+						StatusCode: 200,
+						Header:     http.Header{},
+						Body:       ioutil.NopCloser(strings.NewReader(body)),
+					}, nil
+				}),
+			})
+
+			err := c.Operation(context.Background(), client)
+			if c.ExpectErr != nil {
+				if !errors.Is(err, c.ExpectErr) {
+					t.Errorf("expect %v error, got %v", c.ExpectErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectCalls, calls; e != a {
+				t.Errorf("expect %v calls, got %v", e, a)
+			}
+		})
+	}
+}
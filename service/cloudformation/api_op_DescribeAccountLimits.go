@@ -111,6 +111,33 @@ func addOperationDescribeAccountLimitsMiddlewares(stack *middleware.Stack, optio
 	if err = addRequestResponseLogging(stack, options); err != nil {
 		return err
 	}
+	if err = addUserAgentAppID(stack, options); err != nil {
+		return err
+	}
+	if err = addResponseSizeLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addOperationSpan(stack, options); err != nil {
+		return err
+	}
+	if err = addOnRequestBody(stack, options); err != nil {
+		return err
+	}
+	if err = addConcurrencyLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addCorrelationID(stack, options); err != nil {
+		return err
+	}
+	if err = addOnResponseBody(stack, options); err != nil {
+		return err
+	}
+	if err = addRequestBodySize(stack); err != nil {
+		return err
+	}
+	if err = addDisableMiddlewareGuards(stack); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -128,6 +155,11 @@ type DescribeAccountLimitsPaginatorOptions struct {
 	// Set to true if pagination should stop if the service returns a pagination token
 	// that matches the most recent token provided to the service.
 	StopOnDuplicateToken bool
+
+	// OnPage is called after each page is successfully retrieved, with the page
+	// number starting at 1, the number of items in the page, and the page's
+	// result metadata.
+	OnPage func(pageNum int, itemCount int, metadata middleware.Metadata)
 }
 
 // DescribeAccountLimitsPaginator is a paginator for DescribeAccountLimits
@@ -137,6 +169,7 @@ type DescribeAccountLimitsPaginator struct {
 	params    *DescribeAccountLimitsInput
 	nextToken *string
 	firstPage bool
+	pageNum   int
 }
 
 // NewDescribeAccountLimitsPaginator returns a new DescribeAccountLimitsPaginator
@@ -186,6 +219,11 @@ func (p *DescribeAccountLimitsPaginator) NextPage(ctx context.Context, optFns ..
 		p.nextToken = nil
 	}
 
+	p.pageNum++
+	if p.options.OnPage != nil {
+		p.options.OnPage(p.pageNum, len(result.AccountLimits), result.ResultMetadata)
+	}
+
 	return result, nil
 }
 
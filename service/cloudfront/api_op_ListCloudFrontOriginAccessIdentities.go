@@ -109,6 +109,33 @@ func addOperationListCloudFrontOriginAccessIdentitiesMiddlewares(stack *middlewa
 	if err = addRequestResponseLogging(stack, options); err != nil {
 		return err
 	}
+	if err = addUserAgentAppID(stack, options); err != nil {
+		return err
+	}
+	if err = addResponseSizeLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addOperationSpan(stack, options); err != nil {
+		return err
+	}
+	if err = addOnRequestBody(stack, options); err != nil {
+		return err
+	}
+	if err = addConcurrencyLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addCorrelationID(stack, options); err != nil {
+		return err
+	}
+	if err = addOnResponseBody(stack, options); err != nil {
+		return err
+	}
+	if err = addRequestBodySize(stack); err != nil {
+		return err
+	}
+	if err = addDisableMiddlewareGuards(stack); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -129,6 +156,17 @@ type ListCloudFrontOriginAccessIdentitiesPaginatorOptions struct {
 	// Set to true if pagination should stop if the service returns a pagination token
 	// that matches the most recent token provided to the service.
 	StopOnDuplicateToken bool
+
+	// OnPage is called after each page is successfully retrieved, with the page
+	// number starting at 1, the number of items in the page, and the page's
+	// result metadata.
+	OnPage func(pageNum int, itemCount int, metadata middleware.Metadata)
+
+	// The limit of the first page, if less than Limit. The limit is doubled for
+	// each following page, up to Limit, so the first page is retrieved quickly
+	// while later pages retrieve more items at once. Ignored if zero, or if
+	// Limit is not set.
+	AdaptiveLimit int32
 }
 
 // ListCloudFrontOriginAccessIdentitiesPaginator is a paginator for
@@ -139,6 +177,9 @@ type ListCloudFrontOriginAccessIdentitiesPaginator struct {
 	params    *ListCloudFrontOriginAccessIdentitiesInput
 	nextToken *string
 	firstPage bool
+	pageNum   int
+
+	adaptiveLimit int32
 }
 
 // NewListCloudFrontOriginAccessIdentitiesPaginator returns a new
@@ -162,6 +203,8 @@ func NewListCloudFrontOriginAccessIdentitiesPaginator(client ListCloudFrontOrigi
 		client:    client,
 		params:    params,
 		firstPage: true,
+
+		adaptiveLimit: options.AdaptiveLimit,
 	}
 }
 
@@ -182,6 +225,10 @@ func (p *ListCloudFrontOriginAccessIdentitiesPaginator) NextPage(ctx context.Con
 	var limit *int32
 	if p.options.Limit > 0 {
 		limit = &p.options.Limit
+		if p.adaptiveLimit > 0 && p.adaptiveLimit < p.options.Limit {
+			adaptiveLimit := p.adaptiveLimit
+			limit = &adaptiveLimit
+		}
 	}
 	params.MaxItems = limit
 
@@ -191,6 +238,10 @@ func (p *ListCloudFrontOriginAccessIdentitiesPaginator) NextPage(ctx context.Con
 	}
 	p.firstPage = false
 
+	if p.adaptiveLimit > 0 && p.adaptiveLimit < p.options.Limit {
+		p.adaptiveLimit *= 2
+	}
+
 	prevToken := p.nextToken
 	p.nextToken = nil
 	if result.CloudFrontOriginAccessIdentityList != nil {
@@ -201,6 +252,15 @@ func (p *ListCloudFrontOriginAccessIdentitiesPaginator) NextPage(ctx context.Con
 		p.nextToken = nil
 	}
 
+	p.pageNum++
+	if p.options.OnPage != nil {
+		var itemCount int
+		if result.CloudFrontOriginAccessIdentityList != nil {
+			itemCount = len(result.CloudFrontOriginAccessIdentityList.Items)
+		}
+		p.options.OnPage(p.pageNum, itemCount, result.ResultMetadata)
+	}
+
 	return result, nil
 }
 
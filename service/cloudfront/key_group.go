@@ -0,0 +1,24 @@
+package cloudfront
+
+import (
+	"fmt"
+)
+
+// Ref returns the ID and ETag of the created key group, as needed to get,
+// update, or delete the key group with subsequent operations. Returns an error
+// if the output does not include the key group's ID or ETag.
+//
+//    out, err := client.CreateKeyGroup(ctx, params)
+//    if err != nil {
+//        return err
+//    }
+//    id, etag, err := out.Ref()
+func (o *CreateKeyGroupOutput) Ref() (id string, etag string, err error) {
+	if o == nil || o.KeyGroup == nil || o.KeyGroup.Id == nil || len(*o.KeyGroup.Id) == 0 {
+		return "", "", fmt.Errorf("no key group ID in CreateKeyGroup output")
+	}
+	if o.ETag == nil || len(*o.ETag) == 0 {
+		return "", "", fmt.Errorf("no ETag in CreateKeyGroup output for key group %s", *o.KeyGroup.Id)
+	}
+	return *o.KeyGroup.Id, *o.ETag, nil
+}
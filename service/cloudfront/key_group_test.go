@@ -0,0 +1,76 @@
+package cloudfront
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+)
+
+func TestCreateKeyGroupOutput_Ref(t *testing.T) {
+	cases := map[string]struct {
+		Output     *CreateKeyGroupOutput
+		ExpectID   string
+		ExpectETag string
+		ExpectErr  bool
+	}{
+		"populated": {
+			Output: &CreateKeyGroupOutput{
+				ETag:     aws.String("E2QWRUHAPOMQZL"),
+				Location: aws.String("https://cloudfront.amazonaws.com/2020-05-31/key-group/kg-1234"),
+				KeyGroup: &types.KeyGroup{
+					Id: aws.String("kg-1234"),
+					KeyGroupConfig: &types.KeyGroupConfig{
+						Name:  aws.String("signers"),
+						Items: []string{"K1234"},
+					},
+				},
+			},
+			ExpectID:   "kg-1234",
+			ExpectETag: "E2QWRUHAPOMQZL",
+		},
+		"nil key group": {
+			Output: &CreateKeyGroupOutput{
+				ETag: aws.String("E2QWRUHAPOMQZL"),
+			},
+			ExpectErr: true,
+		},
+		"nil key group ID": {
+			Output: &CreateKeyGroupOutput{
+				ETag:     aws.String("E2QWRUHAPOMQZL"),
+				KeyGroup: &types.KeyGroup{},
+			},
+			ExpectErr: true,
+		},
+		"nil ETag": {
+			Output: &CreateKeyGroupOutput{
+				KeyGroup: &types.KeyGroup{Id: aws.String("kg-1234")},
+			},
+			ExpectErr: true,
+		},
+		"nil output": {
+			ExpectErr: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			id, etag, err := c.Output.Ref()
+			if c.ExpectErr {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectID, id; e != a {
+				t.Errorf("expect %v ID, got %v", e, a)
+			}
+			if e, a := c.ExpectETag, etag; e != a {
+				t.Errorf("expect %v ETag, got %v", e, a)
+			}
+		})
+	}
+}
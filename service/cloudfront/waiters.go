@@ -0,0 +1,64 @@
+package cloudfront
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// DistributionDeployedRetryable is a Retryable for DistributionDeployedWaiter,
+// retrying until the distribution's Status is Deployed. Set it as the waiter's
+// Retryable option to compare the Status by value, which the service-modeled
+// comparison, expecting a string rather than the Status pointer, fails to do.
+//
+//    w := NewDistributionDeployedWaiter(client, func(o *DistributionDeployedWaiterOptions) {
+//        o.Retryable = DistributionDeployedRetryable
+//    })
+func DistributionDeployedRetryable(ctx context.Context, input *GetDistributionInput, output *GetDistributionOutput, err error) (bool, error) {
+	if err == nil && output.Distribution != nil && aws.ToString(output.Distribution.Status) == "Deployed" {
+		return false, nil
+	}
+	return true, nil
+}
+
+// WithDistributionDeployedETag returns a DistributionDeployedWaiter option
+// setting etag to the ETag of the distribution returned by the waiter's last
+// GetDistribution call, such as for the IfMatch of a following
+// UpdateDistribution call once the distribution is deployed. The option wraps
+// the waiter's Retryable, and must follow options setting the Retryable.
+//
+//    var etag string
+//    err := w.Wait(ctx, params, maxWaitDur, func(o *DistributionDeployedWaiterOptions) {
+//        o.Retryable = DistributionDeployedRetryable
+//    }, WithDistributionDeployedETag(&etag))
+func WithDistributionDeployedETag(etag *string) func(*DistributionDeployedWaiterOptions) {
+	return func(o *DistributionDeployedWaiterOptions) {
+		retryable := o.Retryable
+		o.Retryable = func(ctx context.Context, input *GetDistributionInput, output *GetDistributionOutput, err error) (bool, error) {
+			if err == nil && output != nil {
+				*etag = aws.ToString(output.ETag)
+			}
+			return retryable(ctx, input, output, err)
+		}
+	}
+}
+
+// InvalidationCompletedRetryable is a Retryable for
+// InvalidationCompletedWaiter, retrying until the invalidation's Status is
+// Completed. See DistributionDeployedRetryable.
+func InvalidationCompletedRetryable(ctx context.Context, input *GetInvalidationInput, output *GetInvalidationOutput, err error) (bool, error) {
+	if err == nil && output.Invalidation != nil && aws.ToString(output.Invalidation.Status) == "Completed" {
+		return false, nil
+	}
+	return true, nil
+}
+
+// StreamingDistributionDeployedRetryable is a Retryable for
+// StreamingDistributionDeployedWaiter, retrying until the streaming
+// distribution's Status is Deployed. See DistributionDeployedRetryable.
+func StreamingDistributionDeployedRetryable(ctx context.Context, input *GetStreamingDistributionInput, output *GetStreamingDistributionOutput, err error) (bool, error) {
+	if err == nil && output.StreamingDistribution != nil && aws.ToString(output.StreamingDistribution.Status) == "Deployed" {
+		return false, nil
+	}
+	return true, nil
+}
@@ -0,0 +1,105 @@
+package cloudfront
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+)
+
+type mockGetDistributionClient struct {
+	statuses []string
+	calls    int
+}
+
+func (m *mockGetDistributionClient) GetDistribution(ctx context.Context, params *GetDistributionInput, optFns ...func(*Options)) (*GetDistributionOutput, error) {
+	status := m.statuses[m.calls]
+	m.calls++
+	return &GetDistributionOutput{
+		Distribution: &types.Distribution{
+			Id:     params.Id,
+			Status: aws.String(status),
+		},
+		ETag: aws.String("E2QWRUHEXAMPLE" + strconv.Itoa(m.calls)),
+	}, nil
+}
+
+func TestDistributionDeployedWaiter(t *testing.T) {
+	cases := map[string]struct {
+		Statuses    []string
+		MaxWait     time.Duration
+		ExpectCalls int
+		ExpectETag  string
+		ExpectErr   string
+	}{
+		"in progress to deployed": {
+			Statuses:    []string{"InProgress", "InProgress", "Deployed"},
+			MaxWait:     time.Minute,
+			ExpectCalls: 3,
+			ExpectETag:  "E2QWRUHEXAMPLE3",
+		},
+		"already deployed": {
+			Statuses:    []string{"Deployed"},
+			MaxWait:     time.Minute,
+			ExpectCalls: 1,
+			ExpectETag:  "E2QWRUHEXAMPLE1",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockGetDistributionClient{statuses: c.Statuses}
+			w := NewDistributionDeployedWaiter(client, func(o *DistributionDeployedWaiterOptions) {
+				o.Retryable = DistributionDeployedRetryable
+				o.MinDelay = time.Millisecond
+				o.MaxDelay = time.Millisecond
+			})
+
+			var etag string
+			err := w.Wait(context.Background(), &GetDistributionInput{
+				Id: aws.String("EDFDVBD6EXAMPLE"),
+			}, c.MaxWait, WithDistributionDeployedETag(&etag))
+			if len(c.ExpectErr) != 0 {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				if e, a := c.ExpectErr, err.Error(); !strings.Contains(a, e) {
+					t.Errorf("expect %v in error, got %v", e, a)
+				}
+			} else if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectCalls, client.calls; e != a {
+				t.Errorf("expect %v calls, got %v", e, a)
+			}
+			if e, a := c.ExpectETag, etag; e != a {
+				t.Errorf("expect %v etag, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestDistributionDeployedWaiter_DelayBounds(t *testing.T) {
+	client := &mockGetDistributionClient{statuses: []string{"Deployed"}}
+	w := NewDistributionDeployedWaiter(client)
+
+	err := w.Wait(context.Background(), &GetDistributionInput{
+		Id: aws.String("EDFDVBD6EXAMPLE"),
+	}, time.Minute, func(o *DistributionDeployedWaiterOptions) {
+		o.MinDelay = 2 * time.Second
+		o.MaxDelay = time.Second
+	})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := "minimum waiter delay", err.Error(); !strings.Contains(a, e) {
+		t.Errorf("expect %v in error, got %v", e, a)
+	}
+	if e, a := 0, client.calls; e != a {
+		t.Errorf("expect %v calls, got %v", e, a)
+	}
+}
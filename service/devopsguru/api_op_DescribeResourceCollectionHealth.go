@@ -123,6 +123,33 @@ func addOperationDescribeResourceCollectionHealthMiddlewares(stack *middleware.S
 	if err = addRequestResponseLogging(stack, options); err != nil {
 		return err
 	}
+	if err = addUserAgentAppID(stack, options); err != nil {
+		return err
+	}
+	if err = addResponseSizeLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addOperationSpan(stack, options); err != nil {
+		return err
+	}
+	if err = addOnRequestBody(stack, options); err != nil {
+		return err
+	}
+	if err = addConcurrencyLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addCorrelationID(stack, options); err != nil {
+		return err
+	}
+	if err = addOnResponseBody(stack, options); err != nil {
+		return err
+	}
+	if err = addRequestBodySize(stack); err != nil {
+		return err
+	}
+	if err = addDisableMiddlewareGuards(stack); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -140,6 +167,11 @@ type DescribeResourceCollectionHealthPaginatorOptions struct {
 	// Set to true if pagination should stop if the service returns a pagination token
 	// that matches the most recent token provided to the service.
 	StopOnDuplicateToken bool
+
+	// OnPage is called after each page is successfully retrieved, with the page
+	// number starting at 1, the number of items in the page, and the page's
+	// result metadata.
+	OnPage func(pageNum int, itemCount int, metadata middleware.Metadata)
 }
 
 // DescribeResourceCollectionHealthPaginator is a paginator for
@@ -150,6 +182,7 @@ type DescribeResourceCollectionHealthPaginator struct {
 	params    *DescribeResourceCollectionHealthInput
 	nextToken *string
 	firstPage bool
+	pageNum   int
 }
 
 // NewDescribeResourceCollectionHealthPaginator returns a new
@@ -200,6 +233,11 @@ func (p *DescribeResourceCollectionHealthPaginator) NextPage(ctx context.Context
 		p.nextToken = nil
 	}
 
+	p.pageNum++
+	if p.options.OnPage != nil {
+		p.options.OnPage(p.pageNum, len(result.CloudFormation), result.ResultMetadata)
+	}
+
 	return result, nil
 }
 
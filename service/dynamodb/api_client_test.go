@@ -0,0 +1,115 @@
+package dynamodb
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"hash/crc32"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting/unit"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestClient_ValidateResponseChecksumGzip(t *testing.T) {
+	content := []byte(`{"TableNames":["table"]}`)
+
+	var gzipped bytes.Buffer
+	w := gzip.NewWriter(&gzipped)
+	w.Write(content)
+	w.Close()
+
+	corrupted := append([]byte{}, gzipped.Bytes()...)
+	corrupted[len(corrupted)-9] ^= 0xff
+
+	cases := map[string]struct {
+		Body           []byte
+		Checksum       []byte
+		ExpectErr      bool
+		ExpectMismatch bool
+	}{
+		"checksum of gzipped body": {
+			Body:     gzipped.Bytes(),
+			Checksum: gzipped.Bytes(),
+		},
+		"checksum of decompressed body": {
+			Body:           gzipped.Bytes(),
+			Checksum:       content,
+			ExpectErr:      true,
+			ExpectMismatch: true,
+		},
+		// The corrupted body fails to be decompressed before its checksum
+		// is validated.
+		"corrupted gzipped body": {
+			Body:      corrupted,
+			Checksum:  gzipped.Bytes(),
+			ExpectErr: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := New(Options{
+				Region:                   "us-west-2",
+				Credentials:              unit.StubCredentialsProvider{},
+				EnableAcceptEncodingGzip: true,
+				HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: 200,
+						Header: http.Header{
+							"Content-Encoding": []string{"gzip"},
+							"X-Amz-Crc32":      []string{strconv.FormatUint(uint64(crc32.ChecksumIEEE(c.Checksum)), 10)},
+						},
+						Body: ioutil.NopCloser(bytes.NewReader(c.Body)),
+					}, nil
+				}),
+			})
+
+			out, err := client.ListTables(context.Background(), &ListTablesInput{})
+			if c.ExpectErr {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				if c.ExpectMismatch && !errors.Is(err, awshttp.ErrChecksumMismatch) {
+					t.Fatalf("expect checksum mismatch error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := []string{"table"}, out.TableNames; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v tables, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestClient_ValidateResponseChecksumMissing(t *testing.T) {
+	client := New(Options{
+		Region:      "us-west-2",
+		Credentials: unit.StubCredentialsProvider{},
+		HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"TableNames":["table"]}`))),
+			}, nil
+		}),
+	})
+
+	// A response without a checksum header is not validated.
+	out, err := client.ListTables(context.Background(), &ListTablesInput{})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := []string{"table"}, out.TableNames; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v tables, got %v", e, a)
+	}
+}
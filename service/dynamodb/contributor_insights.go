@@ -0,0 +1,118 @@
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/internal/validation"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	smithy "github.com/aws/smithy-go"
+)
+
+// tableNamePattern matches valid DynamoDB table names.
+var tableNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_.-]{3,255}$`)
+
+// ListEnabledContributorInsights returns all ContributorInsightsSummary values
+// for the table, and its global secondary indexes, whose contributor insights
+// status is ENABLED. All pages of the ListContributorInsights operation are
+// retrieved before returning.
+func ListEnabledContributorInsights(ctx context.Context, client ListContributorInsightsAPIClient, tableName string, optFns ...func(*Options)) ([]types.ContributorInsightsSummary, error) {
+	return ListContributorInsightsWithStatus(ctx, client, tableName, types.ContributorInsightsStatusEnabled, optFns...)
+}
+
+// ListContributorInsightsForTable returns all ContributorInsightsSummary values
+// for the table, and its global secondary indexes. All pages of the
+// ListContributorInsights operation are retrieved before returning.
+//
+// Unlike ListContributorInsights, whose TableName is optional and lists the
+// summaries of all tables in the account when omitted, tableName is required
+// and must be a valid table name. An error is returned without invoking the
+// operation if it is not.
+func ListContributorInsightsForTable(ctx context.Context, client ListContributorInsightsAPIClient, tableName string, optFns ...func(*Options)) ([]types.ContributorInsightsSummary, error) {
+	if err := validateContributorInsightsTableName(tableName); err != nil {
+		return nil, err
+	}
+
+	var summaries []types.ContributorInsightsSummary
+	p := NewListContributorInsightsPaginator(client, &ListContributorInsightsInput{
+		TableName: &tableName,
+	})
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx, optFns...)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, page.ContributorInsightsSummaries...)
+	}
+
+	return summaries, nil
+}
+
+func validateContributorInsightsTableName(tableName string) error {
+	invalidParams := smithy.InvalidParamsError{Context: "ListContributorInsightsForTable"}
+	if len(tableName) == 0 {
+		invalidParams.Add(smithy.NewErrParamRequired("TableName"))
+	} else if !tableNamePattern.MatchString(tableName) {
+		invalidParams.Add(validation.NewErrInvalidValue("TableName",
+			fmt.Sprintf("%q is not a valid table name", tableName)))
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// ListContributorInsightsWithStatus returns all ContributorInsightsSummary
+// values for the table, and its global secondary indexes, whose contributor
+// insights status matches the status provided. All pages of the
+// ListContributorInsights operation are retrieved before returning.
+//
+// If tableName is empty the summaries for all tables in the account are
+// listed. Use ListContributorInsightsForTable to require a table name.
+func ListContributorInsightsWithStatus(ctx context.Context, client ListContributorInsightsAPIClient, tableName string, status types.ContributorInsightsStatus, optFns ...func(*Options)) ([]types.ContributorInsightsSummary, error) {
+	params := &ListContributorInsightsInput{}
+	if len(tableName) != 0 {
+		params.TableName = &tableName
+	}
+
+	var summaries []types.ContributorInsightsSummary
+	p := NewListContributorInsightsPaginator(client, params)
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx, optFns...)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range page.ContributorInsightsSummaries {
+			if s.ContributorInsightsStatus == status {
+				summaries = append(summaries, s)
+			}
+		}
+	}
+
+	return summaries, nil
+}
+
+// AllContributorInsights returns the ContributorInsightsSummary values of all
+// tables in the account, and their global secondary indexes, grouped by table
+// name. All pages of the ListContributorInsights operation are retrieved, with
+// TableName omitted, before returning.
+func AllContributorInsights(ctx context.Context, client ListContributorInsightsAPIClient, optFns ...func(*Options)) (map[string][]types.ContributorInsightsSummary, error) {
+	summaries := map[string][]types.ContributorInsightsSummary{}
+	p := NewListContributorInsightsPaginator(client, &ListContributorInsightsInput{})
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx, optFns...)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range page.ContributorInsightsSummaries {
+			var tableName string
+			if s.TableName != nil {
+				tableName = *s.TableName
+			}
+			summaries[tableName] = append(summaries[tableName], s)
+		}
+	}
+
+	return summaries, nil
+}
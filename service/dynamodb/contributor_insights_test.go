@@ -0,0 +1,229 @@
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+type mockListContributorInsightsClient struct {
+	pages  []*ListContributorInsightsOutput
+	inputs []*ListContributorInsightsInput
+}
+
+func (m *mockListContributorInsightsClient) ListContributorInsights(ctx context.Context, params *ListContributorInsightsInput, optFns ...func(*Options)) (*ListContributorInsightsOutput, error) {
+	m.inputs = append(m.inputs, params)
+	if len(m.inputs) > len(m.pages) {
+		return nil, fmt.Errorf("unexpected page request %d", len(m.inputs))
+	}
+	return m.pages[len(m.inputs)-1], nil
+}
+
+func newMixedStatusInsightsPages() []*ListContributorInsightsOutput {
+	return []*ListContributorInsightsOutput{
+		{
+			ContributorInsightsSummaries: []types.ContributorInsightsSummary{
+				{TableName: aws.String("table"), ContributorInsightsStatus: types.ContributorInsightsStatusEnabled},
+				{TableName: aws.String("table"), IndexName: aws.String("idx1"), ContributorInsightsStatus: types.ContributorInsightsStatusFailed},
+			},
+			NextToken: aws.String("token1"),
+		},
+		{
+			ContributorInsightsSummaries: []types.ContributorInsightsSummary{
+				{TableName: aws.String("table"), IndexName: aws.String("idx2"), ContributorInsightsStatus: types.ContributorInsightsStatusDisabled},
+				{TableName: aws.String("table"), IndexName: aws.String("idx3"), ContributorInsightsStatus: types.ContributorInsightsStatusEnabled},
+			},
+		},
+	}
+}
+
+func TestListContributorInsightsWithStatus(t *testing.T) {
+	cases := map[string]struct {
+		Status        types.ContributorInsightsStatus
+		ExpectIndexes []string
+	}{
+		"enabled": {
+			Status:        types.ContributorInsightsStatusEnabled,
+			ExpectIndexes: []string{"", "idx3"},
+		},
+		"failed": {
+			Status:        types.ContributorInsightsStatusFailed,
+			ExpectIndexes: []string{"idx1"},
+		},
+		"enabling": {
+			Status: types.ContributorInsightsStatusEnabling,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockListContributorInsightsClient{pages: newMixedStatusInsightsPages()}
+
+			summaries, err := ListContributorInsightsWithStatus(context.Background(), client, "table", c.Status)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := 2, len(client.inputs); e != a {
+				t.Fatalf("expect %v page requests, got %v", e, a)
+			}
+			if e, a := "table", aws.ToString(client.inputs[0].TableName); e != a {
+				t.Errorf("expect %v table name, got %v", e, a)
+			}
+			if e, a := "token1", aws.ToString(client.inputs[1].NextToken); e != a {
+				t.Errorf("expect %v next token, got %v", e, a)
+			}
+
+			if e, a := len(c.ExpectIndexes), len(summaries); e != a {
+				t.Fatalf("expect %v summaries, got %v", e, a)
+			}
+			for i, s := range summaries {
+				if e, a := c.Status, s.ContributorInsightsStatus; e != a {
+					t.Errorf("%d, expect %v status, got %v", i, e, a)
+				}
+				if e, a := c.ExpectIndexes[i], aws.ToString(s.IndexName); e != a {
+					t.Errorf("%d, expect %v index, got %v", i, e, a)
+				}
+			}
+		})
+	}
+}
+
+func TestListEnabledContributorInsights(t *testing.T) {
+	client := &mockListContributorInsightsClient{pages: newMixedStatusInsightsPages()}
+
+	summaries, err := ListEnabledContributorInsights(context.Background(), client, "table")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 2, len(summaries); e != a {
+		t.Fatalf("expect %v summaries, got %v", e, a)
+	}
+	for _, s := range summaries {
+		if e, a := types.ContributorInsightsStatusEnabled, s.ContributorInsightsStatus; e != a {
+			t.Errorf("expect %v status, got %v", e, a)
+		}
+	}
+}
+
+func TestListContributorInsightsForTable(t *testing.T) {
+	client := &mockListContributorInsightsClient{pages: newMixedStatusInsightsPages()}
+
+	summaries, err := ListContributorInsightsForTable(context.Background(), client, "table")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 4, len(summaries); e != a {
+		t.Errorf("expect %v summaries, got %v", e, a)
+	}
+	for i, input := range client.inputs {
+		if e, a := "table", aws.ToString(input.TableName); e != a {
+			t.Errorf("expect %v table name for request %d, got %v", e, i, a)
+		}
+	}
+}
+
+func TestListContributorInsightsForTable_InvalidTableName(t *testing.T) {
+	cases := map[string]struct {
+		TableName string
+		ExpectErr string
+	}{
+		"empty": {
+			ExpectErr: "missing required field, ListContributorInsightsForTable.TableName",
+		},
+		"too short": {
+			TableName: "ab",
+			ExpectErr: "not a valid table name",
+		},
+		"invalid characters": {
+			TableName: "my table",
+			ExpectErr: "not a valid table name",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockListContributorInsightsClient{}
+
+			_, err := ListContributorInsightsForTable(context.Background(), client, c.TableName)
+			if err == nil {
+				t.Fatalf("expect error, got none")
+			}
+			if e, a := c.ExpectErr, err.Error(); !strings.Contains(a, e) {
+				t.Errorf("expect error to contain %v, got %v", e, a)
+			}
+			if e, a := 0, len(client.inputs); e != a {
+				t.Errorf("expect %v requests, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestAllContributorInsights(t *testing.T) {
+	client := &mockListContributorInsightsClient{
+		pages: []*ListContributorInsightsOutput{
+			{
+				ContributorInsightsSummaries: []types.ContributorInsightsSummary{
+					{TableName: aws.String("table1"), ContributorInsightsStatus: types.ContributorInsightsStatusEnabled},
+					{TableName: aws.String("table2"), ContributorInsightsStatus: types.ContributorInsightsStatusDisabled},
+				},
+				NextToken: aws.String("token1"),
+			},
+			{
+				ContributorInsightsSummaries: []types.ContributorInsightsSummary{
+					{TableName: aws.String("table1"), IndexName: aws.String("idx1"), ContributorInsightsStatus: types.ContributorInsightsStatusEnabled},
+					{TableName: aws.String("table3"), ContributorInsightsStatus: types.ContributorInsightsStatusFailed},
+				},
+				NextToken: aws.String("token2"),
+			},
+			{
+				ContributorInsightsSummaries: []types.ContributorInsightsSummary{
+					{TableName: aws.String("table2"), IndexName: aws.String("idx2"), ContributorInsightsStatus: types.ContributorInsightsStatusEnabling},
+				},
+			},
+		},
+	}
+
+	summaries, err := AllContributorInsights(context.Background(), client)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := 3, len(client.inputs); e != a {
+		t.Fatalf("expect %v page requests, got %v", e, a)
+	}
+	for i, input := range client.inputs {
+		if input.TableName != nil {
+			t.Errorf("expect no table name for request %d, got %v", i, *input.TableName)
+		}
+	}
+	if e, a := "token2", aws.ToString(client.inputs[2].NextToken); e != a {
+		t.Errorf("expect %v next token, got %v", e, a)
+	}
+
+	expect := map[string][]string{
+		"table1": {"", "idx1"},
+		"table2": {"", "idx2"},
+		"table3": {""},
+	}
+	if e, a := len(expect), len(summaries); e != a {
+		t.Fatalf("expect %v tables, got %v", e, a)
+	}
+	for table, indexes := range expect {
+		if e, a := len(indexes), len(summaries[table]); e != a {
+			t.Fatalf("expect %v summaries for %v, got %v", e, table, a)
+		}
+		for i, s := range summaries[table] {
+			if e, a := table, aws.ToString(s.TableName); e != a {
+				t.Errorf("%d, expect %v table, got %v", i, e, a)
+			}
+			if e, a := indexes[i], aws.ToString(s.IndexName); e != a {
+				t.Errorf("%d, expect %v index, got %v", i, e, a)
+			}
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package dynamodb
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// IsConditionalCheckFailed returns if the error is, or wraps, a
+// ConditionalCheckFailedException, returned when the condition expression of
+// a conditional write, such as a PutItem, UpdateItem, or DeleteItem with a
+// ConditionExpression, evaluates to false. Use errors.As with a
+// *types.ConditionalCheckFailedException to retrieve the error.
+func IsConditionalCheckFailed(err error) bool {
+	var conditionalCheckFailed *types.ConditionalCheckFailedException
+	return errors.As(err, &conditionalCheckFailed)
+}
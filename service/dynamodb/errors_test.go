@@ -0,0 +1,82 @@
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting/unit"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestIsConditionalCheckFailed(t *testing.T) {
+	cases := map[string]struct {
+		Err    error
+		Expect bool
+	}{
+		"nil": {},
+		"conditional check failed": {
+			Err:    &types.ConditionalCheckFailedException{Message: aws.String("The conditional request failed")},
+			Expect: true,
+		},
+		"wrapped": {
+			Err:    fmt.Errorf("put item, %w", &types.ConditionalCheckFailedException{}),
+			Expect: true,
+		},
+		"other api error": {
+			Err: &types.ResourceNotFoundException{},
+		},
+		"other error": {
+			Err: errors.New("connection reset"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if e, a := c.Expect, IsConditionalCheckFailed(c.Err); e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestIsConditionalCheckFailed_Decoded(t *testing.T) {
+	client := New(Options{
+		Region:      "us-west-2",
+		Credentials: unit.StubCredentialsProvider{},
+		HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 400,
+				Header:     http.Header{},
+				Body: ioutil.NopCloser(strings.NewReader(
+					`{"__type":"com.amazonaws.dynamodb.v20120810#ConditionalCheckFailedException",` +
+						`"message":"The conditional request failed"}`)),
+			}, nil
+		}),
+	})
+
+	_, err := client.PutItem(context.Background(), &PutItemInput{
+		TableName: aws.String("table"),
+		Item: map[string]types.AttributeValue{
+			"id": &types.AttributeValueMemberS{Value: "1"},
+		},
+		ConditionExpression: aws.String("attribute_not_exists(id)"),
+	})
+	if !IsConditionalCheckFailed(err) {
+		t.Fatalf("expect conditional check failed error, got %v", err)
+	}
+
+	var conditionalCheckFailed *types.ConditionalCheckFailedException
+	if !errors.As(err, &conditionalCheckFailed) {
+		t.Fatalf("expect %T error, got %v", conditionalCheckFailed, err)
+	}
+	if e, a := "The conditional request failed", conditionalCheckFailed.ErrorMessage(); e != a {
+		t.Errorf("expect %v message, got %v", e, a)
+	}
+}
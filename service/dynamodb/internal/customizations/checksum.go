@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"strconv"
 
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	smithy "github.com/aws/smithy-go"
 	"github.com/aws/smithy-go/middleware"
 	smithyhttp "github.com/aws/smithy-go/transport/http"
@@ -60,6 +61,11 @@ func (m *Checksum) HandleDeserialize(
 	if err != nil {
 		return output, metadata, &smithy.DeserializationError{Err: err}
 	}
+	// Responses without a checksum are not validated, instead of being
+	// validated against a checksum of zero.
+	if !ok {
+		return output, metadata, err
+	}
 
 	resp.Body = wrapCRC32ChecksumValidate(expectChecksum, resp.Body)
 
@@ -104,15 +110,16 @@ func wrapCRC32ChecksumValidate(checksum uint32, reader io.ReadCloser) *crc32Chec
 	}
 }
 
-// Close validates the wrapped reader's CRC32 checksum. Returns an error if
-// the read checksum does not match the expected checksum.
+// Close validates the wrapped reader's CRC32 checksum. Returns an error
+// wrapping awshttp.ErrChecksumMismatch if the read checksum does not match
+// the expected checksum.
 //
 // May return an error if the wrapped io.Reader's close returns an error, if it
 // implements close.
 func (c *crc32ChecksumValidate) Close() error {
 	if actual := c.hash.Sum32(); actual != c.expect {
 		c.closer.Close()
-		return fmt.Errorf("response did not match expected checksum, %d, %d", c.expect, actual)
+		return fmt.Errorf("%w, %d, %d", awshttp.ErrChecksumMismatch, c.expect, actual)
 	}
 
 	return c.closer.Close()
@@ -23,7 +23,8 @@ If the header is present, the SDK should validate that the response payload
 computed CRC32 checksum matches the value provided in the header. The checksum
 header is based on the original payload provided returned by the service. Which
 means that if the response is gzipped the checksum is of the gzipped response,
-not the decompressed response bytes.
+not the decompressed response bytes. A checksum that does not match returns an
+error wrapping the ErrChecksumMismatch error of the aws/transport/http package.
 
 Customization option:
     DisableValidateResponseChecksum (Enabled by Default)
@@ -0,0 +1,111 @@
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/smithy-go/middleware"
+)
+
+type mockListContributorInsightsPagesClient struct {
+	pages  [][]types.ContributorInsightsSummary
+	limits []int32
+}
+
+func (m *mockListContributorInsightsPagesClient) ListContributorInsights(ctx context.Context, params *ListContributorInsightsInput, optFns ...func(*Options)) (*ListContributorInsightsOutput, error) {
+	m.limits = append(m.limits, params.MaxResults)
+
+	var idx int
+	if params.NextToken != nil {
+		fmt.Sscanf(*params.NextToken, "%d", &idx)
+	}
+	out := &ListContributorInsightsOutput{ContributorInsightsSummaries: m.pages[idx]}
+	out.ResultMetadata.Set("page", idx)
+	if idx+1 < len(m.pages) {
+		out.NextToken = aws.String(fmt.Sprintf("%d", idx+1))
+	}
+	return out, nil
+}
+
+func TestListContributorInsightsPaginator_OnPage(t *testing.T) {
+	client := &mockListContributorInsightsPagesClient{
+		pages: [][]types.ContributorInsightsSummary{{{}, {}}, {}, {{}}},
+	}
+
+	var pageNums, itemCounts, metadataPages []int
+	p := NewListContributorInsightsPaginator(client, &ListContributorInsightsInput{}, func(o *ListContributorInsightsPaginatorOptions) {
+		o.OnPage = func(pageNum int, itemCount int, metadata middleware.Metadata) {
+			pageNums = append(pageNums, pageNum)
+			itemCounts = append(itemCounts, itemCount)
+			metadataPages = append(metadataPages, metadata.Get("page").(int))
+		}
+	})
+	for p.HasMorePages() {
+		if _, err := p.NextPage(context.Background()); err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+	}
+
+	if e, a := []int{1, 2, 3}, pageNums; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v page numbers, got %v", e, a)
+	}
+	if e, a := []int{2, 0, 1}, itemCounts; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v item counts, got %v", e, a)
+	}
+	if e, a := []int{0, 1, 2}, metadataPages; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v page metadata, got %v", e, a)
+	}
+}
+
+func TestListContributorInsightsPaginator_AdaptiveLimit(t *testing.T) {
+	cases := map[string]struct {
+		Limit         int32
+		AdaptiveLimit int32
+		ExpectLimits  []int32
+	}{
+		"grows to limit": {
+			Limit:         10,
+			AdaptiveLimit: 2,
+			ExpectLimits:  []int32{2, 4, 8, 10, 10},
+		},
+		"not adaptive": {
+			Limit:        10,
+			ExpectLimits: []int32{10, 10, 10, 10, 10},
+		},
+		"adaptive limit above limit": {
+			Limit:         10,
+			AdaptiveLimit: 20,
+			ExpectLimits:  []int32{10, 10, 10, 10, 10},
+		},
+		"no limit": {
+			AdaptiveLimit: 2,
+			ExpectLimits:  []int32{0, 0, 0, 0, 0},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockListContributorInsightsPagesClient{
+				pages: make([][]types.ContributorInsightsSummary, 5),
+			}
+
+			p := NewListContributorInsightsPaginator(client, &ListContributorInsightsInput{}, func(o *ListContributorInsightsPaginatorOptions) {
+				o.Limit = c.Limit
+				o.AdaptiveLimit = c.AdaptiveLimit
+			})
+			for p.HasMorePages() {
+				if _, err := p.NextPage(context.Background()); err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+			}
+
+			if e, a := c.ExpectLimits, client.limits; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v limits, got %v", e, a)
+			}
+		})
+	}
+}
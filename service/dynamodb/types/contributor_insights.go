@@ -0,0 +1,26 @@
+package types
+
+import (
+	"strings"
+)
+
+// LabelSet returns the table name, index name, and status of the summary as a
+// set of metric labels, such as for exporting to Prometheus. The labels are
+// "table", "index", and "status", with the status lower cased. Every label is
+// always present, so the label set is the same for every summary, with an
+// empty value for the index of a table's summary, or for names that are not
+// set.
+func (s ContributorInsightsSummary) LabelSet() map[string]string {
+	labels := map[string]string{
+		"table":  "",
+		"index":  "",
+		"status": strings.ToLower(string(s.ContributorInsightsStatus)),
+	}
+	if s.TableName != nil {
+		labels["table"] = *s.TableName
+	}
+	if s.IndexName != nil {
+		labels["index"] = *s.IndexName
+	}
+	return labels
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestContributorInsightsSummary_LabelSet(t *testing.T) {
+	table, index := "orders", "by-customer"
+
+	cases := map[string]struct {
+		Summary ContributorInsightsSummary
+		Expect  map[string]string
+	}{
+		"table": {
+			Summary: ContributorInsightsSummary{
+				TableName:                 &table,
+				ContributorInsightsStatus: ContributorInsightsStatusEnabled,
+			},
+			Expect: map[string]string{"table": "orders", "index": "", "status": "enabled"},
+		},
+		"index": {
+			Summary: ContributorInsightsSummary{
+				TableName:                 &table,
+				IndexName:                 &index,
+				ContributorInsightsStatus: ContributorInsightsStatusDisabling,
+			},
+			Expect: map[string]string{"table": "orders", "index": "by-customer", "status": "disabling"},
+		},
+		"empty": {
+			Expect: map[string]string{"table": "", "index": "", "status": ""},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if e, a := c.Expect, c.Summary.LabelSet(); !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v labels, got %v", e, a)
+			}
+		})
+	}
+}
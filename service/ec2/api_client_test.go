@@ -0,0 +1,199 @@
+package ec2
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting/unit"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestClient_WithAPIOptions(t *testing.T) {
+	var counts []string
+	counter := func(id string) func(*middleware.Stack) error {
+		return func(stack *middleware.Stack) error {
+			return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(id,
+				func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (
+					out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+				) {
+					counts = append(counts, stack.ID())
+					return next.HandleInitialize(ctx, in)
+				}), middleware.Before)
+		}
+	}
+
+	client := New(Options{
+		Region:      "us-west-2",
+		Credentials: unit.StubCredentialsProvider{},
+		HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(strings.NewReader(`<Response></Response>`)),
+			}, nil
+		}),
+	}, WithAPIOptions(counter("clientCounter")))
+
+	if _, err := client.DescribeVpcs(context.Background(), &DescribeVpcsInput{}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if _, err := client.DescribeSubnets(context.Background(), &DescribeSubnetsInput{}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if _, err := client.DeleteVpc(context.Background(), &DeleteVpcInput{
+		VpcId: aws.String("vpc-1234"),
+	}, WithAPIOptions(counter("operationCounter"))); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expect := []string{"DescribeVpcs", "DescribeSubnets", "DeleteVpc", "DeleteVpc"}
+	if e, a := expect, counts; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v operations counted, got %v", e, a)
+	}
+	if e, a := 1, len(client.options.APIOptions); e != a {
+		t.Errorf("expect per operation options to not modify client, expect %v APIOptions, got %v", e, a)
+	}
+}
+
+func TestClient_OnRequestBody(t *testing.T) {
+	var sent []byte
+	var captured []string
+	var capturedBody []byte
+
+	client := New(Options{
+		Region:      "us-west-2",
+		Credentials: unit.StubCredentialsProvider{},
+		HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			sent, _ = ioutil.ReadAll(r.Body)
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(strings.NewReader(`<Response></Response>`)),
+			}, nil
+		}),
+		OnRequestBody: func(opName string, body []byte) {
+			captured = append(captured, opName)
+			capturedBody = body
+		},
+	})
+
+	_, err := client.DeleteVpc(context.Background(), &DeleteVpcInput{
+		VpcId: aws.String("vpc-1234"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := []string{"DeleteVpc"}, captured; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v operations captured, got %v", e, a)
+	}
+	if e, a := "Action=DeleteVpc&Version=2016-11-15&VpcId=vpc-1234", string(capturedBody); e != a {
+		t.Errorf("expect %v captured body, got %v", e, a)
+	}
+	if e, a := sent, capturedBody; !bytes.Equal(e, a) {
+		t.Errorf("expect captured body to match sent body %q, got %q", e, a)
+	}
+}
+
+func TestClient_OnResponseBody(t *testing.T) {
+	const responseBody = `<DescribeVpcsResponse><vpcSet><item><vpcId>vpc-1234</vpcId></item></vpcSet></DescribeVpcsResponse>`
+
+	var captured []string
+	var capturedStatus int
+	var capturedBody []byte
+
+	client := New(Options{
+		Region:      "us-west-2",
+		Credentials: unit.StubCredentialsProvider{},
+		HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(strings.NewReader(responseBody)),
+			}, nil
+		}),
+		OnResponseBody: func(opName string, status int, body []byte) {
+			captured = append(captured, opName)
+			capturedStatus = status
+			capturedBody = body
+		},
+	})
+
+	out, err := client.DescribeVpcs(context.Background(), &DescribeVpcsInput{})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := []string{"DescribeVpcs"}, captured; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v operations captured, got %v", e, a)
+	}
+	if e, a := 200, capturedStatus; e != a {
+		t.Errorf("expect %v captured status, got %v", e, a)
+	}
+	if e, a := responseBody, string(capturedBody); e != a {
+		t.Errorf("expect %v captured body, got %v", e, a)
+	}
+	if e, a := 1, len(out.Vpcs); e != a {
+		t.Fatalf("expect %v vpcs, got %v", e, a)
+	}
+	if e, a := "vpc-1234", aws.ToString(out.Vpcs[0].VpcId); e != a {
+		t.Errorf("expect %v vpc, got %v", e, a)
+	}
+}
+
+func TestClient_CorrelationIDHeader(t *testing.T) {
+	cases := map[string]struct {
+		Header       string
+		ExpectHeader string
+	}{
+		"default header": {
+			ExpectHeader: "X-Correlation-Id",
+		},
+		"custom header": {
+			Header:       "X-Request-Trace",
+			ExpectHeader: "X-Request-Trace",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var header http.Header
+			client := New(Options{
+				Region:              "us-west-2",
+				Credentials:         unit.StubCredentialsProvider{},
+				CorrelationIDHeader: c.Header,
+				HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+					header = r.Header
+					return &http.Response{
+						StatusCode: 200,
+						Header:     http.Header{},
+						Body:       ioutil.NopCloser(strings.NewReader(`<Response></Response>`)),
+					}, nil
+				}),
+			})
+
+			ctx := awsmiddleware.WithCorrelationID(context.Background(), "trace-1234")
+			if _, err := client.DescribeVpcs(ctx, &DescribeVpcsInput{}); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := "trace-1234", header.Get(c.ExpectHeader); e != a {
+				t.Errorf("expect %v correlation id, got %v", e, a)
+			}
+
+			if _, err := client.DescribeVpcs(context.Background(), &DescribeVpcsInput{}); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if v := header.Get(c.ExpectHeader); len(v) != 0 {
+				t.Errorf("expect no correlation id without one in context, got %v", v)
+			}
+		})
+	}
+}
@@ -161,6 +161,33 @@ func addOperationDescribeReservedInstancesModificationsMiddlewares(stack *middle
 	if err = addRequestResponseLogging(stack, options); err != nil {
 		return err
 	}
+	if err = addUserAgentAppID(stack, options); err != nil {
+		return err
+	}
+	if err = addResponseSizeLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addOperationSpan(stack, options); err != nil {
+		return err
+	}
+	if err = addOnRequestBody(stack, options); err != nil {
+		return err
+	}
+	if err = addConcurrencyLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addCorrelationID(stack, options); err != nil {
+		return err
+	}
+	if err = addOnResponseBody(stack, options); err != nil {
+		return err
+	}
+	if err = addRequestBodySize(stack); err != nil {
+		return err
+	}
+	if err = addDisableMiddlewareGuards(stack); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -178,6 +205,11 @@ type DescribeReservedInstancesModificationsPaginatorOptions struct {
 	// Set to true if pagination should stop if the service returns a pagination token
 	// that matches the most recent token provided to the service.
 	StopOnDuplicateToken bool
+
+	// OnPage is called after each page is successfully retrieved, with the page
+	// number starting at 1, the number of items in the page, and the page's
+	// result metadata.
+	OnPage func(pageNum int, itemCount int, metadata middleware.Metadata)
 }
 
 // DescribeReservedInstancesModificationsPaginator is a paginator for
@@ -188,6 +220,7 @@ type DescribeReservedInstancesModificationsPaginator struct {
 	params    *DescribeReservedInstancesModificationsInput
 	nextToken *string
 	firstPage bool
+	pageNum   int
 }
 
 // NewDescribeReservedInstancesModificationsPaginator returns a new
@@ -238,6 +271,11 @@ func (p *DescribeReservedInstancesModificationsPaginator) NextPage(ctx context.C
 		p.nextToken = nil
 	}
 
+	p.pageNum++
+	if p.options.OnPage != nil {
+		p.options.OnPage(p.pageNum, len(result.ReservedInstancesModifications), result.ResultMetadata)
+	}
+
 	return result, nil
 }
 
@@ -0,0 +1,179 @@
+package ec2
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// EmptyFilterValue is a filter value that NewFilters will serialize as an
+// empty string. Empty string values are otherwise dropped by NewFilters, so
+// filters that must match an empty value need to use EmptyFilterValue
+// instead.
+const EmptyFilterValue = "\x00EmptyFilterValue\x00"
+
+// NewFilters returns the filters normalized so they can be used with the
+// Filters parameter of an operation. Filters with the same name are merged
+// into a single filter in the position of the first, and duplicate values of
+// a filter are removed. Empty string values are dropped, and filters without
+// any values remaining are removed. EmptyFilterValue values are replaced with
+// an empty string.
+//
+//    filters := ec2.NewFilters(
+//        types.Filter{Name: aws.String("tag:team"), Values: []string{"a", ""}},
+//        types.Filter{Name: aws.String("tag:team"), Values: []string{"b", "a"}},
+//        types.Filter{Name: aws.String("tag:env"), Values: []string{ec2.EmptyFilterValue}},
+//    )
+//    // [{tag:team [a b]} {tag:env [""]}]
+func NewFilters(filters ...types.Filter) []types.Filter {
+	var names []string
+	values := map[string][]string{}
+	seen := map[string]map[string]struct{}{}
+
+	for _, f := range filters {
+		name := aws.ToString(f.Name)
+		if _, ok := seen[name]; !ok {
+			names = append(names, name)
+			seen[name] = map[string]struct{}{}
+		}
+
+		for _, v := range f.Values {
+			if len(v) == 0 {
+				continue
+			}
+			if v == EmptyFilterValue {
+				v = ""
+			}
+			if _, ok := seen[name][v]; ok {
+				continue
+			}
+			seen[name][v] = struct{}{}
+			values[name] = append(values[name], v)
+		}
+	}
+
+	normalized := make([]types.Filter, 0, len(names))
+	for _, name := range names {
+		if len(values[name]) == 0 {
+			continue
+		}
+		normalized = append(normalized, types.Filter{
+			Name:   aws.String(name),
+			Values: values[name],
+		})
+	}
+	return normalized
+}
+
+// FiltersFromStruct returns the filters described by the fields of the
+// struct, or pointer to struct, v. Each field with an `ec2filter:"name"` tag is
+// a filter with that name. Fields without the tag, or tagged "-", are ignored.
+//
+// Fields may be strings, bools, integers, slices of these, or pointers to
+// them, including types such as types.InstanceStateName whose underlying type
+// is one of these. Zero valued fields, and nil pointers and slices, are
+// skipped, so only the fields set are used as filters. Fields that are non-nil
+// pointers are always used, so a *bool field can filter on false. The filters
+// are normalized with NewFilters. FiltersFromStruct panics if v is not a
+// struct, or a tagged field's type is not supported.
+//
+//    type instanceFilters struct {
+//        State types.InstanceStateName `ec2filter:"instance-state-name"`
+//        Team  []string                `ec2filter:"tag:team"`
+//        VpcID string                  `ec2filter:"vpc-id"`
+//    }
+//
+//    filters := ec2.FiltersFromStruct(instanceFilters{
+//        State: types.InstanceStateNameRunning,
+//        Team:  []string{"a", "b"},
+//    })
+//    // [{instance-state-name [running]} {tag:team [a b]}]
+func FiltersFromStruct(v interface{}) []types.Filter {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return NewFilters()
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("ec2.FiltersFromStruct: expected struct, got %T", v))
+	}
+
+	var filters []types.Filter
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		name, ok := field.Tag.Lookup("ec2filter")
+		if !ok || name == "-" || len(name) == 0 || len(field.PkgPath) != 0 {
+			continue
+		}
+
+		values, err := filterValues(rv.Field(i))
+		if err != nil {
+			panic(fmt.Sprintf("ec2.FiltersFromStruct: field %s, %v", field.Name, err))
+		}
+		if len(values) == 0 {
+			continue
+		}
+		filters = append(filters, types.Filter{Name: aws.String(name), Values: values})
+	}
+
+	return NewFilters(filters...)
+}
+
+// filterValues returns the filter values of a tagged struct field.
+func filterValues(v reflect.Value) ([]string, error) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil, nil
+		}
+		s, err := filterValue(v.Elem())
+		if err != nil {
+			return nil, err
+		}
+		if len(s) == 0 {
+			s = EmptyFilterValue
+		}
+		return []string{s}, nil
+	case reflect.Slice:
+		var values []string
+		for i := 0; i < v.Len(); i++ {
+			s, err := filterValue(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, s)
+		}
+		return values, nil
+	default:
+		if v.IsZero() {
+			return nil, nil
+		}
+		s, err := filterValue(v)
+		if err != nil {
+			return nil, err
+		}
+		return []string{s}, nil
+	}
+}
+
+// filterValue returns a single filter value formatted as a string.
+func filterValue(v reflect.Value) (string, error) {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	default:
+		return "", fmt.Errorf("unsupported filter value type %v", v.Type())
+	}
+}
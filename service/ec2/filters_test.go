@@ -0,0 +1,153 @@
+package ec2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func TestNewFilters(t *testing.T) {
+	cases := map[string]struct {
+		Filters []types.Filter
+		Expect  []types.Filter
+	}{
+		"no filters": {
+			Expect: []types.Filter{},
+		},
+		"merge duplicate names": {
+			Filters: []types.Filter{
+				{Name: aws.String("tag:team"), Values: []string{"a"}},
+				{Name: aws.String("instance-state-name"), Values: []string{"running"}},
+				{Name: aws.String("tag:team"), Values: []string{"b", "a"}},
+			},
+			Expect: []types.Filter{
+				{Name: aws.String("tag:team"), Values: []string{"a", "b"}},
+				{Name: aws.String("instance-state-name"), Values: []string{"running"}},
+			},
+		},
+		"drop empty values": {
+			Filters: []types.Filter{
+				{Name: aws.String("tag:team"), Values: []string{"", "a", ""}},
+			},
+			Expect: []types.Filter{
+				{Name: aws.String("tag:team"), Values: []string{"a"}},
+			},
+		},
+		"drop filters without values": {
+			Filters: []types.Filter{
+				{Name: aws.String("tag:team"), Values: []string{""}},
+				{Name: aws.String("vpc-id")},
+				{Name: aws.String("instance-state-name"), Values: []string{"running"}},
+			},
+			Expect: []types.Filter{
+				{Name: aws.String("instance-state-name"), Values: []string{"running"}},
+			},
+		},
+		"explicit empty value": {
+			Filters: []types.Filter{
+				{Name: aws.String("tag:env"), Values: []string{EmptyFilterValue, ""}},
+				{Name: aws.String("tag:env"), Values: []string{"dev", EmptyFilterValue}},
+			},
+			Expect: []types.Filter{
+				{Name: aws.String("tag:env"), Values: []string{"", "dev"}},
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			actual := NewFilters(c.Filters...)
+			if e, a := c.Expect, actual; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v filters, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestFiltersFromStruct(t *testing.T) {
+	type instanceFilters struct {
+		State      types.InstanceStateName `ec2filter:"instance-state-name"`
+		Team       []string                `ec2filter:"tag:team"`
+		VpcID      string                  `ec2filter:"vpc-id"`
+		CoreCount  int                     `ec2filter:"cpu-options.core-count"`
+		EbsOpt     *bool                   `ec2filter:"ebs-optimized"`
+		Owner      *string                 `ec2filter:"tag:owner"`
+		Untagged   string
+		Ignored    string `ec2filter:"-"`
+		unexported string `ec2filter:"unexported"`
+	}
+
+	cases := map[string]struct {
+		Value  interface{}
+		Expect []types.Filter
+	}{
+		"populated": {
+			Value: instanceFilters{
+				State:      types.InstanceStateNameRunning,
+				Team:       []string{"a", "b", "a"},
+				VpcID:      "vpc-1234",
+				CoreCount:  4,
+				EbsOpt:     aws.Bool(false),
+				Owner:      aws.String(""),
+				Untagged:   "untagged",
+				Ignored:    "ignored",
+				unexported: "unexported",
+			},
+			Expect: []types.Filter{
+				{Name: aws.String("instance-state-name"), Values: []string{"running"}},
+				{Name: aws.String("tag:team"), Values: []string{"a", "b"}},
+				{Name: aws.String("vpc-id"), Values: []string{"vpc-1234"}},
+				{Name: aws.String("cpu-options.core-count"), Values: []string{"4"}},
+				{Name: aws.String("ebs-optimized"), Values: []string{"false"}},
+				{Name: aws.String("tag:owner"), Values: []string{""}},
+			},
+		},
+		"zero values skipped": {
+			Value: &instanceFilters{
+				VpcID: "vpc-1234",
+				Team:  []string{},
+			},
+			Expect: []types.Filter{
+				{Name: aws.String("vpc-id"), Values: []string{"vpc-1234"}},
+			},
+		},
+		"empty": {
+			Value:  instanceFilters{},
+			Expect: []types.Filter{},
+		},
+		"nil pointer": {
+			Value:  (*instanceFilters)(nil),
+			Expect: []types.Filter{},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if e, a := c.Expect, FiltersFromStruct(c.Value); !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v filters, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestFiltersFromStruct_Panic(t *testing.T) {
+	cases := map[string]interface{}{
+		"not struct": "vpc-1234",
+		"unsupported field": struct {
+			Values map[string]string `ec2filter:"tag:team"`
+		}{Values: map[string]string{"a": "b"}},
+	}
+
+	for name, v := range cases {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("expect panic, got none")
+				}
+			}()
+			FiltersFromStruct(v)
+		})
+	}
+}
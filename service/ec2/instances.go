@@ -0,0 +1,54 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// RunningInstanceIDsOptions provides the options for RunningInstanceIDs.
+type RunningInstanceIDsOptions struct {
+	// The functional options passed to each operation invoked.
+	ClientOptions []func(*Options)
+}
+
+// RunningInstanceIDs returns the IDs of the instances matching the filters
+// that are in the running state, in the order they were described. The
+// instances are described with DescribeInstances, one page at a time, with an
+// instance-state-name filter for running instances added to the filters.
+func RunningInstanceIDs(ctx context.Context, client DescribeInstancesAPIClient, filters []types.Filter, optFns ...func(*RunningInstanceIDsOptions)) ([]string, error) {
+	var options RunningInstanceIDsOptions
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	filters = append(append([]types.Filter{}, filters...), types.Filter{
+		Name:   aws.String("instance-state-name"),
+		Values: []string{string(types.InstanceStateNameRunning)},
+	})
+
+	var ids []string
+	p := NewDescribeInstancesPaginator(client, &DescribeInstancesInput{
+		Filters: NewFilters(filters...),
+	})
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx, options.ClientOptions...)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range page.Reservations {
+			for _, instance := range r.Instances {
+				// The state is checked as well, in case the state filter is
+				// merged with a filter of other states.
+				if instance.State == nil || instance.State.Name != types.InstanceStateNameRunning {
+					continue
+				}
+				ids = append(ids, aws.ToString(instance.InstanceId))
+			}
+		}
+	}
+
+	return ids, nil
+}
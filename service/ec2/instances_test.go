@@ -0,0 +1,99 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+type mockDescribeInstancesClient struct {
+	pages []DescribeInstancesOutput
+	err   error
+
+	filters [][]types.Filter
+}
+
+func (m *mockDescribeInstancesClient) DescribeInstances(ctx context.Context, params *DescribeInstancesInput, optFns ...func(*Options)) (*DescribeInstancesOutput, error) {
+	m.filters = append(m.filters, params.Filters)
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	var idx int
+	if params.NextToken != nil {
+		fmt.Sscan(*params.NextToken, &idx)
+	}
+	out := m.pages[idx]
+	if idx+1 < len(m.pages) {
+		out.NextToken = aws.String(fmt.Sprint(idx + 1))
+	}
+	return &out, nil
+}
+
+func newInstance(id string, state types.InstanceStateName) types.Instance {
+	return types.Instance{
+		InstanceId: aws.String(id),
+		State:      &types.InstanceState{Name: state},
+	}
+}
+
+func TestRunningInstanceIDs(t *testing.T) {
+	client := &mockDescribeInstancesClient{
+		pages: []DescribeInstancesOutput{
+			{Reservations: []types.Reservation{
+				{Instances: []types.Instance{
+					newInstance("i-1", types.InstanceStateNameRunning),
+					newInstance("i-2", types.InstanceStateNameStopped),
+				}},
+				{Instances: []types.Instance{
+					newInstance("i-3", types.InstanceStateNameRunning),
+				}},
+			}},
+			{Reservations: []types.Reservation{
+				{Instances: []types.Instance{
+					newInstance("i-4", types.InstanceStateNamePending),
+					newInstance("i-5", types.InstanceStateNameRunning),
+				}},
+			}},
+		},
+	}
+
+	ids, err := RunningInstanceIDs(context.Background(), client, []types.Filter{
+		{Name: aws.String("tag:team"), Values: []string{"a"}},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := []string{"i-1", "i-3", "i-5"}, ids; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v instances, got %v", e, a)
+	}
+	if e, a := 2, len(client.filters); e != a {
+		t.Fatalf("expect %v describe calls, got %v", e, a)
+	}
+	expectFilters := []types.Filter{
+		{Name: aws.String("tag:team"), Values: []string{"a"}},
+		{Name: aws.String("instance-state-name"), Values: []string{"running"}},
+	}
+	for i, filters := range client.filters {
+		if e, a := expectFilters, filters; !reflect.DeepEqual(e, a) {
+			t.Errorf("expect %v filters for call %v, got %v", e, a, i)
+		}
+	}
+}
+
+func TestRunningInstanceIDs_Error(t *testing.T) {
+	client := &mockDescribeInstancesClient{err: fmt.Errorf("unauthorized")}
+
+	ids, err := RunningInstanceIDs(context.Background(), client, nil)
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if len(ids) != 0 {
+		t.Errorf("expect no instances, got %v", ids)
+	}
+}
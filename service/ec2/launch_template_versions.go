@@ -0,0 +1,136 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// maxDeleteLaunchTemplateVersions is the maximum number of versions that can
+// be deleted by a single DeleteLaunchTemplateVersions operation.
+const maxDeleteLaunchTemplateVersions = 200
+
+// DeleteLaunchTemplateVersionsAPIClient is a client that implements the
+// DeleteLaunchTemplateVersions operation.
+type DeleteLaunchTemplateVersionsAPIClient interface {
+	DeleteLaunchTemplateVersions(context.Context, *DeleteLaunchTemplateVersionsInput, ...func(*Options)) (*DeleteLaunchTemplateVersionsOutput, error)
+}
+
+var _ DeleteLaunchTemplateVersionsAPIClient = (*Client)(nil)
+
+// DeleteOldLaunchTemplateVersionsAPIClient is a client that implements the
+// DescribeLaunchTemplateVersions and DeleteLaunchTemplateVersions operations.
+type DeleteOldLaunchTemplateVersionsAPIClient interface {
+	DescribeLaunchTemplateVersionsAPIClient
+	DeleteLaunchTemplateVersionsAPIClient
+}
+
+var _ DeleteOldLaunchTemplateVersionsAPIClient = (*Client)(nil)
+
+// DeleteOldLaunchTemplateVersionsOptions provides the options for
+// DeleteOldLaunchTemplateVersions.
+type DeleteOldLaunchTemplateVersionsOptions struct {
+	// The functional options passed to each operation invoked.
+	ClientOptions []func(*Options)
+}
+
+// LaunchTemplateVersionsNotDeletedError is returned by
+// DeleteOldLaunchTemplateVersions when one or more of the launch template's
+// versions could not be deleted.
+type LaunchTemplateVersionsNotDeletedError struct {
+	LaunchTemplateID string
+	Errors           []types.DeleteLaunchTemplateVersionsResponseErrorItem
+}
+
+func (e *LaunchTemplateVersionsNotDeletedError) Error() string {
+	return fmt.Sprintf("failed to delete %d version(s) of launch template %s",
+		len(e.Errors), e.LaunchTemplateID)
+}
+
+// DeleteOldLaunchTemplateVersions deletes all but the newest keepLatest
+// versions of the launch template, returning the version numbers deleted in
+// ascending order. Unlike DeleteLaunchTemplate, the launch template itself is
+// not deleted.
+//
+// The launch template's default version is never deleted, and is kept in
+// addition to the newest keepLatest versions. If any of the versions could
+// not be deleted, the versions that were deleted are returned along with a
+// *LaunchTemplateVersionsNotDeletedError.
+func DeleteOldLaunchTemplateVersions(ctx context.Context, client DeleteOldLaunchTemplateVersionsAPIClient, launchTemplateID string, keepLatest int, optFns ...func(*DeleteOldLaunchTemplateVersionsOptions)) ([]int64, error) {
+	var options DeleteOldLaunchTemplateVersionsOptions
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	if keepLatest < 0 {
+		return nil, fmt.Errorf("number of launch template versions to keep must not be negative, %d", keepLatest)
+	}
+
+	var versions []types.LaunchTemplateVersion
+	p := NewDescribeLaunchTemplateVersionsPaginator(client, &DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(launchTemplateID),
+	})
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx, options.ClientOptions...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe launch template %s versions, %w",
+				launchTemplateID, err)
+		}
+		versions = append(versions, page.LaunchTemplateVersions...)
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].VersionNumber > versions[j].VersionNumber
+	})
+
+	var prune []string
+	for i, v := range versions {
+		if i < keepLatest || v.DefaultVersion {
+			continue
+		}
+		prune = append(prune, strconv.FormatInt(v.VersionNumber, 10))
+	}
+
+	var deleted []int64
+	var failed []types.DeleteLaunchTemplateVersionsResponseErrorItem
+	for len(prune) > 0 {
+		n := len(prune)
+		if n > maxDeleteLaunchTemplateVersions {
+			n = maxDeleteLaunchTemplateVersions
+		}
+
+		out, err := client.DeleteLaunchTemplateVersions(ctx, &DeleteLaunchTemplateVersionsInput{
+			LaunchTemplateId: aws.String(launchTemplateID),
+			Versions:         prune[:n],
+		}, options.ClientOptions...)
+		if err != nil {
+			sortVersionNumbers(deleted)
+			return deleted, fmt.Errorf("failed to delete launch template %s versions, %w",
+				launchTemplateID, err)
+		}
+		prune = prune[n:]
+
+		for _, item := range out.SuccessfullyDeletedLaunchTemplateVersions {
+			deleted = append(deleted, item.VersionNumber)
+		}
+		failed = append(failed, out.UnsuccessfullyDeletedLaunchTemplateVersions...)
+	}
+
+	sortVersionNumbers(deleted)
+	if len(failed) != 0 {
+		return deleted, &LaunchTemplateVersionsNotDeletedError{
+			LaunchTemplateID: launchTemplateID,
+			Errors:           failed,
+		}
+	}
+
+	return deleted, nil
+}
+
+func sortVersionNumbers(vs []int64) {
+	sort.Slice(vs, func(i, j int) bool { return vs[i] < vs[j] })
+}
@@ -0,0 +1,167 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+type mockLaunchTemplateVersionsClient struct {
+	versions []types.LaunchTemplateVersion
+	fail     map[string]bool
+
+	deleteCalls [][]string
+}
+
+func (m *mockLaunchTemplateVersionsClient) DescribeLaunchTemplateVersions(ctx context.Context, params *DescribeLaunchTemplateVersionsInput, optFns ...func(*Options)) (*DescribeLaunchTemplateVersionsOutput, error) {
+	if e, a := "lt-1234", aws.ToString(params.LaunchTemplateId); e != a {
+		return nil, fmt.Errorf("expect %v launch template, got %v", e, a)
+	}
+
+	// Return two versions per page.
+	var idx int
+	if params.NextToken != nil {
+		idx, _ = strconv.Atoi(*params.NextToken)
+	}
+	end := idx + 2
+	if end > len(m.versions) {
+		end = len(m.versions)
+	}
+	out := &DescribeLaunchTemplateVersionsOutput{LaunchTemplateVersions: m.versions[idx:end]}
+	if end < len(m.versions) {
+		out.NextToken = aws.String(strconv.Itoa(end))
+	}
+	return out, nil
+}
+
+func (m *mockLaunchTemplateVersionsClient) DeleteLaunchTemplateVersions(ctx context.Context, params *DeleteLaunchTemplateVersionsInput, optFns ...func(*Options)) (*DeleteLaunchTemplateVersionsOutput, error) {
+	m.deleteCalls = append(m.deleteCalls, params.Versions)
+
+	out := &DeleteLaunchTemplateVersionsOutput{}
+	for _, v := range params.Versions {
+		n, _ := strconv.ParseInt(v, 10, 64)
+		if m.fail[v] {
+			out.UnsuccessfullyDeletedLaunchTemplateVersions = append(out.UnsuccessfullyDeletedLaunchTemplateVersions,
+				types.DeleteLaunchTemplateVersionsResponseErrorItem{
+					LaunchTemplateId: params.LaunchTemplateId,
+					VersionNumber:    n,
+					ResponseError: &types.ResponseError{
+						Code: types.LaunchTemplateErrorCodeUnexpectedError,
+					},
+				})
+			continue
+		}
+		out.SuccessfullyDeletedLaunchTemplateVersions = append(out.SuccessfullyDeletedLaunchTemplateVersions,
+			types.DeleteLaunchTemplateVersionsResponseSuccessItem{
+				LaunchTemplateId: params.LaunchTemplateId,
+				VersionNumber:    n,
+			})
+	}
+	return out, nil
+}
+
+func newLaunchTemplateVersions(defaultVersion int64, numbers ...int64) []types.LaunchTemplateVersion {
+	var versions []types.LaunchTemplateVersion
+	for _, n := range numbers {
+		versions = append(versions, types.LaunchTemplateVersion{
+			LaunchTemplateId: aws.String("lt-1234"),
+			VersionNumber:    n,
+			DefaultVersion:   n == defaultVersion,
+		})
+	}
+	return versions
+}
+
+func TestDeleteOldLaunchTemplateVersions(t *testing.T) {
+	cases := map[string]struct {
+		Versions      []types.LaunchTemplateVersion
+		KeepLatest    int
+		ExpectDeleted []int64
+		ExpectCalls   [][]string
+	}{
+		"keep two": {
+			Versions:      newLaunchTemplateVersions(5, 1, 2, 3, 4, 5),
+			KeepLatest:    2,
+			ExpectDeleted: []int64{1, 2, 3},
+			ExpectCalls:   [][]string{{"3", "2", "1"}},
+		},
+		"keep two with old default": {
+			Versions:      newLaunchTemplateVersions(1, 3, 5, 1, 4, 2),
+			KeepLatest:    2,
+			ExpectDeleted: []int64{2, 3},
+			ExpectCalls:   [][]string{{"3", "2"}},
+		},
+		"keep zero": {
+			Versions:      newLaunchTemplateVersions(3, 1, 2, 3, 4, 5),
+			ExpectDeleted: []int64{1, 2, 4, 5},
+			ExpectCalls:   [][]string{{"5", "4", "2", "1"}},
+		},
+		"keep all": {
+			Versions:   newLaunchTemplateVersions(1, 1, 2, 3, 4, 5),
+			KeepLatest: 5,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockLaunchTemplateVersionsClient{versions: c.Versions}
+
+			deleted, err := DeleteOldLaunchTemplateVersions(context.Background(), client, "lt-1234", c.KeepLatest)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectDeleted, deleted; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v deleted, got %v", e, a)
+			}
+			if e, a := c.ExpectCalls, client.deleteCalls; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v delete calls, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestDeleteOldLaunchTemplateVersions_Unsuccessful(t *testing.T) {
+	client := &mockLaunchTemplateVersionsClient{
+		versions: newLaunchTemplateVersions(5, 1, 2, 3, 4, 5),
+		fail:     map[string]bool{"2": true},
+	}
+
+	deleted, err := DeleteOldLaunchTemplateVersions(context.Background(), client, "lt-1234", 2)
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+
+	var notDeleted *LaunchTemplateVersionsNotDeletedError
+	if !errors.As(err, &notDeleted) {
+		t.Fatalf("expect %T error, got %T", notDeleted, err)
+	}
+	if e, a := 1, len(notDeleted.Errors); e != a {
+		t.Fatalf("expect %v failed versions, got %v", e, a)
+	}
+	if e, a := int64(2), notDeleted.Errors[0].VersionNumber; e != a {
+		t.Errorf("expect %v failed version, got %v", e, a)
+	}
+	if e, a := []int64{1, 3}, deleted; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v deleted, got %v", e, a)
+	}
+}
+
+func TestDeleteOldLaunchTemplateVersions_NegativeKeep(t *testing.T) {
+	client := &mockLaunchTemplateVersionsClient{
+		versions: newLaunchTemplateVersions(1, 1, 2),
+	}
+
+	_, err := DeleteOldLaunchTemplateVersions(context.Background(), client, "lt-1234", -1)
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if len(client.deleteCalls) != 0 {
+		t.Errorf("expect no versions deleted, got %v", client.deleteCalls)
+	}
+}
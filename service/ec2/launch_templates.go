@@ -0,0 +1,90 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// DeleteLaunchTemplateAPIClient is a client that implements the
+// DeleteLaunchTemplate operation.
+type DeleteLaunchTemplateAPIClient interface {
+	DeleteLaunchTemplate(context.Context, *DeleteLaunchTemplateInput, ...func(*Options)) (*DeleteLaunchTemplateOutput, error)
+}
+
+var _ DeleteLaunchTemplateAPIClient = (*Client)(nil)
+
+// DeleteLaunchTemplatesByTagAPIClient is a client that implements the
+// DescribeLaunchTemplates and DeleteLaunchTemplate operations.
+type DeleteLaunchTemplatesByTagAPIClient interface {
+	DescribeLaunchTemplatesAPIClient
+	DeleteLaunchTemplateAPIClient
+}
+
+var _ DeleteLaunchTemplatesByTagAPIClient = (*Client)(nil)
+
+// DeleteLaunchTemplatesByTagOptions provides the options for
+// DeleteLaunchTemplatesByTag.
+type DeleteLaunchTemplatesByTagOptions struct {
+	// The functional options passed to each operation invoked.
+	ClientOptions []func(*Options)
+}
+
+// DeleteLaunchTemplatesByTag deletes every launch template tagged with the
+// key and value, returning the IDs of the launch templates deleted. All
+// versions of the launch templates are deleted.
+//
+// The launch templates are described one page at a time, and each launch
+// template is deleted with DeleteLaunchTemplate once all pages have been
+// described. If any of the launch templates could not be deleted, the IDs of
+// the launch templates that were deleted are returned along with an
+// *aws.BatchError. The Index of each failure is the index of the launch
+// template among the launch templates with the tag, in the order they were
+// described.
+func DeleteLaunchTemplatesByTag(ctx context.Context, client DeleteLaunchTemplatesByTagAPIClient, key, value string, optFns ...func(*DeleteLaunchTemplatesByTagOptions)) ([]string, error) {
+	var options DeleteLaunchTemplatesByTagOptions
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	var templates []types.LaunchTemplate
+	p := NewDescribeLaunchTemplatesPaginator(client, &DescribeLaunchTemplatesInput{
+		Filters: []types.Filter{
+			{Name: aws.String("tag:" + key), Values: []string{value}},
+		},
+	})
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx, options.ClientOptions...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe launch templates tagged %s=%s, %w", key, value, err)
+		}
+		templates = append(templates, page.LaunchTemplates...)
+	}
+
+	var deleted []string
+	var batchErr *aws.BatchError
+	for i, lt := range templates {
+		id := aws.ToString(lt.LaunchTemplateId)
+		_, err := client.DeleteLaunchTemplate(ctx, &DeleteLaunchTemplateInput{
+			LaunchTemplateId: lt.LaunchTemplateId,
+		}, options.ClientOptions...)
+		if err != nil {
+			if batchErr == nil {
+				batchErr = &aws.BatchError{}
+			}
+			batchErr.Failures = append(batchErr.Failures, aws.BatchFailure{
+				Index: i,
+				Err:   fmt.Errorf("failed to delete launch template %s, %w", id, err),
+			})
+			continue
+		}
+		deleted = append(deleted, id)
+	}
+	if batchErr != nil {
+		return deleted, batchErr
+	}
+
+	return deleted, nil
+}
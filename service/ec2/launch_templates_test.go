@@ -0,0 +1,108 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+type mockDeleteLaunchTemplatesClient struct {
+	templates []types.LaunchTemplate
+	fail      map[string]error
+
+	describeCalls int
+	deleted       []string
+}
+
+func (m *mockDeleteLaunchTemplatesClient) DescribeLaunchTemplates(ctx context.Context, params *DescribeLaunchTemplatesInput, optFns ...func(*Options)) (*DescribeLaunchTemplatesOutput, error) {
+	m.describeCalls++
+	if len(params.Filters) != 1 || aws.ToString(params.Filters[0].Name) != "tag:team" ||
+		!reflect.DeepEqual(params.Filters[0].Values, []string{"a"}) {
+		return nil, fmt.Errorf("unexpected filters %v", params.Filters)
+	}
+
+	// Return two launch templates per page.
+	var idx int
+	if params.NextToken != nil {
+		idx, _ = strconv.Atoi(*params.NextToken)
+	}
+	end := idx + 2
+	if end > len(m.templates) {
+		end = len(m.templates)
+	}
+	out := &DescribeLaunchTemplatesOutput{LaunchTemplates: m.templates[idx:end]}
+	if end < len(m.templates) {
+		out.NextToken = aws.String(strconv.Itoa(end))
+	}
+	return out, nil
+}
+
+func (m *mockDeleteLaunchTemplatesClient) DeleteLaunchTemplate(ctx context.Context, params *DeleteLaunchTemplateInput, optFns ...func(*Options)) (*DeleteLaunchTemplateOutput, error) {
+	id := aws.ToString(params.LaunchTemplateId)
+	if err := m.fail[id]; err != nil {
+		return nil, err
+	}
+	m.deleted = append(m.deleted, id)
+	return &DeleteLaunchTemplateOutput{}, nil
+}
+
+func newLaunchTemplates(ids ...string) []types.LaunchTemplate {
+	var templates []types.LaunchTemplate
+	for _, id := range ids {
+		templates = append(templates, types.LaunchTemplate{LaunchTemplateId: aws.String(id)})
+	}
+	return templates
+}
+
+func TestDeleteLaunchTemplatesByTag(t *testing.T) {
+	client := &mockDeleteLaunchTemplatesClient{
+		templates: newLaunchTemplates("lt-1", "lt-2", "lt-3"),
+	}
+
+	deleted, err := DeleteLaunchTemplatesByTag(context.Background(), client, "team", "a")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := []string{"lt-1", "lt-2", "lt-3"}, deleted; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v deleted, got %v", e, a)
+	}
+	if e, a := 2, client.describeCalls; e != a {
+		t.Errorf("expect %v describe calls, got %v", e, a)
+	}
+}
+
+func TestDeleteLaunchTemplatesByTag_PartialFailure(t *testing.T) {
+	failErr := errors.New("launch template in use")
+	client := &mockDeleteLaunchTemplatesClient{
+		templates: newLaunchTemplates("lt-1", "lt-2", "lt-3"),
+		fail:      map[string]error{"lt-2": failErr},
+	}
+
+	deleted, err := DeleteLaunchTemplatesByTag(context.Background(), client, "team", "a")
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+
+	var batchErr *aws.BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("expect %T error, got %v", batchErr, err)
+	}
+	if e, a := 1, len(batchErr.Failures); e != a {
+		t.Fatalf("expect %v failures, got %v", e, a)
+	}
+	if e, a := 1, batchErr.Failures[0].Index; e != a {
+		t.Errorf("expect %v failure index, got %v", e, a)
+	}
+	if !errors.Is(batchErr.Failures[0].Err, failErr) {
+		t.Errorf("expect failure to wrap %v, got %v", failErr, batchErr.Failures[0].Err)
+	}
+	if e, a := []string{"lt-1", "lt-3"}, deleted; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v deleted, got %v", e, a)
+	}
+}
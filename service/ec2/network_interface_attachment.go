@@ -0,0 +1,245 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/internal/waiter"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// AttachedInterface is the network interface of a network interface
+// attachment.
+type AttachedInterface struct {
+	NetworkInterfaceID string
+	PrivateIPAddress   string
+	MACAddress         string
+	SubnetID           string
+}
+
+// ResolveAttachedInterfaceOptions provides the options for
+// ResolveAttachedInterface.
+type ResolveAttachedInterfaceOptions struct {
+	// The minimum amount of time to delay between describing the network
+	// interface while waiting for the attachment to be visible. If zero, 1
+	// second will be used.
+	MinDelay time.Duration
+
+	// The maximum amount of time to delay between describing the network
+	// interface while waiting for the attachment to be visible. If zero, 5
+	// seconds will be used.
+	MaxDelay time.Duration
+
+	// The maximum amount of time to wait for the attachment to be visible. If
+	// zero, 30 seconds will be used.
+	MaxWait time.Duration
+
+	// The functional options passed to each operation invoked.
+	ClientOptions []func(*Options)
+}
+
+// AttachedInterfaceNotFoundError is returned by ResolveAttachedInterface when
+// no network interface with the attachment was found.
+type AttachedInterfaceNotFoundError struct {
+	AttachmentID string
+	MaxWait      time.Duration
+}
+
+func (e *AttachedInterfaceNotFoundError) Error() string {
+	return fmt.Sprintf("network interface with attachment %s not found within %v",
+		e.AttachmentID, e.MaxWait)
+}
+
+// ResolveAttachedInterface returns the private IP address, MAC address, and
+// subnet of the network interface with the attachment ID, such as returned by
+// AttachNetworkInterface.
+//
+// A newly created attachment may not be immediately visible to
+// DescribeNetworkInterfaces. If no network interface is found with the
+// attachment, ResolveAttachedInterface will describe the network interfaces
+// again, delaying with a jittered backoff between the MinDelay and MaxDelay of
+// the options. If the attachment is still not found within the MaxWait of the
+// options an *AttachedInterfaceNotFoundError is returned.
+func ResolveAttachedInterface(ctx context.Context, client DescribeNetworkInterfacesAPIClient, attachmentID string, optFns ...func(*ResolveAttachedInterfaceOptions)) (*AttachedInterface, error) {
+	var options ResolveAttachedInterfaceOptions
+	for _, fn := range optFns {
+		fn(&options)
+	}
+	if options.MinDelay <= 0 {
+		options.MinDelay = 1 * time.Second
+	}
+	if options.MaxDelay <= 0 {
+		options.MaxDelay = 5 * time.Second
+	}
+	if options.MaxWait <= 0 {
+		options.MaxWait = 30 * time.Second
+	}
+
+	params := &DescribeNetworkInterfacesInput{
+		Filters: []types.Filter{
+			{Name: aws.String("attachment.attachment-id"), Values: []string{attachmentID}},
+		},
+	}
+
+	var attached *AttachedInterface
+	err := waiter.Wait(ctx, waiter.Options{
+		Name:     "AttachedInterface",
+		MinDelay: options.MinDelay,
+		MaxDelay: options.MaxDelay,
+		MaxWait:  options.MaxWait,
+	}, func(out interface{}, err error) (bool, error) {
+		if err != nil {
+			return false, fmt.Errorf("failed to describe network interface with attachment %s, %w",
+				attachmentID, err)
+		}
+
+		interfaces := out.(*DescribeNetworkInterfacesOutput).NetworkInterfaces
+		if len(interfaces) == 0 {
+			return false, nil
+		}
+		ni := interfaces[0]
+		attached = &AttachedInterface{
+			NetworkInterfaceID: aws.ToString(ni.NetworkInterfaceId),
+			PrivateIPAddress:   aws.ToString(ni.PrivateIpAddress),
+			MACAddress:         aws.ToString(ni.MacAddress),
+			SubnetID:           aws.ToString(ni.SubnetId),
+		}
+		return true, nil
+	}, func(ctx context.Context) (interface{}, error) {
+		return client.DescribeNetworkInterfaces(ctx, params, options.ClientOptions...)
+	})
+
+	var maxWaitErr *waiter.MaxWaitExceededError
+	if errors.As(err, &maxWaitErr) {
+		return nil, &AttachedInterfaceNotFoundError{
+			AttachmentID: attachmentID,
+			MaxWait:      options.MaxWait,
+		}
+	} else if err != nil {
+		return nil, err
+	}
+	return attached, nil
+}
+
+// WaitAttachmentAttachedOptions provides the options for
+// WaitAttachmentAttached.
+type WaitAttachmentAttachedOptions struct {
+	// The minimum amount of time to delay between describing the attachment.
+	// If zero, 2 seconds will be used.
+	MinDelay time.Duration
+
+	// The maximum amount of time to delay between describing the attachment.
+	// If zero, 10 seconds will be used.
+	MaxDelay time.Duration
+
+	// The functional options passed to each operation invoked.
+	ClientOptions []func(*Options)
+}
+
+// AttachmentNotAttachedError is returned by WaitAttachmentAttached when the
+// network interface attachment will not reach the attached status. Status is
+// the status of the attachment described.
+type AttachmentNotAttachedError struct {
+	AttachmentID string
+	Status       types.AttachmentStatus
+}
+
+func (e *AttachmentNotAttachedError) Error() string {
+	return fmt.Sprintf("network interface attachment %s not attached, status %s", e.AttachmentID, e.Status)
+}
+
+// WaitAttachmentAttached waits until the network interface attachment with
+// the attachment ID, such as returned by AttachNetworkInterface, has the
+// attached status, and the attached network interface is usable. The
+// attachment is described with DescribeNetworkInterfaces, delaying with a
+// jittered backoff between the MinDelay and MaxDelay of the options, until it
+// is attached, or maxWait has elapsed.
+//
+// A newly created attachment may not be immediately visible to
+// DescribeNetworkInterfaces, so an attachment that is not found is described
+// again. If the attachment is detaching or detached, an
+// *AttachmentNotAttachedError is returned. If the attachment is not attached
+// within maxWait, the waiter's max wait exceeded error is returned.
+func WaitAttachmentAttached(ctx context.Context, client DescribeNetworkInterfacesAPIClient, attachmentID string, maxWait time.Duration, optFns ...func(*WaitAttachmentAttachedOptions)) error {
+	var options WaitAttachmentAttachedOptions
+	for _, fn := range optFns {
+		fn(&options)
+	}
+	if options.MinDelay <= 0 {
+		options.MinDelay = 2 * time.Second
+	}
+	if options.MaxDelay <= 0 {
+		options.MaxDelay = 10 * time.Second
+	}
+
+	params := &DescribeNetworkInterfacesInput{
+		Filters: []types.Filter{
+			{Name: aws.String("attachment.attachment-id"), Values: []string{attachmentID}},
+		},
+	}
+
+	return waiter.Wait(ctx, waiter.Options{
+		Name:     "AttachmentAttached",
+		MinDelay: options.MinDelay,
+		MaxDelay: options.MaxDelay,
+		MaxWait:  maxWait,
+	}, func(out interface{}, err error) (bool, error) {
+		if err != nil {
+			return false, fmt.Errorf("failed to describe network interface with attachment %s, %w",
+				attachmentID, err)
+		}
+
+		var status types.AttachmentStatus
+		for _, ni := range out.(*DescribeNetworkInterfacesOutput).NetworkInterfaces {
+			if ni.Attachment != nil && aws.ToString(ni.Attachment.AttachmentId) == attachmentID {
+				status = ni.Attachment.Status
+			}
+		}
+		switch status {
+		case types.AttachmentStatusAttached:
+			return true, nil
+		case types.AttachmentStatusDetaching, types.AttachmentStatusDetached:
+			return false, &AttachmentNotAttachedError{AttachmentID: attachmentID, Status: status}
+		default:
+			return false, nil
+		}
+	}, func(ctx context.Context) (interface{}, error) {
+		return client.DescribeNetworkInterfaces(ctx, params, options.ClientOptions...)
+	})
+}
+
+// DefaultEventualConsistencyMaxAttempts is the maximum number of attempts
+// RetryOnEventualConsistency makes when zero or less is specified.
+const DefaultEventualConsistencyMaxAttempts = 5
+
+// RetryOnEventualConsistency returns a functional option for the
+// AttachNetworkInterface operation that retries the operation when it fails
+// with an InvalidParameterValue or IncorrectState error, up to a total of
+// maxAttempts attempts. If maxAttempts is zero or less,
+// DefaultEventualConsistencyMaxAttempts is used.
+//
+// A network interface or instance that was just created may not yet be
+// visible to AttachNetworkInterface, which fails with one of these errors
+// until it is. The option should only be passed to the AttachNetworkInterface
+// operation, and not the client, as the errors are not transient for other
+// operations.
+//
+//    out, err := client.AttachNetworkInterface(ctx, params,
+//        ec2.RetryOnEventualConsistency(0))
+func RetryOnEventualConsistency(maxAttempts int) func(*Options) {
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultEventualConsistencyMaxAttempts
+	}
+	return func(o *Options) {
+		retryer := o.Retryer
+		if retryer == nil {
+			retryer = retry.NewStandard()
+		}
+		retryer = retry.AddWithErrorCodes(retryer, "InvalidParameterValue", "IncorrectState")
+		o.Retryer = retry.AddWithMaxAttempts(retryer, maxAttempts)
+	}
+}
@@ -0,0 +1,340 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting/unit"
+	"github.com/aws/aws-sdk-go-v2/internal/sdk"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// mockAttachedInterfaceClient returns no network interfaces until the
+// attachment becomes visible.
+type mockAttachedInterfaceClient struct {
+	visibleAfter int
+	calls        int
+}
+
+func (m *mockAttachedInterfaceClient) DescribeNetworkInterfaces(ctx context.Context, params *DescribeNetworkInterfacesInput, optFns ...func(*Options)) (*DescribeNetworkInterfacesOutput, error) {
+	m.calls++
+
+	if len(params.Filters) != 1 || aws.ToString(params.Filters[0].Name) != "attachment.attachment-id" ||
+		len(params.Filters[0].Values) != 1 || params.Filters[0].Values[0] != "eni-attach-1234" {
+		return nil, errors.New("unexpected filters")
+	}
+
+	if m.calls < m.visibleAfter {
+		return &DescribeNetworkInterfacesOutput{}, nil
+	}
+	return &DescribeNetworkInterfacesOutput{
+		NetworkInterfaces: []types.NetworkInterface{
+			{
+				NetworkInterfaceId: aws.String("eni-1234"),
+				PrivateIpAddress:   aws.String("10.0.0.10"),
+				MacAddress:         aws.String("02:00:00:00:00:01"),
+				SubnetId:           aws.String("subnet-1234"),
+			},
+		},
+	}, nil
+}
+
+func TestResolveAttachedInterface(t *testing.T) {
+	restoreSleep := sdk.TestingUseNopSleep()
+	defer restoreSleep()
+
+	cases := map[string]struct {
+		VisibleAfter int
+		ExpectCalls  int
+		ExpectErr    bool
+	}{
+		"immediately visible": {
+			VisibleAfter: 1,
+			ExpectCalls:  1,
+		},
+		"delayed visibility": {
+			VisibleAfter: 3,
+			ExpectCalls:  3,
+		},
+		"never visible": {
+			VisibleAfter: 1000,
+			ExpectErr:    true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockAttachedInterfaceClient{visibleAfter: c.VisibleAfter}
+
+			attached, err := ResolveAttachedInterface(context.Background(), client, "eni-attach-1234",
+				func(o *ResolveAttachedInterfaceOptions) {
+					o.MaxWait = 10 * time.Second
+				})
+			if c.ExpectErr {
+				var notFound *AttachedInterfaceNotFoundError
+				if !errors.As(err, &notFound) {
+					t.Fatalf("expect %T error, got %v", notFound, err)
+				}
+				if e, a := 10*time.Second, notFound.MaxWait; e != a {
+					t.Errorf("expect %v max wait, got %v", e, a)
+				}
+				// Polling is bounded by the max wait, with at least the
+				// minimum delay between each describe.
+				if a := client.calls; a < 2 || a > 11 {
+					t.Errorf("expect between 2 and 11 calls, got %v", a)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			expect := AttachedInterface{
+				NetworkInterfaceID: "eni-1234",
+				PrivateIPAddress:   "10.0.0.10",
+				MACAddress:         "02:00:00:00:00:01",
+				SubnetID:           "subnet-1234",
+			}
+			if e, a := expect, *attached; e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+			if e, a := c.ExpectCalls, client.calls; e != a {
+				t.Errorf("expect %v calls, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestResolveAttachedInterface_DescribeError(t *testing.T) {
+	restoreSleep := sdk.TestingUseNopSleep()
+	defer restoreSleep()
+
+	client := &mockAttachedInterfaceClient{visibleAfter: 1}
+	_, err := ResolveAttachedInterface(context.Background(), client, "eni-attach-other")
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	var notFound *AttachedInterfaceNotFoundError
+	if errors.As(err, &notFound) {
+		t.Errorf("expect describe error, got %v", err)
+	}
+	if e, a := 1, client.calls; e != a {
+		t.Errorf("expect %v calls, got %v", e, a)
+	}
+}
+
+func newAttachNetworkInterfaceClient(errCodes ...string) (*Client, *int) {
+	var calls int
+	client := New(Options{
+		Region:      "us-west-2",
+		Credentials: unit.StubCredentialsProvider{},
+		HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			calls++
+			if calls <= len(errCodes) {
+				return &http.Response{
+					StatusCode: 400,
+					Header:     http.Header{},
+					Body: ioutil.NopCloser(strings.NewReader(`<Response><Errors><Error><Code>` +
+						errCodes[calls-1] + `</Code><Message>message</Message></Error></Errors><RequestID>id</RequestID></Response>`)),
+				}, nil
+			}
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{},
+				Body: ioutil.NopCloser(strings.NewReader(
+					`<AttachNetworkInterfaceResponse><attachmentId>eni-attach-1234</attachmentId></AttachNetworkInterfaceResponse>`)),
+			}, nil
+		}),
+	})
+	return client, &calls
+}
+
+func TestRetryOnEventualConsistency(t *testing.T) {
+	restoreSleep := sdk.TestingUseNopSleep()
+	defer restoreSleep()
+
+	cases := map[string]struct {
+		ErrCodes    []string
+		Retry       bool
+		MaxAttempts int
+		ExpectCalls int
+		ExpectErr   bool
+	}{
+		"invalid parameter value": {
+			ErrCodes:    []string{"InvalidParameterValue"},
+			Retry:       true,
+			ExpectCalls: 2,
+		},
+		"incorrect state": {
+			ErrCodes:    []string{"IncorrectState"},
+			Retry:       true,
+			ExpectCalls: 2,
+		},
+		"not retried without option": {
+			ErrCodes:    []string{"InvalidParameterValue"},
+			ExpectCalls: 1,
+			ExpectErr:   true,
+		},
+		"other error not retried": {
+			ErrCodes:    []string{"InvalidNetworkInterfaceID.NotFound"},
+			Retry:       true,
+			ExpectCalls: 1,
+			ExpectErr:   true,
+		},
+		"max attempts": {
+			ErrCodes:    []string{"IncorrectState", "IncorrectState", "IncorrectState"},
+			Retry:       true,
+			MaxAttempts: 2,
+			ExpectCalls: 2,
+			ExpectErr:   true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client, calls := newAttachNetworkInterfaceClient(c.ErrCodes...)
+
+			var optFns []func(*Options)
+			if c.Retry {
+				optFns = append(optFns, RetryOnEventualConsistency(c.MaxAttempts))
+			}
+
+			out, err := client.AttachNetworkInterface(context.Background(), &AttachNetworkInterfaceInput{
+				DeviceIndex:        1,
+				InstanceId:         aws.String("i-1234"),
+				NetworkInterfaceId: aws.String("eni-1234"),
+			}, optFns...)
+			if e, a := c.ExpectCalls, *calls; e != a {
+				t.Errorf("expect %v calls, got %v", e, a)
+			}
+			if c.ExpectErr {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := "eni-attach-1234", aws.ToString(out.AttachmentId); e != a {
+				t.Errorf("expect %v attachment, got %v", e, a)
+			}
+		})
+	}
+}
+
+// mockAttachmentStatusClient returns the network interface with the
+// attachment in each of the statuses in turn.
+type mockAttachmentStatusClient struct {
+	statuses []types.AttachmentStatus
+	calls    int
+}
+
+func (m *mockAttachmentStatusClient) DescribeNetworkInterfaces(ctx context.Context, params *DescribeNetworkInterfacesInput, optFns ...func(*Options)) (*DescribeNetworkInterfacesOutput, error) {
+	status := m.statuses[len(m.statuses)-1]
+	if m.calls < len(m.statuses) {
+		status = m.statuses[m.calls]
+	}
+	m.calls++
+
+	if len(status) == 0 {
+		return &DescribeNetworkInterfacesOutput{}, nil
+	}
+	return &DescribeNetworkInterfacesOutput{
+		NetworkInterfaces: []types.NetworkInterface{
+			{
+				NetworkInterfaceId: aws.String("eni-1234"),
+				Attachment: &types.NetworkInterfaceAttachment{
+					AttachmentId: aws.String("eni-attach-1234"),
+					Status:       status,
+				},
+			},
+		},
+	}, nil
+}
+
+func TestWaitAttachmentAttached(t *testing.T) {
+	restoreSleep := sdk.TestingUseNopSleep()
+	defer restoreSleep()
+
+	cases := map[string]struct {
+		Statuses      []types.AttachmentStatus
+		ExpectCalls   int
+		ExpectStatus  types.AttachmentStatus
+		ExpectErr     bool
+		ExpectTimeout bool
+	}{
+		"attached": {
+			Statuses:    []types.AttachmentStatus{types.AttachmentStatusAttached},
+			ExpectCalls: 1,
+		},
+		"delayed attach": {
+			Statuses: []types.AttachmentStatus{
+				types.AttachmentStatusAttaching, types.AttachmentStatusAttaching, types.AttachmentStatusAttached,
+			},
+			ExpectCalls: 3,
+		},
+		"detached": {
+			Statuses:     []types.AttachmentStatus{types.AttachmentStatusAttaching, types.AttachmentStatusDetached},
+			ExpectCalls:  2,
+			ExpectStatus: types.AttachmentStatusDetached,
+			ExpectErr:    true,
+		},
+		"delayed visibility": {
+			Statuses: []types.AttachmentStatus{
+				"", "", types.AttachmentStatusAttaching, types.AttachmentStatusAttached,
+			},
+			ExpectCalls: 4,
+		},
+		"not found": {
+			Statuses:      []types.AttachmentStatus{""},
+			ExpectTimeout: true,
+		},
+		"timeout": {
+			Statuses:      []types.AttachmentStatus{types.AttachmentStatusAttaching},
+			ExpectTimeout: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockAttachmentStatusClient{statuses: c.Statuses}
+			err := WaitAttachmentAttached(context.Background(), client, "eni-attach-1234", 30*time.Second)
+			switch {
+			case c.ExpectErr:
+				var notAttached *AttachmentNotAttachedError
+				if !errors.As(err, &notAttached) {
+					t.Fatalf("expect %T error, got %v", notAttached, err)
+				}
+				if e, a := c.ExpectStatus, notAttached.Status; e != a {
+					t.Errorf("expect %v status, got %v", e, a)
+				}
+			case c.ExpectTimeout:
+				if err == nil || !strings.Contains(err.Error(), "exceeded max wait time") {
+					t.Fatalf("expect max wait exceeded error, got %v", err)
+				}
+				// Polling is bounded by the max wait, with at least the
+				// minimum delay between each describe.
+				if a := client.calls; a < 2 || a > 16 {
+					t.Errorf("expect between 2 and 16 calls, got %v", a)
+				}
+				return
+			default:
+				if err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+			}
+
+			if e, a := c.ExpectCalls, client.calls; e != a {
+				t.Errorf("expect %v calls, got %v", e, a)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/internal/validation"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// vpcEndpointServiceResourceType is the TagSpecification resource type of the
+// service configuration created by CreateVpcEndpointServiceConfiguration.
+const vpcEndpointServiceResourceType types.ResourceType = "vpc-endpoint-service"
+
+// addRequiredTags adds the middleware validating the TagSpecifications of
+// CreateVpcEndpointServiceConfiguration operations against the client's
+// RequiredTags.
+func addRequiredTags(stack *middleware.Stack, o Options) error {
+	if len(o.RequiredTags) == 0 {
+		return nil
+	}
+	return stack.Initialize.Add(&validateRequiredTags{
+		keys:         o.RequiredTags,
+		resourceType: vpcEndpointServiceResourceType,
+	}, middleware.After)
+}
+
+// validateRequiredTags fails create operations whose TagSpecifications for the
+// resource type created do not include all of the required tag keys, before
+// the request is sent. Tags specified for other resource types do not satisfy
+// the required tags.
+type validateRequiredTags struct {
+	keys         []string
+	resourceType types.ResourceType
+}
+
+func (*validateRequiredTags) ID() string {
+	return "RequiredTagsValidation"
+}
+
+func (m *validateRequiredTags) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	input, ok := in.Parameters.(*CreateVpcEndpointServiceConfigurationInput)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown input parameters type %T", in.Parameters)
+	}
+
+	present := map[string]struct{}{}
+	for _, spec := range input.TagSpecifications {
+		if spec.ResourceType != m.resourceType {
+			continue
+		}
+		for _, t := range spec.Tags {
+			if t.Key != nil {
+				present[*t.Key] = struct{}{}
+			}
+		}
+	}
+	var missing []string
+	for _, k := range m.keys {
+		if _, ok := present[k]; !ok {
+			missing = append(missing, k)
+		}
+	}
+	if len(missing) != 0 {
+		invalidParams := smithy.InvalidParamsError{Context: "CreateVpcEndpointServiceConfigurationInput"}
+		invalidParams.Add(validation.NewErrInvalidValue("TagSpecifications",
+			fmt.Sprintf("missing required tag key(s) %s", strings.Join(missing, ", "))))
+		return out, metadata, invalidParams
+	}
+
+	return next.HandleInitialize(ctx, in)
+}
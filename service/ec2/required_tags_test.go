@@ -0,0 +1,135 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting/unit"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	smithy "github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestClient_RequiredTags(t *testing.T) {
+	cases := map[string]struct {
+		RequiredTags      []string
+		TagSpecifications []types.TagSpecification
+		ExpectSent        bool
+		ExpectErr         string
+	}{
+		"no required tags": {
+			ExpectSent: true,
+		},
+		"required tags present": {
+			RequiredTags: []string{"team", "cost-center"},
+			TagSpecifications: []types.TagSpecification{
+				{
+					ResourceType: types.ResourceType("vpc-endpoint-service"),
+					Tags: []types.Tag{
+						{Key: aws.String("team"), Value: aws.String("network")},
+						{Key: aws.String("cost-center"), Value: aws.String("1234")},
+					},
+				},
+			},
+			ExpectSent: true,
+		},
+		"required tag missing": {
+			RequiredTags: []string{"team", "cost-center"},
+			TagSpecifications: []types.TagSpecification{
+				{
+					ResourceType: types.ResourceType("vpc-endpoint-service"),
+					Tags: []types.Tag{
+						{Key: aws.String("team"), Value: aws.String("network")},
+					},
+				},
+			},
+			ExpectErr: "missing required tag key(s) cost-center",
+		},
+		"required tags for other resource type": {
+			RequiredTags: []string{"team"},
+			TagSpecifications: []types.TagSpecification{
+				{
+					ResourceType: types.ResourceTypeVpc,
+					Tags: []types.Tag{
+						{Key: aws.String("team"), Value: aws.String("network")},
+					},
+				},
+				{
+					ResourceType: types.ResourceType("vpc-endpoint-service"),
+					Tags: []types.Tag{
+						{Key: aws.String("cost-center"), Value: aws.String("1234")},
+					},
+				},
+			},
+			ExpectErr: "missing required tag key(s) team",
+		},
+		"no tag specifications": {
+			RequiredTags: []string{"team"},
+			ExpectErr:    "missing required tag key(s) team",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var sent bool
+			client := New(Options{
+				Region:       "us-west-2",
+				Credentials:  unit.StubCredentialsProvider{},
+				RequiredTags: c.RequiredTags,
+				HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+					sent = true
+					return &http.Response{
+						StatusCode: 200,
+						Header:     http.Header{},
+						Body: ioutil.NopCloser(strings.NewReader(
+							`<CreateVpcEndpointServiceConfigurationResponse></CreateVpcEndpointServiceConfigurationResponse>`)),
+					}, nil
+				}),
+			})
+
+			_, err := client.CreateVpcEndpointServiceConfiguration(context.Background(), &CreateVpcEndpointServiceConfigurationInput{
+				NetworkLoadBalancerArns: []string{"arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/net/nlb/1234"},
+				TagSpecifications:       c.TagSpecifications,
+			})
+			if len(c.ExpectErr) != 0 {
+				var invalidParams smithy.InvalidParamsError
+				if !errors.As(err, &invalidParams) {
+					t.Fatalf("expect %T error, got %v", invalidParams, err)
+				}
+				if e, a := c.ExpectErr, err.Error(); !strings.Contains(a, e) {
+					t.Errorf("expect error to contain %q, got %v", e, a)
+				}
+			} else if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.ExpectSent, sent; e != a {
+				t.Errorf("expect request sent %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestClient_RequiredTags_OtherOperations(t *testing.T) {
+	client := New(Options{
+		Region:       "us-west-2",
+		Credentials:  unit.StubCredentialsProvider{},
+		RequiredTags: []string{"team"},
+		HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(strings.NewReader(`<DescribeVpcsResponse></DescribeVpcsResponse>`)),
+			}, nil
+		}),
+	})
+
+	if _, err := client.DescribeVpcs(context.Background(), &DescribeVpcsInput{}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// serializedInput is returned by the serializeInputForTest middleware to stop
+// the operation once its input has been serialized.
+type serializedInput struct {
+	values url.Values
+}
+
+func (*serializedInput) Error() string {
+	return "operation input serialized"
+}
+
+// SerializeInputForTest returns the query parameters the input of an
+// operation, such as a *DescribeInstancesInput, is serialized to in the body of
+// the operation's request. The request is not sent. Use it to assert complex
+// inputs, such as filters with many values, serialize to the expected indexed
+// parameters, like "Filter.1.Value.2".
+//
+// An error is returned if the input is not the input of an operation of the
+// client, or the input is not valid.
+func SerializeInputForTest(input interface{}) (url.Values, error) {
+	v := reflect.ValueOf(input)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct ||
+		!strings.HasSuffix(v.Elem().Type().Name(), "Input") {
+		return nil, fmt.Errorf("expect operation input, got %T", input)
+	}
+
+	client := New(Options{Region: "us-east-1"})
+	opName := strings.TrimSuffix(v.Elem().Type().Name(), "Input")
+	method := reflect.ValueOf(client).MethodByName(opName)
+	if !method.IsValid() || method.Type().NumIn() < 2 || method.Type().In(1) != v.Type() {
+		return nil, fmt.Errorf("expect operation input, got %T", input)
+	}
+
+	results := method.Call([]reflect.Value{
+		reflect.ValueOf(context.Background()),
+		v,
+		reflect.ValueOf(WithAPIOptions(addSerializeInputForTest)),
+	})
+	err, _ := results[1].Interface().(error)
+
+	var serialized *serializedInput
+	if errors.As(err, &serialized) {
+		return serialized.values, nil
+	}
+	if err == nil {
+		err = fmt.Errorf("operation input not serialized")
+	}
+	return nil, err
+}
+
+func addSerializeInputForTest(stack *middleware.Stack) error {
+	return stack.Build.Add(middleware.BuildMiddlewareFunc("SerializeInputForTest",
+		func(ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler) (
+			out middleware.BuildOutput, metadata middleware.Metadata, err error,
+		) {
+			req, ok := in.Request.(*smithyhttp.Request)
+			if !ok {
+				return out, metadata, fmt.Errorf("unknown transport type %T", in.Request)
+			}
+
+			var body []byte
+			if stream := req.GetStream(); stream != nil {
+				if body, err = ioutil.ReadAll(stream); err != nil {
+					return out, metadata, fmt.Errorf("failed to read request body, %w", err)
+				}
+			}
+			values, err := url.ParseQuery(string(body))
+			if err != nil {
+				return out, metadata, fmt.Errorf("failed to parse request body, %w", err)
+			}
+			return out, metadata, &serializedInput{values: values}
+		}), middleware.After)
+}
@@ -0,0 +1,63 @@
+package ec2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func TestSerializeInputForTest(t *testing.T) {
+	values, err := SerializeInputForTest(&DescribeInstancesInput{
+		Filters: []types.Filter{
+			{Name: aws.String("tag:team"), Values: []string{"a", "b"}},
+			{Name: aws.String("instance-state-name"), Values: []string{"running", "stopped", "pending"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expect := map[string][]string{
+		"Action":           {"DescribeInstances"},
+		"Version":          {"2016-11-15"},
+		"Filter.1.Name":    {"tag:team"},
+		"Filter.1.Value.1": {"a"},
+		"Filter.1.Value.2": {"b"},
+		"Filter.2.Name":    {"instance-state-name"},
+		"Filter.2.Value.1": {"running"},
+		"Filter.2.Value.2": {"stopped"},
+		"Filter.2.Value.3": {"pending"},
+	}
+	if e, a := expect, map[string][]string(values); !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v values, got %v", e, a)
+	}
+}
+
+func TestSerializeInputForTest_Error(t *testing.T) {
+	cases := map[string]struct {
+		Input interface{}
+	}{
+		"not an input": {
+			Input: &types.Filter{},
+		},
+		"not a pointer": {
+			Input: DescribeInstancesInput{},
+		},
+		"nil": {
+			Input: (*DescribeInstancesInput)(nil),
+		},
+		"invalid input": {
+			Input: &DeleteVpcInput{},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := SerializeInputForTest(c.Input); err == nil {
+				t.Fatalf("expect error, got none")
+			}
+		})
+	}
+}
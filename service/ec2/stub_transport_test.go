@@ -0,0 +1,47 @@
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting/unit"
+)
+
+func TestStubTransport(t *testing.T) {
+	stub := awstesting.NewStubTransport()
+	stub.Add("DescribeVpcs", awstesting.StubResponse{
+		Body: `<DescribeVpcsResponse><vpcSet><item><vpcId>vpc-1234</vpcId></item></vpcSet></DescribeVpcsResponse>`,
+	})
+
+	client := New(Options{
+		Region:      "us-west-2",
+		Credentials: unit.StubCredentialsProvider{},
+		HTTPClient:  stub,
+	})
+
+	out, err := client.DescribeVpcs(context.Background(), &DescribeVpcsInput{
+		VpcIds: []string{"vpc-1234"},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 1, len(out.Vpcs); e != a {
+		t.Fatalf("expect %v vpcs, got %v", e, a)
+	}
+	if e, a := "vpc-1234", aws.ToString(out.Vpcs[0].VpcId); e != a {
+		t.Errorf("expect %v vpc, got %v", e, a)
+	}
+
+	requests := stub.Requests()
+	if e, a := 1, len(requests); e != a {
+		t.Fatalf("expect %v requests, got %v", e, a)
+	}
+	if e, a := "DescribeVpcs", requests[0].Operation; e != a {
+		t.Errorf("expect %v operation, got %v", e, a)
+	}
+	if e, a := "Action=DescribeVpcs&Version=2016-11-15&VpcId.1=vpc-1234", string(requests[0].Body); e != a {
+		t.Errorf("expect %v body, got %v", e, a)
+	}
+}
@@ -0,0 +1,22 @@
+package types
+
+// ServiceID returns the ID of the endpoint service, such as
+// "vpce-svc-0123456789abcdef0", or an empty string if the configuration or
+// its ID is nil.
+func (c *ServiceConfiguration) ServiceID() string {
+	if c == nil || c.ServiceId == nil {
+		return ""
+	}
+	return *c.ServiceId
+}
+
+// GetServiceName returns the name of the endpoint service, such as
+// "com.amazonaws.vpce.us-west-2.vpce-svc-0123456789abcdef0", or an empty
+// string if the configuration or its name is nil. The accessor is named
+// GetServiceName as ServiceName is the configuration's member.
+func (c *ServiceConfiguration) GetServiceName() string {
+	if c == nil || c.ServiceName == nil {
+		return ""
+	}
+	return *c.ServiceName
+}
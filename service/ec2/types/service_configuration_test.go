@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+)
+
+func TestServiceConfiguration_Identifiers(t *testing.T) {
+	id, name := "vpce-svc-1234", "com.amazonaws.vpce.us-west-2.vpce-svc-1234"
+
+	cases := map[string]struct {
+		Config     *ServiceConfiguration
+		ExpectID   string
+		ExpectName string
+	}{
+		"populated": {
+			Config: &ServiceConfiguration{
+				ServiceId:    &id,
+				ServiceName:  &name,
+				ServiceState: ServiceStateAvailable,
+			},
+			ExpectID:   id,
+			ExpectName: name,
+		},
+		"sparse": {
+			Config: &ServiceConfiguration{
+				ServiceId: &id,
+			},
+			ExpectID: id,
+		},
+		"empty": {
+			Config: &ServiceConfiguration{},
+		},
+		"nil": {},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if e, a := c.ExpectID, c.Config.ServiceID(); e != a {
+				t.Errorf("expect %q service ID, got %q", e, a)
+			}
+			if e, a := c.ExpectName, c.Config.GetServiceName(); e != a {
+				t.Errorf("expect %q service name, got %q", e, a)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package ec2
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// isNetworkLoadBalancerArn returns if the value is the ARN of an Elastic Load
+// Balancing Network Load Balancer, e.g.
+// arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/net/my-nlb/1234567890abcdef
+func isNetworkLoadBalancerArn(v string) bool {
+	parsed, err := arn.Parse(v)
+	if err != nil {
+		return false
+	}
+	if parsed.Service != "elasticloadbalancing" || len(parsed.Region) == 0 || len(parsed.AccountID) == 0 {
+		return false
+	}
+
+	// loadbalancer/net/<name>/<id>
+	parts := strings.Split(parsed.Resource, "/")
+	if len(parts) != 4 || parts[0] != "loadbalancer" || parts[1] != "net" {
+		return false
+	}
+	return len(parts[2]) != 0 && len(parts[3]) != 0
+}
@@ -0,0 +1,61 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/internal/validation"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+)
+
+type customValidateOpCreateVpcEndpointServiceConfiguration struct {
+}
+
+func (*customValidateOpCreateVpcEndpointServiceConfiguration) ID() string {
+	return "OperationInputCustomValidation"
+}
+
+func (m *customValidateOpCreateVpcEndpointServiceConfiguration) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	input, ok := in.Parameters.(*CreateVpcEndpointServiceConfigurationInput)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown input parameters type %T", in.Parameters)
+	}
+	if err := customValidateOpCreateVpcEndpointServiceConfigurationInput(input); err != nil {
+		return out, metadata, err
+	}
+	return next.HandleInitialize(ctx, in)
+}
+
+// addOpCreateVpcEndpointServiceConfigurationCustomValidationMiddleware adds
+// the validation of the load balancer ARNs of the input, which are not
+// modeled as required.
+func addOpCreateVpcEndpointServiceConfigurationCustomValidationMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(&customValidateOpCreateVpcEndpointServiceConfiguration{}, middleware.After)
+}
+
+func customValidateOpCreateVpcEndpointServiceConfigurationInput(v *CreateVpcEndpointServiceConfigurationInput) error {
+	if v == nil {
+		return nil
+	}
+	invalidParams := smithy.InvalidParamsError{Context: "CreateVpcEndpointServiceConfigurationInput"}
+	if len(v.NetworkLoadBalancerArns) == 0 && len(v.GatewayLoadBalancerArns) == 0 {
+		invalidParams.Add(smithy.NewErrParamRequired("NetworkLoadBalancerArns"))
+	}
+	for i, lbArn := range v.NetworkLoadBalancerArns {
+		field := fmt.Sprintf("NetworkLoadBalancerArns[%d]", i)
+		if len(lbArn) == 0 {
+			invalidParams.Add(smithy.NewErrParamRequired(field))
+		} else if !isNetworkLoadBalancerArn(lbArn) {
+			invalidParams.Add(validation.NewErrInvalidValue(field,
+				fmt.Sprintf("%q is not a Network Load Balancer ARN", lbArn)))
+		}
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	} else {
+		return nil
+	}
+}
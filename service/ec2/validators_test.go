@@ -0,0 +1,114 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting/unit"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestCustomValidateOpCreateVpcEndpointServiceConfigurationInput(t *testing.T) {
+	const nlbArn = "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/net/my-nlb/1234567890abcdef"
+
+	cases := map[string]struct {
+		Input     CreateVpcEndpointServiceConfigurationInput
+		ExpectErr string
+	}{
+		"empty list": {
+			Input:     CreateVpcEndpointServiceConfigurationInput{},
+			ExpectErr: "missing required field, CreateVpcEndpointServiceConfigurationInput.NetworkLoadBalancerArns",
+		},
+		"empty arn": {
+			Input: CreateVpcEndpointServiceConfigurationInput{
+				NetworkLoadBalancerArns: []string{nlbArn, ""},
+			},
+			ExpectErr: "missing required field, CreateVpcEndpointServiceConfigurationInput.NetworkLoadBalancerArns[1]",
+		},
+		"application load balancer arn": {
+			Input: CreateVpcEndpointServiceConfigurationInput{
+				NetworkLoadBalancerArns: []string{
+					"arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-alb/1234567890abcdef",
+				},
+			},
+			ExpectErr: "is not a Network Load Balancer ARN, CreateVpcEndpointServiceConfigurationInput.NetworkLoadBalancerArns[0]",
+		},
+		"classic load balancer arn": {
+			Input: CreateVpcEndpointServiceConfigurationInput{
+				NetworkLoadBalancerArns: []string{
+					"arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/my-elb",
+				},
+			},
+			ExpectErr: "NetworkLoadBalancerArns[0]",
+		},
+		"not an arn": {
+			Input: CreateVpcEndpointServiceConfigurationInput{
+				NetworkLoadBalancerArns: []string{"my-nlb"},
+			},
+			ExpectErr: "NetworkLoadBalancerArns[0]",
+		},
+		"network load balancer arn": {
+			Input: CreateVpcEndpointServiceConfigurationInput{
+				NetworkLoadBalancerArns: []string{nlbArn},
+			},
+		},
+		"other partition": {
+			Input: CreateVpcEndpointServiceConfigurationInput{
+				NetworkLoadBalancerArns: []string{
+					"arn:aws-cn:elasticloadbalancing:cn-north-1:123456789012:loadbalancer/net/my-nlb/1234567890abcdef",
+				},
+			},
+		},
+		"gateway load balancer only": {
+			Input: CreateVpcEndpointServiceConfigurationInput{
+				GatewayLoadBalancerArns: []string{
+					"arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/gwy/my-gwlb/1234567890abcdef",
+				},
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := customValidateOpCreateVpcEndpointServiceConfigurationInput(&c.Input)
+			if len(c.ExpectErr) == 0 {
+				if err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expect error, got none")
+			}
+			if e, a := c.ExpectErr, err.Error(); !strings.Contains(a, e) {
+				t.Errorf("expect error to contain %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestCreateVpcEndpointServiceConfiguration_Validation(t *testing.T) {
+	client := New(Options{
+		Region:      "us-west-2",
+		Credentials: unit.StubCredentialsProvider{},
+		HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			return nil, fmt.Errorf("expect request not to be sent")
+		}),
+	})
+
+	_, err := client.CreateVpcEndpointServiceConfiguration(context.Background(),
+		&CreateVpcEndpointServiceConfigurationInput{
+			NetworkLoadBalancerArns: []string{
+				"arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-alb/1234567890abcdef",
+			},
+		})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := "NetworkLoadBalancerArns[0]", err.Error(); !strings.Contains(a, e) {
+		t.Errorf("expect error to contain %v, got %v", e, a)
+	}
+}
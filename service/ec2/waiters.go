@@ -0,0 +1,115 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/internal/waiter"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// NetworkInterfaceAvailableWaiterOptions are waiter options for
+// NetworkInterfaceAvailableWaiter
+type NetworkInterfaceAvailableWaiterOptions struct {
+
+	// Set of options to modify how an operation is invoked. These apply to all
+	// operations invoked for this client. Use functional options on operation call to
+	// modify this list for per operation behavior.
+	APIOptions []func(*middleware.Stack) error
+
+	// MinDelay is the minimum amount of time to delay between retries. If unset,
+	// NetworkInterfaceAvailableWaiter will use default minimum delay of 20 seconds.
+	// Note that MinDelay must resolve to a value lesser than or equal to the
+	// MaxDelay.
+	MinDelay time.Duration
+
+	// MaxDelay is the maximum amount of time to delay between retries. If unset or set
+	// to zero, NetworkInterfaceAvailableWaiter will use default max delay of 120
+	// seconds. Note that MaxDelay must resolve to value greater than or equal to the
+	// MinDelay.
+	MaxDelay time.Duration
+
+	// Retryable is function that can be used to override the default waiter-behavior
+	// based on operation output, or returned error. The function returns an error in
+	// case of a failure state. In case of retry state, this function returns a bool
+	// value of true and nil error, while in case of success it returns a bool value
+	// of false and nil error.
+	Retryable func(context.Context, *DescribeNetworkInterfacesInput, *DescribeNetworkInterfacesOutput, error) (bool, error)
+}
+
+// NetworkInterfaceAvailableWaiter defines the waiter for a network interface to
+// become available after it was created or detached.
+type NetworkInterfaceAvailableWaiter struct {
+	client DescribeNetworkInterfacesAPIClient
+
+	options NetworkInterfaceAvailableWaiterOptions
+}
+
+// NewNetworkInterfaceAvailableWaiter constructs a NetworkInterfaceAvailableWaiter.
+func NewNetworkInterfaceAvailableWaiter(client DescribeNetworkInterfacesAPIClient, optFns ...func(*NetworkInterfaceAvailableWaiterOptions)) *NetworkInterfaceAvailableWaiter {
+	options := NetworkInterfaceAvailableWaiterOptions{}
+	options.MinDelay = 20 * time.Second
+	options.MaxDelay = 120 * time.Second
+	options.Retryable = networkInterfaceAvailableStateRetryable
+
+	for _, fn := range optFns {
+		fn(&options)
+	}
+	return &NetworkInterfaceAvailableWaiter{
+		client:  client,
+		options: options,
+	}
+}
+
+// Wait calls the waiter function for NetworkInterfaceAvailable waiter. The
+// maxWaitDur is the maximum wait duration the waiter will wait. The maxWaitDur is
+// required and must be greater than zero.
+func (w *NetworkInterfaceAvailableWaiter) Wait(ctx context.Context, params *DescribeNetworkInterfacesInput, maxWaitDur time.Duration, optFns ...func(*NetworkInterfaceAvailableWaiterOptions)) error {
+	options := w.options
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	if options.MaxDelay <= 0 {
+		options.MaxDelay = 120 * time.Second
+	}
+
+	return waiter.Wait(ctx, waiter.Options{
+		Name:     "NetworkInterfaceAvailable",
+		MinDelay: options.MinDelay,
+		MaxDelay: options.MaxDelay,
+		MaxWait:  maxWaitDur,
+	}, func(out interface{}, err error) (bool, error) {
+		retryable, err := options.Retryable(ctx, params, out.(*DescribeNetworkInterfacesOutput), err)
+		return !retryable, err
+	}, func(ctx context.Context) (interface{}, error) {
+		return w.client.DescribeNetworkInterfaces(ctx, params, func(o *Options) {
+			o.APIOptions = append(o.APIOptions, options.APIOptions...)
+		})
+	})
+}
+
+func networkInterfaceAvailableStateRetryable(ctx context.Context, input *DescribeNetworkInterfacesInput, output *DescribeNetworkInterfacesOutput, err error) (bool, error) {
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode() == "InvalidNetworkInterfaceID.NotFound" {
+			return true, nil
+		}
+		return false, err
+	}
+
+	if len(output.NetworkInterfaces) == 0 {
+		return true, nil
+	}
+
+	for _, ni := range output.NetworkInterfaces {
+		if ni.Status != types.NetworkInterfaceStatusAvailable {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,109 @@
+package ec2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/internal/sdk"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+)
+
+type mockDescribeNetworkInterfacesClient struct {
+	states []types.NetworkInterfaceStatus
+	errs   []error
+	calls  int
+}
+
+func (m *mockDescribeNetworkInterfacesClient) DescribeNetworkInterfaces(ctx context.Context, params *DescribeNetworkInterfacesInput, optFns ...func(*Options)) (*DescribeNetworkInterfacesOutput, error) {
+	state := m.states[m.calls]
+	var err error
+	if m.calls < len(m.errs) {
+		err = m.errs[m.calls]
+	}
+	m.calls++
+	if err != nil {
+		return nil, err
+	}
+	return &DescribeNetworkInterfacesOutput{
+		NetworkInterfaces: []types.NetworkInterface{
+			{Status: state},
+		},
+	}, nil
+}
+
+func TestNetworkInterfaceAvailableWaiter(t *testing.T) {
+	restoreSleep := sdk.TestingUseNopSleep()
+	defer restoreSleep()
+
+	client := &mockDescribeNetworkInterfacesClient{
+		states: []types.NetworkInterfaceStatus{
+			types.NetworkInterfaceStatusDetaching,
+			types.NetworkInterfaceStatusAvailable,
+		},
+	}
+
+	w := NewNetworkInterfaceAvailableWaiter(client)
+	err := w.Wait(context.Background(), &DescribeNetworkInterfacesInput{
+		NetworkInterfaceIds: []string{"eni-12345678"},
+	}, time.Hour)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 2, client.calls; e != a {
+		t.Errorf("expect %v calls, got %v", e, a)
+	}
+}
+
+func TestNetworkInterfaceAvailableWaiter_Errors(t *testing.T) {
+	cases := map[string]struct {
+		Errs        []error
+		ExpectCalls int
+		ExpectErr   bool
+	}{
+		"not found retried": {
+			Errs: []error{
+				&smithy.GenericAPIError{Code: "InvalidNetworkInterfaceID.NotFound"},
+			},
+			ExpectCalls: 2,
+		},
+		"other error": {
+			Errs: []error{
+				&smithy.GenericAPIError{Code: "UnauthorizedOperation"},
+			},
+			ExpectCalls: 1,
+			ExpectErr:   true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			restoreSleep := sdk.TestingUseNopSleep()
+			defer restoreSleep()
+
+			client := &mockDescribeNetworkInterfacesClient{
+				states: []types.NetworkInterfaceStatus{
+					"",
+					types.NetworkInterfaceStatusAvailable,
+				},
+				errs: c.Errs,
+			}
+
+			w := NewNetworkInterfaceAvailableWaiter(client)
+			err := w.Wait(context.Background(), &DescribeNetworkInterfacesInput{
+				NetworkInterfaceIds: []string{"eni-12345678"},
+			}, time.Hour)
+			if c.ExpectErr {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+			} else if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectCalls, client.calls; e != a {
+				t.Errorf("expect %v calls, got %v", e, a)
+			}
+		})
+	}
+}
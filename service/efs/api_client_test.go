@@ -0,0 +1,62 @@
+package efs
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting/unit"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestClient_WithAPIOptions(t *testing.T) {
+	var counts []string
+	counter := func(id string) func(*middleware.Stack) error {
+		return func(stack *middleware.Stack) error {
+			return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(id,
+				func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (
+					out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+				) {
+					counts = append(counts, stack.ID())
+					return next.HandleInitialize(ctx, in)
+				}), middleware.Before)
+		}
+	}
+
+	client := New(Options{
+		Region:      "us-west-2",
+		Credentials: unit.StubCredentialsProvider{},
+		HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(strings.NewReader(`{}`)),
+			}, nil
+		}),
+	}, WithAPIOptions(counter("clientCounter")))
+
+	if _, err := client.DescribeFileSystems(context.Background(), &DescribeFileSystemsInput{}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if _, err := client.DescribeAccessPoints(context.Background(), &DescribeAccessPointsInput{}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if _, err := client.DeleteFileSystem(context.Background(), &DeleteFileSystemInput{
+		FileSystemId: aws.String("fs-1234"),
+	}, WithAPIOptions(counter("operationCounter"))); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expect := []string{"DescribeFileSystems", "DescribeAccessPoints", "DeleteFileSystem", "DeleteFileSystem"}
+	if e, a := expect, counts; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v operations counted, got %v", e, a)
+	}
+	if e, a := 1, len(client.options.APIOptions); e != a {
+		t.Errorf("expect per operation options to not modify client, expect %v APIOptions, got %v", e, a)
+	}
+}
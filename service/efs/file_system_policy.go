@@ -0,0 +1,100 @@
+package efs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/efs/types"
+)
+
+// DeleteFileSystemPolicyAPIClient is a client that implements the
+// DeleteFileSystemPolicy operation.
+type DeleteFileSystemPolicyAPIClient interface {
+	DeleteFileSystemPolicy(context.Context, *DeleteFileSystemPolicyInput, ...func(*Options)) (*DeleteFileSystemPolicyOutput, error)
+}
+
+var _ DeleteFileSystemPolicyAPIClient = (*Client)(nil)
+
+// DescribeFileSystemPolicyAPIClient is a client that implements the
+// DescribeFileSystemPolicy operation.
+type DescribeFileSystemPolicyAPIClient interface {
+	DescribeFileSystemPolicy(context.Context, *DescribeFileSystemPolicyInput, ...func(*Options)) (*DescribeFileSystemPolicyOutput, error)
+}
+
+var _ DescribeFileSystemPolicyAPIClient = (*Client)(nil)
+
+// RemoveFileSystemPolicyAPIClient is a client that implements the
+// DeleteFileSystemPolicy and DescribeFileSystemPolicy operations.
+type RemoveFileSystemPolicyAPIClient interface {
+	DeleteFileSystemPolicyAPIClient
+	DescribeFileSystemPolicyAPIClient
+}
+
+var _ RemoveFileSystemPolicyAPIClient = (*Client)(nil)
+
+// RemoveFileSystemPolicyOptions provides the options for
+// RemoveFileSystemPolicy.
+type RemoveFileSystemPolicyOptions struct {
+	// If true, DescribeFileSystemPolicy is called after the policy is deleted to
+	// confirm the file system no longer has a policy, and the default policy is
+	// in effect.
+	VerifyDeletion bool
+
+	// The functional options passed to each operation invoked.
+	ClientOptions []func(*Options)
+}
+
+// FileSystemPolicyNotDeletedError is returned by RemoveFileSystemPolicy when
+// the deletion of a file system's policy is verified, but the file system
+// still has a policy.
+type FileSystemPolicyNotDeletedError struct {
+	FileSystemID string
+	Policy       string
+}
+
+func (e *FileSystemPolicyNotDeletedError) Error() string {
+	return fmt.Sprintf("file system %s policy still present after deletion", e.FileSystemID)
+}
+
+// RemoveFileSystemPolicy deletes the FileSystemPolicy of the file system,
+// reverting the file system to the default policy.
+//
+// If VerifyDeletion is set, DescribeFileSystemPolicy is called after the
+// deletion, and a PolicyNotFound error is treated as confirmation the policy
+// was deleted. If a policy is still returned a FileSystemPolicyNotDeletedError
+// is returned.
+func RemoveFileSystemPolicy(ctx context.Context, client RemoveFileSystemPolicyAPIClient, fileSystemID string, optFns ...func(*RemoveFileSystemPolicyOptions)) error {
+	var options RemoveFileSystemPolicyOptions
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	_, err := client.DeleteFileSystemPolicy(ctx, &DeleteFileSystemPolicyInput{
+		FileSystemId: aws.String(fileSystemID),
+	}, options.ClientOptions...)
+	if err != nil {
+		return fmt.Errorf("failed to delete file system %s policy, %w", fileSystemID, err)
+	}
+
+	if !options.VerifyDeletion {
+		return nil
+	}
+
+	out, err := client.DescribeFileSystemPolicy(ctx, &DescribeFileSystemPolicyInput{
+		FileSystemId: aws.String(fileSystemID),
+	}, options.ClientOptions...)
+	if err != nil {
+		var notFound *types.PolicyNotFound
+		if errors.As(err, &notFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to verify file system %s policy deletion, %w", fileSystemID, err)
+	}
+
+	return &FileSystemPolicyNotDeletedError{
+		FileSystemID: fileSystemID,
+		Policy:       aws.ToString(out.Policy),
+	}
+}
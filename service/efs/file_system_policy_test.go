@@ -0,0 +1,206 @@
+package efs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting/unit"
+	"github.com/aws/aws-sdk-go-v2/service/efs/types"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+type mockFileSystemPolicyClient struct {
+	deleteErr   error
+	describeOut *DescribeFileSystemPolicyOutput
+	describeErr error
+
+	deleted   []string
+	described []string
+}
+
+func (m *mockFileSystemPolicyClient) DeleteFileSystemPolicy(ctx context.Context, params *DeleteFileSystemPolicyInput, optFns ...func(*Options)) (*DeleteFileSystemPolicyOutput, error) {
+	m.deleted = append(m.deleted, aws.ToString(params.FileSystemId))
+	if m.deleteErr != nil {
+		return nil, m.deleteErr
+	}
+	return &DeleteFileSystemPolicyOutput{}, nil
+}
+
+func (m *mockFileSystemPolicyClient) DescribeFileSystemPolicy(ctx context.Context, params *DescribeFileSystemPolicyInput, optFns ...func(*Options)) (*DescribeFileSystemPolicyOutput, error) {
+	m.described = append(m.described, aws.ToString(params.FileSystemId))
+	if m.describeErr != nil {
+		return nil, m.describeErr
+	}
+	return m.describeOut, nil
+}
+
+func TestRemoveFileSystemPolicy(t *testing.T) {
+	cases := map[string]struct {
+		Client          *mockFileSystemPolicyClient
+		VerifyDeletion  bool
+		ExpectDescribed int
+		ExpectErr       func(*testing.T, error)
+	}{
+		"no verify": {
+			Client: &mockFileSystemPolicyClient{},
+		},
+		"verify confirmed deleted": {
+			Client: &mockFileSystemPolicyClient{
+				describeErr: &types.PolicyNotFound{Message: aws.String("No policy found")},
+			},
+			VerifyDeletion:  true,
+			ExpectDescribed: 1,
+		},
+		"verify still present": {
+			Client: &mockFileSystemPolicyClient{
+				describeOut: &DescribeFileSystemPolicyOutput{
+					FileSystemId: aws.String("fs-1234"),
+					Policy:       aws.String(`{"Version":"2012-10-17"}`),
+				},
+			},
+			VerifyDeletion:  true,
+			ExpectDescribed: 1,
+			ExpectErr: func(t *testing.T, err error) {
+				var notDeleted *FileSystemPolicyNotDeletedError
+				if !errors.As(err, &notDeleted) {
+					t.Fatalf("expect %T error, got %v", notDeleted, err)
+				}
+				if e, a := `{"Version":"2012-10-17"}`, notDeleted.Policy; e != a {
+					t.Errorf("expect %v policy, got %v", e, a)
+				}
+			},
+		},
+		"verify describe error": {
+			Client: &mockFileSystemPolicyClient{
+				describeErr: fmt.Errorf("access denied"),
+			},
+			VerifyDeletion:  true,
+			ExpectDescribed: 1,
+			ExpectErr: func(t *testing.T, err error) {
+				var notDeleted *FileSystemPolicyNotDeletedError
+				if errors.As(err, &notDeleted) {
+					t.Errorf("expect describe error, got %v", err)
+				}
+			},
+		},
+		"delete error": {
+			Client: &mockFileSystemPolicyClient{
+				deleteErr: fmt.Errorf("file system not found"),
+			},
+			VerifyDeletion: true,
+			ExpectErr: func(t *testing.T, err error) {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := RemoveFileSystemPolicy(context.Background(), c.Client, "fs-1234",
+				func(o *RemoveFileSystemPolicyOptions) {
+					o.VerifyDeletion = c.VerifyDeletion
+				})
+			if c.ExpectErr != nil {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				c.ExpectErr(t, err)
+			} else if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := []string{"fs-1234"}, c.Client.deleted; len(a) != 1 || e[0] != a[0] {
+				t.Errorf("expect %v deleted, got %v", e, a)
+			}
+			if e, a := c.ExpectDescribed, len(c.Client.described); e != a {
+				t.Errorf("expect %v describe calls, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestClient_DescribeFileSystemPolicy(t *testing.T) {
+	cases := map[string]struct {
+		StatusCode   int
+		Header       http.Header
+		Body         string
+		ExpectPolicy string
+		ExpectErr    func(*testing.T, error)
+	}{
+		"policy": {
+			StatusCode:   200,
+			Body:         `{"FileSystemId":"fs-1234","Policy":"{\"Version\":\"2012-10-17\",\"Statement\":[]}"}`,
+			ExpectPolicy: `{"Version":"2012-10-17","Statement":[]}`,
+		},
+		"policy not found": {
+			StatusCode: 404,
+			Header:     http.Header{"X-Amzn-Errortype": []string{"PolicyNotFound"}},
+			Body:       `{"ErrorCode":"PolicyNotFound","Message":"No policy found"}`,
+			ExpectErr: func(t *testing.T, err error) {
+				var notFound *types.PolicyNotFound
+				if !errors.As(err, &notFound) {
+					t.Fatalf("expect %T error, got %v", notFound, err)
+				}
+				if e, a := "PolicyNotFound", aws.ToString(notFound.ErrorCode_); e != a {
+					t.Errorf("expect %v error code, got %v", e, a)
+				}
+				if e, a := "No policy found", notFound.ErrorMessage(); e != a {
+					t.Errorf("expect %v message, got %v", e, a)
+				}
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var path string
+			client := New(Options{
+				Region:      "us-west-2",
+				Credentials: unit.StubCredentialsProvider{},
+				HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+					path = r.URL.Path
+					header := c.Header
+					if header == nil {
+						header = http.Header{}
+					}
+					return &http.Response{
+						StatusCode: c.StatusCode,
+						Header:     header,
+						Body:       ioutil.NopCloser(strings.NewReader(c.Body)),
+					}, nil
+				}),
+			})
+
+			out, err := client.DescribeFileSystemPolicy(context.Background(), &DescribeFileSystemPolicyInput{
+				FileSystemId: aws.String("fs-1234"),
+			})
+			if e, a := "/2015-02-01/file-systems/fs-1234/policy", path; e != a {
+				t.Errorf("expect %v path, got %v", e, a)
+			}
+			if c.ExpectErr != nil {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				c.ExpectErr(t, err)
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := "fs-1234", aws.ToString(out.FileSystemId); e != a {
+				t.Errorf("expect %v file system, got %v", e, a)
+			}
+			if e, a := c.ExpectPolicy, aws.ToString(out.Policy); e != a {
+				t.Errorf("expect %v policy, got %v", e, a)
+			}
+		})
+	}
+}
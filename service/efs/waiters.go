@@ -0,0 +1,119 @@
+package efs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/internal/waiter"
+	"github.com/aws/aws-sdk-go-v2/service/efs/types"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// FileSystemAvailableWaiterOptions are waiter options for
+// FileSystemAvailableWaiter
+type FileSystemAvailableWaiterOptions struct {
+
+	// Set of options to modify how an operation is invoked. These apply to all
+	// operations invoked for this client. Use functional options on operation call to
+	// modify this list for per operation behavior.
+	APIOptions []func(*middleware.Stack) error
+
+	// MinDelay is the minimum amount of time to delay between retries. If unset,
+	// FileSystemAvailableWaiter will use default minimum delay of 5 seconds. Note that
+	// MinDelay must resolve to a value lesser than or equal to the MaxDelay.
+	MinDelay time.Duration
+
+	// MaxDelay is the maximum amount of time to delay between retries. If unset or set
+	// to zero, FileSystemAvailableWaiter will use default max delay of 120 seconds.
+	// Note that MaxDelay must resolve to value greater than or equal to the MinDelay.
+	MaxDelay time.Duration
+
+	// Retryable is function that can be used to override the default waiter-behavior
+	// based on operation output, or returned error. The function returns an error in
+	// case of a failure state. In case of retry state, this function returns a bool
+	// value of true and nil error, while in case of success it returns a bool value
+	// of false and nil error.
+	Retryable func(context.Context, *DescribeFileSystemsInput, *DescribeFileSystemsOutput, error) (bool, error)
+}
+
+// FileSystemAvailableWaiter defines the waiter for a file system to become
+// available after it was created or updated.
+type FileSystemAvailableWaiter struct {
+	client DescribeFileSystemsAPIClient
+
+	options FileSystemAvailableWaiterOptions
+}
+
+// NewFileSystemAvailableWaiter constructs a FileSystemAvailableWaiter.
+func NewFileSystemAvailableWaiter(client DescribeFileSystemsAPIClient, optFns ...func(*FileSystemAvailableWaiterOptions)) *FileSystemAvailableWaiter {
+	options := FileSystemAvailableWaiterOptions{}
+	options.MinDelay = 5 * time.Second
+	options.MaxDelay = 120 * time.Second
+	options.Retryable = fileSystemAvailableStateRetryable
+
+	for _, fn := range optFns {
+		fn(&options)
+	}
+	return &FileSystemAvailableWaiter{
+		client:  client,
+		options: options,
+	}
+}
+
+// Wait calls the waiter function for FileSystemAvailable waiter. The maxWaitDur
+// is the maximum wait duration the waiter will wait. The maxWaitDur is required
+// and must be greater than zero.
+func (w *FileSystemAvailableWaiter) Wait(ctx context.Context, params *DescribeFileSystemsInput, maxWaitDur time.Duration, optFns ...func(*FileSystemAvailableWaiterOptions)) error {
+	options := w.options
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	if options.MaxDelay <= 0 {
+		options.MaxDelay = 120 * time.Second
+	}
+
+	return waiter.Wait(ctx, waiter.Options{
+		Name:     "FileSystemAvailable",
+		MinDelay: options.MinDelay,
+		MaxDelay: options.MaxDelay,
+		MaxWait:  maxWaitDur,
+	}, func(out interface{}, err error) (bool, error) {
+		retryable, err := options.Retryable(ctx, params, out.(*DescribeFileSystemsOutput), err)
+		return !retryable, err
+	}, func(ctx context.Context) (interface{}, error) {
+		return w.client.DescribeFileSystems(ctx, params, func(o *Options) {
+			o.APIOptions = append(o.APIOptions, options.APIOptions...)
+		})
+	})
+}
+
+func fileSystemAvailableStateRetryable(ctx context.Context, input *DescribeFileSystemsInput, output *DescribeFileSystemsOutput, err error) (bool, error) {
+	if err != nil {
+		var notFound *types.FileSystemNotFound
+		if errors.As(err, &notFound) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	if len(output.FileSystems) == 0 {
+		return true, nil
+	}
+
+	available := true
+	for _, fs := range output.FileSystems {
+		switch fs.LifeCycleState {
+		case types.LifeCycleStateAvailable:
+		case types.LifeCycleStateDeleting, types.LifeCycleStateDeleted:
+			return false, fmt.Errorf("waiter state transitioned to Failure, file system %s",
+				fs.LifeCycleState)
+		default:
+			available = false
+		}
+	}
+
+	return !available, nil
+}
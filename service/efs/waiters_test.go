@@ -0,0 +1,108 @@
+package efs
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/sdk"
+	"github.com/aws/aws-sdk-go-v2/service/efs/types"
+)
+
+type mockDescribeFileSystemsClient struct {
+	states []types.LifeCycleState
+	errs   []error
+	calls  int
+}
+
+func (m *mockDescribeFileSystemsClient) DescribeFileSystems(ctx context.Context, params *DescribeFileSystemsInput, optFns ...func(*Options)) (*DescribeFileSystemsOutput, error) {
+	state := m.states[m.calls]
+	var err error
+	if m.calls < len(m.errs) {
+		err = m.errs[m.calls]
+	}
+	m.calls++
+	if err != nil {
+		return nil, err
+	}
+	return &DescribeFileSystemsOutput{
+		FileSystems: []types.FileSystemDescription{
+			{FileSystemId: params.FileSystemId, LifeCycleState: state},
+		},
+	}, nil
+}
+
+func TestFileSystemAvailableWaiter(t *testing.T) {
+	cases := map[string]struct {
+		States      []types.LifeCycleState
+		Errs        []error
+		ExpectCalls int
+		ExpectErr   string
+	}{
+		"creating to available": {
+			States: []types.LifeCycleState{
+				types.LifeCycleStateCreating,
+				types.LifeCycleStateCreating,
+				types.LifeCycleStateAvailable,
+			},
+			ExpectCalls: 3,
+		},
+		"deleted": {
+			States: []types.LifeCycleState{
+				types.LifeCycleStateCreating,
+				types.LifeCycleStateDeleted,
+			},
+			ExpectCalls: 2,
+			ExpectErr:   "transitioned to Failure",
+		},
+		"not found retried": {
+			States: []types.LifeCycleState{
+				"",
+				types.LifeCycleStateAvailable,
+			},
+			Errs: []error{
+				&types.FileSystemNotFound{Message: aws.String("not found")},
+			},
+			ExpectCalls: 2,
+		},
+		"other error": {
+			States: []types.LifeCycleState{
+				"",
+			},
+			Errs: []error{
+				&types.BadRequest{Message: aws.String("bad request")},
+			},
+			ExpectCalls: 1,
+			ExpectErr:   "bad request",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			restoreSleep := sdk.TestingUseNopSleep()
+			defer restoreSleep()
+
+			client := &mockDescribeFileSystemsClient{states: c.States, errs: c.Errs}
+			w := NewFileSystemAvailableWaiter(client)
+
+			fsID := "fs-12345678"
+			err := w.Wait(context.Background(), &DescribeFileSystemsInput{FileSystemId: &fsID}, time.Hour)
+			if len(c.ExpectErr) != 0 {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				if e, a := c.ExpectErr, err.Error(); !strings.Contains(a, e) {
+					t.Errorf("expect error to contain %v, got %v", e, a)
+				}
+			} else if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.ExpectCalls, client.calls; e != a {
+				t.Errorf("expect %v calls, got %v", e, a)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package globalaccelerator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+	"github.com/aws/smithy-go/middleware"
+)
+
+type mockListAcceleratorsPagesClient struct {
+	pages [][]types.Accelerator
+}
+
+func (m *mockListAcceleratorsPagesClient) ListAccelerators(ctx context.Context, params *ListAcceleratorsInput, optFns ...func(*Options)) (*ListAcceleratorsOutput, error) {
+	var idx int
+	if params.NextToken != nil {
+		fmt.Sscanf(*params.NextToken, "%d", &idx)
+	}
+	out := &ListAcceleratorsOutput{Accelerators: m.pages[idx]}
+	out.ResultMetadata.Set("page", idx)
+	if idx+1 < len(m.pages) {
+		out.NextToken = aws.String(fmt.Sprintf("%d", idx+1))
+	}
+	return out, nil
+}
+
+func TestListAcceleratorsPaginator_OnPage(t *testing.T) {
+	client := &mockListAcceleratorsPagesClient{
+		pages: [][]types.Accelerator{{{}, {}}, {}, {{}}},
+	}
+
+	var pageNums, itemCounts, metadataPages []int
+	p := NewListAcceleratorsPaginator(client, &ListAcceleratorsInput{}, func(o *ListAcceleratorsPaginatorOptions) {
+		o.OnPage = func(pageNum int, itemCount int, metadata middleware.Metadata) {
+			pageNums = append(pageNums, pageNum)
+			itemCounts = append(itemCounts, itemCount)
+			metadataPages = append(metadataPages, metadata.Get("page").(int))
+		}
+	})
+	for p.HasMorePages() {
+		if _, err := p.NextPage(context.Background()); err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+	}
+
+	if e, a := []int{1, 2, 3}, pageNums; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v page numbers, got %v", e, a)
+	}
+	if e, a := []int{2, 0, 1}, itemCounts; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v item counts, got %v", e, a)
+	}
+	if e, a := []int{0, 1, 2}, metadataPages; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v page metadata, got %v", e, a)
+	}
+}
@@ -111,8 +111,20 @@ func (m *ResolveEndpoint) HandleSerialize(ctx context.Context, in middleware.Ser
 	return next.HandleSerialize(ctx, in)
 }
 func addResolveEndpointMiddleware(stack *middleware.Stack, o Options) error {
+	resolver := o.EndpointResolver
+	if o.EndpointResolverFunc != nil {
+		resolver = withOperationEndpointResolver(stack.ID(), o.EndpointResolverFunc, resolver)
+	} else if _, ok := resolver.(*internalendpoints.Resolver); ok {
+		// The endpoint URL from the environment overrides the default endpoint
+		// resolver, but not an endpoint resolver configured by the caller.
+		if endpointURL := aws.ServiceEndpointURLFromEnv(ServiceID); len(endpointURL) != 0 {
+			resolver = EndpointResolverFromURL(endpointURL, func(e *aws.Endpoint) {
+				e.HostnameImmutable = true
+			})
+		}
+	}
 	return stack.Serialize.Insert(&ResolveEndpoint{
-		Resolver: o.EndpointResolver,
+		Resolver: resolver,
 		Options:  o.EndpointOptions,
 	}, "OperationSerializer", middleware.Before)
 }
@@ -157,3 +169,27 @@ func withEndpointResolver(awsResolver aws.EndpointResolver, fallbackResolver End
 		resolver:    fallbackResolver,
 	}
 }
+
+// withOperationEndpointResolver returns an EndpointResolver that first delegates
+// endpoint resolution to the operation resolver fn. If fn returns
+// aws.EndpointNotFoundError error, the resolver will use the provided
+// fallbackResolver for resolution. The endpoint's signing region defaults to the
+// region if not set by fn.
+func withOperationEndpointResolver(operation string, fn func(operation, region string) (aws.Endpoint, error), fallbackResolver EndpointResolver) EndpointResolver {
+	return EndpointResolverFunc(func(region string, options EndpointResolverOptions) (aws.Endpoint, error) {
+		endpoint, err := fn(operation, region)
+		if err == nil {
+			if len(endpoint.SigningRegion) == 0 {
+				endpoint.SigningRegion = region
+			}
+			return endpoint, nil
+		}
+		if nf := (&aws.EndpointNotFoundError{}); !errors.As(err, &nf) {
+			return endpoint, err
+		}
+		if fallbackResolver == nil {
+			return endpoint, fmt.Errorf("default endpoint resolver provided was nil")
+		}
+		return fallbackResolver.ResolveEndpoint(region, options)
+	})
+}
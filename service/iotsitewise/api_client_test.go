@@ -0,0 +1,268 @@
+package iotsitewise
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting/unit"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestClient_EndpointResolverFunc(t *testing.T) {
+	cases := map[string]struct {
+		Endpoint   aws.Endpoint
+		Invoke     func(*Client) error
+		ExpectHost string
+	}{
+		"prefix applied after override": {
+			Endpoint: aws.Endpoint{URL: "https://sitewise.vpce.example.com"},
+			Invoke: func(c *Client) error {
+				_, err := c.DescribeAsset(context.Background(), &DescribeAssetInput{
+					AssetId: aws.String("a1b2c3d4-5678-90ab-cdef-111111111111"),
+				})
+				return err
+			},
+			ExpectHost: "model.sitewise.vpce.example.com",
+		},
+		"immutable hostname": {
+			Endpoint: aws.Endpoint{
+				URL:               "https://sitewise.vpce.example.com",
+				HostnameImmutable: true,
+			},
+			Invoke: func(c *Client) error {
+				_, err := c.DescribeAsset(context.Background(), &DescribeAssetInput{
+					AssetId: aws.String("a1b2c3d4-5678-90ab-cdef-111111111111"),
+				})
+				return err
+			},
+			ExpectHost: "sitewise.vpce.example.com",
+		},
+		"default operation": {
+			Endpoint: aws.Endpoint{URL: "https://sitewise.vpce.example.com"},
+			Invoke: func(c *Client) error {
+				_, err := c.ListAssets(context.Background(), &ListAssetsInput{})
+				return err
+			},
+			ExpectHost: "model.iotsitewise.us-west-2.amazonaws.com",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var host string
+			client := New(Options{
+				Region:      "us-west-2",
+				Credentials: unit.StubCredentialsProvider{},
+				EndpointResolverFunc: func(operation, region string) (aws.Endpoint, error) {
+					if operation != "DescribeAsset" {
+						return aws.Endpoint{}, &aws.EndpointNotFoundError{}
+					}
+					return c.Endpoint, nil
+				},
+				HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+					host = r.URL.Host
+					return &http.Response{
+						StatusCode: 200,
+						Header:     http.Header{},
+						Body:       ioutil.NopCloser(strings.NewReader(`{}`)),
+					}, nil
+				}),
+			})
+
+			if err := c.Invoke(client); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectHost, host; e != a {
+				t.Errorf("expect %v host, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestClient_MaxConcurrentRequests(t *testing.T) {
+	const max = 2
+
+	started := make(chan struct{})
+	unblock := make(chan struct{})
+	client := New(Options{
+		Region:                "us-west-2",
+		Credentials:           unit.StubCredentialsProvider{},
+		MaxConcurrentRequests: max,
+		HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			started <- struct{}{}
+			<-unblock
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(strings.NewReader(`{}`)),
+			}, nil
+		}),
+	})
+
+	done := make(chan error, max+1)
+	for i := 0; i < max+1; i++ {
+		go func() {
+			_, err := client.DescribeAsset(context.Background(), &DescribeAssetInput{
+				AssetId: aws.String("a1b2c3d4-5678-90ab-cdef-111111111111"),
+			})
+			done <- err
+		}()
+	}
+
+	for i := 0; i < max; i++ {
+		select {
+		case <-started:
+		case <-time.After(time.Second):
+			t.Fatalf("expect %v requests to be sent", max)
+		}
+	}
+
+	// The request over the limit waits for one of the others to complete.
+	select {
+	case <-started:
+		t.Fatalf("expect request over the limit to wait")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	unblock <- struct{}{}
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatalf("expect waiting request to be sent once another completes")
+	}
+	close(unblock)
+
+	for i := 0; i < max+1; i++ {
+		if err := <-done; err != nil {
+			t.Errorf("expect no error, got %v", err)
+		}
+	}
+}
+
+func TestClient_EndpointURLFromEnv(t *testing.T) {
+	cases := map[string]struct {
+		EnvURL           string
+		EndpointResolver EndpointResolver
+		ExpectHost       string
+	}{
+		"no override": {
+			ExpectHost: "model.iotsitewise.us-west-2.amazonaws.com",
+		},
+		"override skips host prefix": {
+			EnvURL:     "http://localhost:4566",
+			ExpectHost: "localhost:4566",
+		},
+		"endpoint resolver not overridden": {
+			EnvURL:           "http://localhost:4566",
+			EndpointResolver: EndpointResolverFromURL("https://sitewise.vpce.example.com"),
+			ExpectHost:       "model.sitewise.vpce.example.com",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			restoreEnv := awstesting.StashEnv()
+			defer awstesting.PopEnv(restoreEnv)
+			if len(c.EnvURL) != 0 {
+				os.Setenv("AWS_ENDPOINT_URL_IOTSITEWISE", c.EnvURL)
+			}
+
+			var host string
+			client := New(Options{
+				Region:           "us-west-2",
+				Credentials:      unit.StubCredentialsProvider{},
+				EndpointResolver: c.EndpointResolver,
+				HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+					host = r.URL.Host
+					return &http.Response{
+						StatusCode: 200,
+						Header:     http.Header{},
+						Body:       ioutil.NopCloser(strings.NewReader(`{}`)),
+					}, nil
+				}),
+			})
+
+			_, err := client.DescribeAsset(context.Background(), &DescribeAssetInput{
+				AssetId: aws.String("a1b2c3d4-5678-90ab-cdef-111111111111"),
+			})
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectHost, host; e != a {
+				t.Errorf("expect %v host, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestClient_DisableMiddleware(t *testing.T) {
+	cases := map[string]struct {
+		Disable    []string
+		ExpectHost string
+	}{
+		"enabled": {
+			ExpectHost: "model.sitewise.example.com",
+		},
+		"host prefix disabled": {
+			Disable:    []string{"EndpointHostPrefix"},
+			ExpectHost: "sitewise.example.com",
+		},
+		"other middleware disabled": {
+			Disable:    []string{"UserAgent"},
+			ExpectHost: "model.sitewise.example.com",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var host string
+			client := New(Options{
+				Region:      "us-west-2",
+				Credentials: unit.StubCredentialsProvider{},
+				EndpointResolver: EndpointResolverFunc(func(region string, options EndpointResolverOptions) (aws.Endpoint, error) {
+					return aws.Endpoint{URL: "https://sitewise.example.com"}, nil
+				}),
+				HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+					host = r.URL.Host
+					return &http.Response{
+						StatusCode: 200,
+						Header:     http.Header{},
+						Body:       ioutil.NopCloser(strings.NewReader(`{}`)),
+					}, nil
+				}),
+			})
+
+			ctx := context.Background()
+			for _, id := range c.Disable {
+				ctx = awsmiddleware.DisableMiddleware(ctx, id)
+			}
+			_, err := client.DescribeAsset(ctx, &DescribeAssetInput{
+				AssetId: aws.String("a1b2c3d4-5678-90ab-cdef-111111111111"),
+			})
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectHost, host; e != a {
+				t.Errorf("expect %v host, got %v", e, a)
+			}
+
+			// The middleware is only disabled for calls with the context.
+			if _, err := client.DescribeAsset(context.Background(), &DescribeAssetInput{
+				AssetId: aws.String("a1b2c3d4-5678-90ab-cdef-111111111111"),
+			}); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := "model.sitewise.example.com", host; e != a {
+				t.Errorf("expect %v host, got %v", e, a)
+			}
+		})
+	}
+}
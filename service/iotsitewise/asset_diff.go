@@ -0,0 +1,119 @@
+package iotsitewise
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotsitewise/types"
+)
+
+// AssetPropertyChange is an asset property whose definition differs between
+// two descriptions of an asset.
+type AssetPropertyChange struct {
+	Old types.AssetProperty
+	New types.AssetProperty
+}
+
+// AssetHierarchyChange is an asset hierarchy whose definition differs
+// between two descriptions of an asset.
+type AssetHierarchyChange struct {
+	Old types.AssetHierarchy
+	New types.AssetHierarchy
+}
+
+// AssetDiff is the difference between two descriptions of an asset, as
+// returned by DiffAssets. Properties and hierarchies are matched by their
+// ID, and each list is sorted by ID.
+type AssetDiff struct {
+	AddedProperties   []types.AssetProperty
+	RemovedProperties []types.AssetProperty
+	ChangedProperties []AssetPropertyChange
+
+	AddedHierarchies   []types.AssetHierarchy
+	RemovedHierarchies []types.AssetHierarchy
+	ChangedHierarchies []AssetHierarchyChange
+}
+
+// IsEmpty returns whether the descriptions of the asset have no differences
+// in their properties or hierarchies.
+func (d AssetDiff) IsEmpty() bool {
+	return len(d.AddedProperties) == 0 && len(d.RemovedProperties) == 0 && len(d.ChangedProperties) == 0 &&
+		len(d.AddedHierarchies) == 0 && len(d.RemovedHierarchies) == 0 && len(d.ChangedHierarchies) == 0
+}
+
+// DiffAssets returns the properties and hierarchies added, removed, and
+// changed between the descriptions a and b of an asset, such as those
+// returned by DescribeAsset before and after the asset was updated. A nil
+// description is treated as an asset without properties or hierarchies.
+// The order of the properties and hierarchies within the descriptions is
+// ignored.
+func DiffAssets(a, b *DescribeAssetOutput) AssetDiff {
+	if a == nil {
+		a = &DescribeAssetOutput{}
+	}
+	if b == nil {
+		b = &DescribeAssetOutput{}
+	}
+
+	var diff AssetDiff
+
+	propIDs := map[string]struct{}{}
+	oldProps := make(map[string]types.AssetProperty, len(a.AssetProperties))
+	for _, p := range a.AssetProperties {
+		oldProps[aws.ToString(p.Id)] = p
+		propIDs[aws.ToString(p.Id)] = struct{}{}
+	}
+	newProps := make(map[string]types.AssetProperty, len(b.AssetProperties))
+	for _, p := range b.AssetProperties {
+		newProps[aws.ToString(p.Id)] = p
+		propIDs[aws.ToString(p.Id)] = struct{}{}
+	}
+	for _, id := range sortedIDs(propIDs) {
+		o, inOld := oldProps[id]
+		n, inNew := newProps[id]
+		switch {
+		case !inOld:
+			diff.AddedProperties = append(diff.AddedProperties, n)
+		case !inNew:
+			diff.RemovedProperties = append(diff.RemovedProperties, o)
+		case !reflect.DeepEqual(o, n):
+			diff.ChangedProperties = append(diff.ChangedProperties, AssetPropertyChange{Old: o, New: n})
+		}
+	}
+
+	hierarchyIDs := map[string]struct{}{}
+	oldHierarchies := make(map[string]types.AssetHierarchy, len(a.AssetHierarchies))
+	for _, h := range a.AssetHierarchies {
+		oldHierarchies[aws.ToString(h.Id)] = h
+		hierarchyIDs[aws.ToString(h.Id)] = struct{}{}
+	}
+	newHierarchies := make(map[string]types.AssetHierarchy, len(b.AssetHierarchies))
+	for _, h := range b.AssetHierarchies {
+		newHierarchies[aws.ToString(h.Id)] = h
+		hierarchyIDs[aws.ToString(h.Id)] = struct{}{}
+	}
+	for _, id := range sortedIDs(hierarchyIDs) {
+		o, inOld := oldHierarchies[id]
+		n, inNew := newHierarchies[id]
+		switch {
+		case !inOld:
+			diff.AddedHierarchies = append(diff.AddedHierarchies, n)
+		case !inNew:
+			diff.RemovedHierarchies = append(diff.RemovedHierarchies, o)
+		case !reflect.DeepEqual(o, n):
+			diff.ChangedHierarchies = append(diff.ChangedHierarchies, AssetHierarchyChange{Old: o, New: n})
+		}
+	}
+
+	return diff
+}
+
+func sortedIDs(set map[string]struct{}) []string {
+	ids := make([]string, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
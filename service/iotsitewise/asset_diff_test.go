@@ -0,0 +1,96 @@
+package iotsitewise
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotsitewise/types"
+)
+
+func TestDiffAssets(t *testing.T) {
+	temperature := types.AssetProperty{
+		Id:       aws.String("prop-1"),
+		Name:     aws.String("Temperature"),
+		DataType: types.PropertyDataTypeDouble,
+	}
+	temperatureRenamed := types.AssetProperty{
+		Id:       aws.String("prop-1"),
+		Name:     aws.String("Ambient Temperature"),
+		DataType: types.PropertyDataTypeDouble,
+	}
+	pressure := types.AssetProperty{
+		Id:       aws.String("prop-2"),
+		Name:     aws.String("Pressure"),
+		DataType: types.PropertyDataTypeDouble,
+	}
+	sensors := types.AssetHierarchy{Id: aws.String("hier-1"), Name: aws.String("Sensors")}
+	pumps := types.AssetHierarchy{Id: aws.String("hier-2"), Name: aws.String("Pumps")}
+
+	cases := map[string]struct {
+		A, B   *DescribeAssetOutput
+		Expect AssetDiff
+	}{
+		"both nil": {},
+		"unchanged unordered": {
+			A: &DescribeAssetOutput{
+				AssetProperties:  []types.AssetProperty{temperature, pressure},
+				AssetHierarchies: []types.AssetHierarchy{sensors, pumps},
+			},
+			B: &DescribeAssetOutput{
+				AssetProperties:  []types.AssetProperty{pressure, temperature},
+				AssetHierarchies: []types.AssetHierarchy{pumps, sensors},
+			},
+		},
+		"property renamed": {
+			A: &DescribeAssetOutput{AssetProperties: []types.AssetProperty{temperature, pressure}},
+			B: &DescribeAssetOutput{AssetProperties: []types.AssetProperty{pressure, temperatureRenamed}},
+			Expect: AssetDiff{
+				ChangedProperties: []AssetPropertyChange{{Old: temperature, New: temperatureRenamed}},
+			},
+		},
+		"hierarchy added": {
+			A: &DescribeAssetOutput{AssetHierarchies: []types.AssetHierarchy{sensors}},
+			B: &DescribeAssetOutput{AssetHierarchies: []types.AssetHierarchy{pumps, sensors}},
+			Expect: AssetDiff{
+				AddedHierarchies: []types.AssetHierarchy{pumps},
+			},
+		},
+		"property added and removed": {
+			A: &DescribeAssetOutput{AssetProperties: []types.AssetProperty{temperature}},
+			B: &DescribeAssetOutput{AssetProperties: []types.AssetProperty{pressure}},
+			Expect: AssetDiff{
+				AddedProperties:   []types.AssetProperty{pressure},
+				RemovedProperties: []types.AssetProperty{temperature},
+			},
+		},
+		"nil old": {
+			B: &DescribeAssetOutput{
+				AssetProperties:  []types.AssetProperty{pressure, temperature},
+				AssetHierarchies: []types.AssetHierarchy{pumps},
+			},
+			Expect: AssetDiff{
+				AddedProperties:  []types.AssetProperty{temperature, pressure},
+				AddedHierarchies: []types.AssetHierarchy{pumps},
+			},
+		},
+		"nil new": {
+			A: &DescribeAssetOutput{AssetHierarchies: []types.AssetHierarchy{sensors}},
+			Expect: AssetDiff{
+				RemovedHierarchies: []types.AssetHierarchy{sensors},
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			diff := DiffAssets(c.A, c.B)
+			if e, a := c.Expect, diff; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %+v diff, got %+v", e, a)
+			}
+			if e, a := reflect.DeepEqual(c.Expect, AssetDiff{}), diff.IsEmpty(); e != a {
+				t.Errorf("expect %v empty, got %v", e, a)
+			}
+		})
+	}
+}
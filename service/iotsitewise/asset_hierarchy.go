@@ -0,0 +1,135 @@
+package iotsitewise
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotsitewise/types"
+)
+
+// DefaultWalkAssetHierarchyMaxDepth is the default maximum depth of child
+// assets WalkAssetHierarchy will descend to below the root asset.
+const DefaultWalkAssetHierarchyMaxDepth = 30
+
+// SkipAssetChildren can be returned by the visit function of
+// WalkAssetHierarchy to skip walking the children of the visited asset.
+var SkipAssetChildren = errors.New("skip asset children")
+
+// WalkAssetHierarchyAPIClient is a client that implements the DescribeAsset
+// and ListAssociatedAssets operations.
+type WalkAssetHierarchyAPIClient interface {
+	DescribeAssetAPIClient
+	ListAssociatedAssetsAPIClient
+}
+
+var _ WalkAssetHierarchyAPIClient = (*Client)(nil)
+
+// WalkAssetHierarchyOptions provides the options for WalkAssetHierarchy.
+type WalkAssetHierarchyOptions struct {
+	// The maximum depth below the root asset that will be walked. The root
+	// asset is depth 0. If zero, DefaultWalkAssetHierarchyMaxDepth will be
+	// used.
+	MaxDepth int
+
+	// The functional options passed to each operation invoked.
+	ClientOptions []func(*Options)
+}
+
+// WalkAssetHierarchy walks the asset hierarchy rooted at rootAssetID depth
+// first, calling visit with the description of each asset and its depth below
+// the root asset. The child assets of each of the asset's hierarchies are
+// listed with ListAssociatedAssets, and described with DescribeAsset.
+//
+// Each asset is visited at most once, even if an asset is reachable through
+// multiple hierarchies. If visit returns SkipAssetChildren the children of
+// that asset are not walked. Any other error returned by visit stops the walk
+// and is returned.
+func WalkAssetHierarchy(ctx context.Context, client WalkAssetHierarchyAPIClient, rootAssetID string, visit func(depth int, out *DescribeAssetOutput) error, optFns ...func(*WalkAssetHierarchyOptions)) error {
+	options := WalkAssetHierarchyOptions{
+		MaxDepth: DefaultWalkAssetHierarchyMaxDepth,
+	}
+	for _, fn := range optFns {
+		fn(&options)
+	}
+	if options.MaxDepth <= 0 {
+		options.MaxDepth = DefaultWalkAssetHierarchyMaxDepth
+	}
+
+	w := assetHierarchyWalker{
+		client:  client,
+		options: options,
+		visit:   visit,
+		visited: map[string]struct{}{},
+	}
+	return w.walk(ctx, rootAssetID, 0)
+}
+
+type assetHierarchyWalker struct {
+	client  WalkAssetHierarchyAPIClient
+	options WalkAssetHierarchyOptions
+	visit   func(int, *DescribeAssetOutput) error
+	visited map[string]struct{}
+}
+
+func (w *assetHierarchyWalker) walk(ctx context.Context, assetID string, depth int) error {
+	if _, ok := w.visited[assetID]; ok {
+		return nil
+	}
+	w.visited[assetID] = struct{}{}
+
+	out, err := w.client.DescribeAsset(ctx, &DescribeAssetInput{
+		AssetId: aws.String(assetID),
+	}, w.options.ClientOptions...)
+	if err != nil {
+		return fmt.Errorf("failed to describe asset %s, %w", assetID, err)
+	}
+
+	if err := w.visit(depth, out); err != nil {
+		if err == SkipAssetChildren {
+			return nil
+		}
+		return err
+	}
+
+	if depth >= w.options.MaxDepth {
+		return nil
+	}
+
+	for _, h := range out.AssetHierarchies {
+		children, err := w.listChildren(ctx, assetID, aws.ToString(h.Id))
+		if err != nil {
+			return err
+		}
+		for _, childID := range children {
+			if err := w.walk(ctx, childID, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (w *assetHierarchyWalker) listChildren(ctx context.Context, assetID, hierarchyID string) ([]string, error) {
+	var children []string
+
+	p := NewListAssociatedAssetsPaginator(w.client, &ListAssociatedAssetsInput{
+		AssetId:            aws.String(assetID),
+		HierarchyId:        aws.String(hierarchyID),
+		TraversalDirection: types.TraversalDirectionChild,
+	})
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx, w.options.ClientOptions...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list child assets of asset %s hierarchy %s, %w",
+				assetID, hierarchyID, err)
+		}
+		for _, s := range page.AssetSummaries {
+			children = append(children, aws.ToString(s.Id))
+		}
+	}
+
+	return children, nil
+}
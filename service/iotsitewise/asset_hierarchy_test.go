@@ -0,0 +1,150 @@
+package iotsitewise
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotsitewise/types"
+)
+
+// mockAssetHierarchyClient models each asset as having a single hierarchy
+// whose ID is the asset ID prefixed with "h-".
+type mockAssetHierarchyClient struct {
+	children  map[string][]string
+	described []string
+}
+
+func (m *mockAssetHierarchyClient) DescribeAsset(ctx context.Context, params *DescribeAssetInput, optFns ...func(*Options)) (*DescribeAssetOutput, error) {
+	id := aws.ToString(params.AssetId)
+	m.described = append(m.described, id)
+
+	out := &DescribeAssetOutput{AssetId: params.AssetId, AssetName: aws.String("name-" + id)}
+	if _, ok := m.children[id]; ok {
+		out.AssetHierarchies = []types.AssetHierarchy{{Id: aws.String("h-" + id)}}
+	}
+	return out, nil
+}
+
+func (m *mockAssetHierarchyClient) ListAssociatedAssets(ctx context.Context, params *ListAssociatedAssetsInput, optFns ...func(*Options)) (*ListAssociatedAssetsOutput, error) {
+	id := aws.ToString(params.AssetId)
+	if e, a := "h-"+id, aws.ToString(params.HierarchyId); e != a {
+		return nil, fmt.Errorf("expect %v hierarchy, got %v", e, a)
+	}
+	if e, a := types.TraversalDirectionChild, params.TraversalDirection; e != a {
+		return nil, fmt.Errorf("expect %v traversal direction, got %v", e, a)
+	}
+
+	// Return one child per page.
+	var idx int
+	if params.NextToken != nil {
+		fmt.Sscanf(*params.NextToken, "%d", &idx)
+	}
+	children := m.children[id]
+	out := &ListAssociatedAssetsOutput{}
+	if idx < len(children) {
+		out.AssetSummaries = []types.AssociatedAssetsSummary{{Id: aws.String(children[idx])}}
+	}
+	if idx+1 < len(children) {
+		out.NextToken = aws.String(fmt.Sprintf("%d", idx+1))
+	}
+	return out, nil
+}
+
+func TestWalkAssetHierarchy(t *testing.T) {
+	// root
+	// ├── a
+	// │   ├── a1
+	// │   └── a2
+	// └── b
+	//     └── b1 -> root (cycle)
+	hierarchy := map[string][]string{
+		"root": {"a", "b"},
+		"a":    {"a1", "a2"},
+		"b":    {"b1"},
+		"b1":   {"root"},
+	}
+
+	cases := map[string]struct {
+		MaxDepth       int
+		Skip           string
+		ExpectVisited  []string
+		ExpectDepths   []int
+		ExpectDescribe []string
+	}{
+		"full walk": {
+			ExpectVisited: []string{"root", "a", "a1", "a2", "b", "b1"},
+			ExpectDepths:  []int{0, 1, 2, 2, 1, 2},
+		},
+		"max depth": {
+			MaxDepth:      1,
+			ExpectVisited: []string{"root", "a", "b"},
+			ExpectDepths:  []int{0, 1, 1},
+		},
+		"skip children": {
+			Skip:          "a",
+			ExpectVisited: []string{"root", "a", "b", "b1"},
+			ExpectDepths:  []int{0, 1, 1, 2},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockAssetHierarchyClient{children: hierarchy}
+
+			var visited []string
+			var depths []int
+			err := WalkAssetHierarchy(context.Background(), client, "root",
+				func(depth int, out *DescribeAssetOutput) error {
+					id := aws.ToString(out.AssetId)
+					visited = append(visited, id)
+					depths = append(depths, depth)
+					if id == c.Skip {
+						return SkipAssetChildren
+					}
+					return nil
+				},
+				func(o *WalkAssetHierarchyOptions) {
+					o.MaxDepth = c.MaxDepth
+				})
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.ExpectVisited, visited; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v visited, got %v", e, a)
+			}
+			if e, a := c.ExpectDepths, depths; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v depths, got %v", e, a)
+			}
+			if e, a := c.ExpectVisited, client.described; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v described once each, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestWalkAssetHierarchy_VisitError(t *testing.T) {
+	client := &mockAssetHierarchyClient{children: map[string][]string{
+		"root": {"a", "b"},
+	}}
+
+	visitErr := fmt.Errorf("visit failed")
+	var visited []string
+	err := WalkAssetHierarchy(context.Background(), client, "root",
+		func(depth int, out *DescribeAssetOutput) error {
+			visited = append(visited, aws.ToString(out.AssetId))
+			if aws.ToString(out.AssetId) == "a" {
+				return visitErr
+			}
+			return nil
+		})
+	if e, a := visitErr, err; e != a {
+		t.Fatalf("expect %v error, got %v", e, a)
+	}
+	if e, a := []string{"root", "a"}, visited; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v visited, got %v", e, a)
+	}
+}
@@ -0,0 +1,70 @@
+package iotsitewise
+
+import (
+	"context"
+
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// HostPrefixMode is the mode the client applies the host prefix of an
+// operation's endpoint with, such as the "model." prefix of DescribeAsset.
+type HostPrefixMode int
+
+// Enumerations of the HostPrefixMode
+const (
+	// The host prefix is applied unless the endpoint's hostname is immutable,
+	// or the host prefix is disabled for the operation with
+	// smithyhttp.DisableEndpointHostPrefix.
+	HostPrefixModeAuto HostPrefixMode = iota
+
+	// The host prefix is always applied, even if the endpoint's hostname is
+	// immutable.
+	HostPrefixModeAlways
+
+	// The host prefix is never applied.
+	HostPrefixModeNever
+)
+
+// addHostPrefixMode adds the middleware applying the client's HostPrefixMode to
+// the operations with a host prefix. The middleware is not added for
+// HostPrefixModeAuto.
+func addHostPrefixMode(stack *middleware.Stack, o Options) error {
+	if o.HostPrefixMode == HostPrefixModeAuto {
+		return nil
+	}
+	// The host prefix middleware of every operation with a host prefix has the
+	// same ID.
+	const endpointHostPrefixID = "EndpointHostPrefix"
+	if _, ok := stack.Serialize.Get(endpointHostPrefixID); !ok {
+		return nil
+	}
+	return stack.Serialize.Insert(&hostPrefixMode{mode: o.HostPrefixMode}, endpointHostPrefixID, middleware.Before)
+}
+
+// hostPrefixMode sets whether the operation's host prefix will be applied by
+// the operation's host prefix middleware.
+type hostPrefixMode struct {
+	mode HostPrefixMode
+}
+
+// ID returns the id of the middleware
+func (*hostPrefixMode) ID() string {
+	return "HostPrefixMode"
+}
+
+// HandleSerialize implements the SerializeMiddleware interface
+func (m *hostPrefixMode) HandleSerialize(
+	ctx context.Context, in middleware.SerializeInput, next middleware.SerializeHandler,
+) (
+	out middleware.SerializeOutput, metadata middleware.Metadata, err error,
+) {
+	switch m.mode {
+	case HostPrefixModeAlways:
+		ctx = smithyhttp.SetHostnameImmutable(ctx, false)
+		ctx = smithyhttp.DisableEndpointHostPrefix(ctx, false)
+	case HostPrefixModeNever:
+		ctx = smithyhttp.DisableEndpointHostPrefix(ctx, true)
+	}
+	return next.HandleSerialize(ctx, in)
+}
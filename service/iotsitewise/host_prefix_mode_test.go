@@ -0,0 +1,79 @@
+package iotsitewise
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting/unit"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestClient_HostPrefixMode(t *testing.T) {
+	cases := map[string]struct {
+		Mode              HostPrefixMode
+		HostnameImmutable bool
+		ExpectHost        string
+	}{
+		"auto": {
+			Mode:       HostPrefixModeAuto,
+			ExpectHost: "model.sitewise.example.com",
+		},
+		"auto immutable hostname": {
+			Mode:              HostPrefixModeAuto,
+			HostnameImmutable: true,
+			ExpectHost:        "sitewise.example.com",
+		},
+		"always": {
+			Mode:       HostPrefixModeAlways,
+			ExpectHost: "model.sitewise.example.com",
+		},
+		"always immutable hostname": {
+			Mode:              HostPrefixModeAlways,
+			HostnameImmutable: true,
+			ExpectHost:        "model.sitewise.example.com",
+		},
+		"never": {
+			Mode:       HostPrefixModeNever,
+			ExpectHost: "sitewise.example.com",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var host string
+			client := New(Options{
+				Region:      "us-west-2",
+				Credentials: unit.StubCredentialsProvider{},
+				EndpointResolver: EndpointResolverFunc(func(region string, options EndpointResolverOptions) (aws.Endpoint, error) {
+					return aws.Endpoint{
+						URL:               "https://sitewise.example.com",
+						HostnameImmutable: c.HostnameImmutable,
+					}, nil
+				}),
+				HostPrefixMode: c.Mode,
+				HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+					host = r.URL.Host
+					return &http.Response{
+						StatusCode: 200,
+						Header:     http.Header{},
+						Body:       ioutil.NopCloser(strings.NewReader(`{}`)),
+					}, nil
+				}),
+			})
+
+			_, err := client.DescribeAsset(context.Background(), &DescribeAssetInput{
+				AssetId: aws.String("a1b2c3d4-5678-90ab-cdef-111111111111"),
+			})
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectHost, host; e != a {
+				t.Errorf("expect %v host, got %v", e, a)
+			}
+		})
+	}
+}
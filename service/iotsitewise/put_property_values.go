@@ -0,0 +1,158 @@
+package iotsitewise
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotsitewise/types"
+)
+
+const (
+	// MaxBatchPutAssetPropertyValueEntries is the maximum number of entries
+	// that can be put by a single BatchPutAssetPropertyValue request.
+	MaxBatchPutAssetPropertyValueEntries = 10
+
+	// MaxPutAssetPropertyValues is the maximum number of property values of a
+	// single BatchPutAssetPropertyValue entry.
+	MaxPutAssetPropertyValues = 10
+)
+
+// BatchPutAssetPropertyValueAPIClient is a client that implements the
+// BatchPutAssetPropertyValue operation.
+type BatchPutAssetPropertyValueAPIClient interface {
+	BatchPutAssetPropertyValue(context.Context, *BatchPutAssetPropertyValueInput, ...func(*Options)) (*BatchPutAssetPropertyValueOutput, error)
+}
+
+var _ BatchPutAssetPropertyValueAPIClient = (*Client)(nil)
+
+// PutAssetPropertyValuesOptions provides the options for
+// PutAssetPropertyValues.
+type PutAssetPropertyValuesOptions struct {
+	// The functional options passed to each operation invoked.
+	ClientOptions []func(*Options)
+}
+
+// PropertyValuesFailure describes an entry of property values of a property
+// that could not be put.
+type PropertyValuesFailure struct {
+	// The ID of the property the values are of.
+	PropertyID string
+
+	// The property values of the entry.
+	Values []types.AssetPropertyValue
+
+	// The errors the service returned for the entry, if the entry was
+	// rejected.
+	Errors []types.BatchPutAssetPropertyError
+
+	// The error of the BatchPutAssetPropertyValue request the entry was sent
+	// in, if the request failed.
+	Err error
+}
+
+// PutAssetPropertyValuesError is returned by PutAssetPropertyValues when one
+// or more entries of property values could not be put.
+type PutAssetPropertyValuesError struct {
+	// The entries that could not be put, ordered by property ID.
+	Failures []PropertyValuesFailure
+}
+
+func (e *PutAssetPropertyValuesError) Error() string {
+	var msgs []string
+	for _, f := range e.Failures {
+		if f.Err != nil {
+			msgs = append(msgs, fmt.Sprintf("property %s: %v", f.PropertyID, f.Err))
+			continue
+		}
+		for _, entryErr := range f.Errors {
+			msgs = append(msgs, fmt.Sprintf("property %s: %s: %s", f.PropertyID,
+				entryErr.ErrorCode, aws.ToString(entryErr.ErrorMessage)))
+		}
+	}
+	return fmt.Sprintf("failed to put %d entry(s) of property values, %s", len(e.Failures), strings.Join(msgs, "; "))
+}
+
+// PutAssetPropertyValues puts the values of the properties of the asset,
+// keyed by property ID, with BatchPutAssetPropertyValue. The values of each
+// property are split into entries of up to MaxPutAssetPropertyValues values,
+// which are sent in requests of up to MaxBatchPutAssetPropertyValueEntries
+// entries, one request at a time.
+//
+// Every request is sent, even if an earlier request fails. If any entry is
+// rejected by the service, or is sent in a request that fails, a
+// *PutAssetPropertyValuesError describing each such entry is returned.
+func PutAssetPropertyValues(ctx context.Context, client BatchPutAssetPropertyValueAPIClient, assetID string, values map[string][]types.AssetPropertyValue, optFns ...func(*PutAssetPropertyValuesOptions)) error {
+	var options PutAssetPropertyValuesOptions
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	propertyIDs := make([]string, 0, len(values))
+	for id := range values {
+		propertyIDs = append(propertyIDs, id)
+	}
+	sort.Strings(propertyIDs)
+
+	// The entry IDs are the index of the entry within entries.
+	var entries []types.PutAssetPropertyValueEntry
+	var entryPropertyIDs []string
+	for _, id := range propertyIDs {
+		vs := values[id]
+		for start := 0; start < len(vs); start += MaxPutAssetPropertyValues {
+			end := start + MaxPutAssetPropertyValues
+			if end > len(vs) {
+				end = len(vs)
+			}
+			entries = append(entries, types.PutAssetPropertyValueEntry{
+				EntryId:        aws.String(strconv.Itoa(len(entries))),
+				AssetId:        aws.String(assetID),
+				PropertyId:     aws.String(id),
+				PropertyValues: vs[start:end],
+			})
+			entryPropertyIDs = append(entryPropertyIDs, id)
+		}
+	}
+
+	var failures []PropertyValuesFailure
+	for start := 0; start < len(entries); start += MaxBatchPutAssetPropertyValueEntries {
+		end := start + MaxBatchPutAssetPropertyValueEntries
+		if end > len(entries) {
+			end = len(entries)
+		}
+
+		out, err := client.BatchPutAssetPropertyValue(ctx, &BatchPutAssetPropertyValueInput{
+			Entries: entries[start:end],
+		}, options.ClientOptions...)
+		if err != nil {
+			for i := start; i < end; i++ {
+				failures = append(failures, PropertyValuesFailure{
+					PropertyID: entryPropertyIDs[i],
+					Values:     entries[i].PropertyValues,
+					Err:        err,
+				})
+			}
+			continue
+		}
+
+		for _, e := range out.ErrorEntries {
+			i, err := strconv.Atoi(aws.ToString(e.EntryId))
+			if err != nil || i < start || i >= end {
+				return fmt.Errorf("unexpected error entry ID %q", aws.ToString(e.EntryId))
+			}
+			failures = append(failures, PropertyValuesFailure{
+				PropertyID: entryPropertyIDs[i],
+				Values:     entries[i].PropertyValues,
+				Errors:     e.Errors,
+			})
+		}
+	}
+
+	if len(failures) != 0 {
+		return &PutAssetPropertyValuesError{Failures: failures}
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package iotsitewise
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotsitewise/types"
+)
+
+type mockBatchPutAssetPropertyValueClient struct {
+	// errors of the request, keyed by request index
+	requestErrs map[int]error
+	// rejected entries, keyed by property ID and entry values offset
+	rejected map[string]int
+
+	inputs []*BatchPutAssetPropertyValueInput
+}
+
+func (m *mockBatchPutAssetPropertyValueClient) BatchPutAssetPropertyValue(ctx context.Context, params *BatchPutAssetPropertyValueInput, optFns ...func(*Options)) (*BatchPutAssetPropertyValueOutput, error) {
+	m.inputs = append(m.inputs, params)
+	if err := m.requestErrs[len(m.inputs)-1]; err != nil {
+		return nil, err
+	}
+
+	out := &BatchPutAssetPropertyValueOutput{}
+	for _, e := range params.Entries {
+		offset, ok := m.rejected[aws.ToString(e.PropertyId)]
+		if !ok || int64(offset) != aws.ToInt64(e.PropertyValues[0].Timestamp.TimeInSeconds) {
+			continue
+		}
+		out.ErrorEntries = append(out.ErrorEntries, types.BatchPutAssetPropertyErrorEntry{
+			EntryId: e.EntryId,
+			Errors: []types.BatchPutAssetPropertyError{{
+				ErrorCode:    types.BatchPutAssetPropertyValueErrorCodeTimestampOutOfRangeException,
+				ErrorMessage: aws.String("timestamp out of range"),
+			}},
+		})
+	}
+	return out, nil
+}
+
+func newTestPropertyValues(n int) []types.AssetPropertyValue {
+	values := make([]types.AssetPropertyValue, n)
+	for i := range values {
+		values[i] = types.AssetPropertyValue{
+			Timestamp: &types.TimeInNanos{TimeInSeconds: aws.Int64(int64(i))},
+			Value:     &types.Variant{DoubleValue: aws.Float64(float64(i))},
+		}
+	}
+	return values
+}
+
+func TestPutAssetPropertyValues(t *testing.T) {
+	client := &mockBatchPutAssetPropertyValueClient{}
+
+	err := PutAssetPropertyValues(context.Background(), client, "asset", map[string][]types.AssetPropertyValue{
+		"prop1": newTestPropertyValues(25),
+		"prop2": newTestPropertyValues(5),
+		"prop3": newTestPropertyValues(80),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := 2, len(client.inputs); e != a {
+		t.Fatalf("expect %v requests, got %v", e, a)
+	}
+	if e, a := 10, len(client.inputs[0].Entries); e != a {
+		t.Errorf("expect %v entries in first request, got %v", e, a)
+	}
+	if e, a := 2, len(client.inputs[1].Entries); e != a {
+		t.Errorf("expect %v entries in second request, got %v", e, a)
+	}
+
+	counts := map[string]int{}
+	entryIDs := map[string]bool{}
+	for _, input := range client.inputs {
+		for _, e := range input.Entries {
+			if e, a := "asset", aws.ToString(e.AssetId); e != a {
+				t.Errorf("expect %v asset, got %v", e, a)
+			}
+			if n := len(e.PropertyValues); n > MaxPutAssetPropertyValues {
+				t.Errorf("expect at most %v values per entry, got %v", MaxPutAssetPropertyValues, n)
+			}
+			if entryIDs[aws.ToString(e.EntryId)] {
+				t.Errorf("expect unique entry IDs, got duplicate %v", aws.ToString(e.EntryId))
+			}
+			entryIDs[aws.ToString(e.EntryId)] = true
+			counts[aws.ToString(e.PropertyId)] += len(e.PropertyValues)
+		}
+	}
+	expect := map[string]int{"prop1": 25, "prop2": 5, "prop3": 80}
+	for id, n := range expect {
+		if e, a := n, counts[id]; e != a {
+			t.Errorf("expect %v values put for %v, got %v", e, id, a)
+		}
+	}
+}
+
+func TestPutAssetPropertyValues_EntryError(t *testing.T) {
+	client := &mockBatchPutAssetPropertyValueClient{
+		// the entry with the third chunk of prop3's values
+		rejected: map[string]int{"prop3": 20},
+	}
+
+	err := PutAssetPropertyValues(context.Background(), client, "asset", map[string][]types.AssetPropertyValue{
+		"prop1": newTestPropertyValues(25),
+		"prop3": newTestPropertyValues(80),
+	})
+
+	var putErr *PutAssetPropertyValuesError
+	if !errors.As(err, &putErr) {
+		t.Fatalf("expect %T error, got %v", putErr, err)
+	}
+	if e, a := 2, len(client.inputs); e != a {
+		t.Errorf("expect %v requests, got %v", e, a)
+	}
+	if e, a := 1, len(putErr.Failures); e != a {
+		t.Fatalf("expect %v failures, got %v", e, a)
+	}
+
+	failure := putErr.Failures[0]
+	if e, a := "prop3", failure.PropertyID; e != a {
+		t.Errorf("expect %v property, got %v", e, a)
+	}
+	if e, a := 10, len(failure.Values); e != a {
+		t.Errorf("expect %v values, got %v", e, a)
+	}
+	if e, a := int64(20), aws.ToInt64(failure.Values[0].Timestamp.TimeInSeconds); e != a {
+		t.Errorf("expect values from %v, got %v", e, a)
+	}
+	if e, a := 1, len(failure.Errors); e != a {
+		t.Fatalf("expect %v entry errors, got %v", e, a)
+	}
+	if e, a := types.BatchPutAssetPropertyValueErrorCodeTimestampOutOfRangeException, failure.Errors[0].ErrorCode; e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+	if failure.Err != nil {
+		t.Errorf("expect no request error, got %v", failure.Err)
+	}
+}
+
+func TestPutAssetPropertyValues_RequestError(t *testing.T) {
+	requestErr := fmt.Errorf("throttled")
+	client := &mockBatchPutAssetPropertyValueClient{
+		requestErrs: map[int]error{1: requestErr},
+	}
+
+	err := PutAssetPropertyValues(context.Background(), client, "asset", map[string][]types.AssetPropertyValue{
+		"prop1": newTestPropertyValues(100),
+		"prop2": newTestPropertyValues(15),
+	})
+
+	var putErr *PutAssetPropertyValuesError
+	if !errors.As(err, &putErr) {
+		t.Fatalf("expect %T error, got %v", putErr, err)
+	}
+	if e, a := 2, len(putErr.Failures); e != a {
+		t.Fatalf("expect %v failures, got %v", e, a)
+	}
+	for i, f := range putErr.Failures {
+		if e, a := "prop2", f.PropertyID; e != a {
+			t.Errorf("%d, expect %v property, got %v", i, e, a)
+		}
+		if e, a := requestErr, f.Err; e != a {
+			t.Errorf("%d, expect %v error, got %v", i, e, a)
+		}
+	}
+}
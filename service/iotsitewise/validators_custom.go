@@ -0,0 +1,53 @@
+package iotsitewise
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/internal/validation"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+)
+
+type customValidateOpDescribeAsset struct {
+}
+
+func (*customValidateOpDescribeAsset) ID() string {
+	return "OperationInputCustomValidation"
+}
+
+func (m *customValidateOpDescribeAsset) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	input, ok := in.Parameters.(*DescribeAssetInput)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown input parameters type %T", in.Parameters)
+	}
+	if err := customValidateOpDescribeAssetInput(input); err != nil {
+		return out, metadata, err
+	}
+	return next.HandleInitialize(ctx, in)
+}
+
+// addOpDescribeAssetCustomValidationMiddleware adds the validation that the
+// input's AssetId is an asset ID, so a malformed ID is rejected before the
+// request is sent.
+func addOpDescribeAssetCustomValidationMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(&customValidateOpDescribeAsset{}, middleware.After)
+}
+
+func customValidateOpDescribeAssetInput(v *DescribeAssetInput) error {
+	if v == nil {
+		return nil
+	}
+	invalidParams := smithy.InvalidParamsError{Context: "DescribeAssetInput"}
+	if v.AssetId != nil && !isID(*v.AssetId) {
+		invalidParams.Add(validation.NewErrInvalidValue("AssetId",
+			fmt.Sprintf("%q is not an asset ID, which must be a UUID", *v.AssetId)))
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	} else {
+		return nil
+	}
+}
@@ -0,0 +1,15 @@
+package iotsitewise
+
+import (
+	"regexp"
+)
+
+// idPattern is the pattern of the IDs of AWS IoT SiteWise resources, such as
+// assets, which are UUIDs.
+var idPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// isID returns if the value is the ID of an AWS IoT SiteWise resource, e.g.
+// a1b2c3d4-5678-90ab-cdef-111111111111
+func isID(v string) bool {
+	return idPattern.MatchString(v)
+}
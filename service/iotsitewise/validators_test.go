@@ -0,0 +1,79 @@
+package iotsitewise
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestValidateOpDescribeAsset(t *testing.T) {
+	cases := map[string]struct {
+		Input     *DescribeAssetInput
+		ExpectErr string
+	}{
+		"valid uuid": {
+			Input: &DescribeAssetInput{AssetId: aws.String("a1b2c3d4-5678-90ab-cdef-111111111111")},
+		},
+		"upper case uuid": {
+			Input: &DescribeAssetInput{AssetId: aws.String("A1B2C3D4-5678-90AB-CDEF-111111111111")},
+		},
+		"missing": {
+			Input:     &DescribeAssetInput{},
+			ExpectErr: "missing required field, DescribeAssetInput.AssetId",
+		},
+		"malformed": {
+			Input:     &DescribeAssetInput{AssetId: aws.String("my-asset")},
+			ExpectErr: `"my-asset" is not an asset ID, which must be a UUID, DescribeAssetInput.AssetId`,
+		},
+		"not hex": {
+			Input:     &DescribeAssetInput{AssetId: aws.String("a1b2c3d4-5678-90ab-cdef-11111EXAMPLE")},
+			ExpectErr: "is not an asset ID",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			stack := middleware.NewStack("DescribeAsset", smithyhttp.NewStackRequest)
+			if err := addOpDescribeAssetValidationMiddleware(stack); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if err := addOpDescribeAssetCustomValidationMiddleware(stack); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			var called bool
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, input interface{}) (
+				output interface{}, metadata middleware.Metadata, err error,
+			) {
+				called = true
+				return output, metadata, nil
+			}), stack)
+			_, _, err := handler.Handle(context.Background(), c.Input)
+			if len(c.ExpectErr) != 0 {
+				var invalidParams smithy.InvalidParamsError
+				if !errors.As(err, &invalidParams) {
+					t.Fatalf("expect %T error, got %v", invalidParams, err)
+				}
+				if e, a := c.ExpectErr, err.Error(); !strings.Contains(a, e) {
+					t.Errorf("expect error to contain %v, got %v", e, a)
+				}
+				if called {
+					t.Errorf("expect request not to be sent")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if !called {
+				t.Errorf("expect request to be sent")
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package networkfirewall
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+)
+
+type mockListFirewallPoliciesClient struct {
+	pages     [][]string
+	lastToken *string
+	inputs    []ListFirewallPoliciesInput
+}
+
+func (m *mockListFirewallPoliciesClient) ListFirewallPolicies(ctx context.Context, params *ListFirewallPoliciesInput, optFns ...func(*Options)) (*ListFirewallPoliciesOutput, error) {
+	m.inputs = append(m.inputs, *params)
+
+	var idx int
+	if params.NextToken != nil {
+		fmt.Sscanf(*params.NextToken, "page-%d", &idx)
+	}
+
+	out := &ListFirewallPoliciesOutput{}
+	for _, name := range m.pages[idx] {
+		out.FirewallPolicies = append(out.FirewallPolicies, types.FirewallPolicyMetadata{Name: aws.String(name)})
+	}
+	if idx+1 < len(m.pages) {
+		out.NextToken = aws.String(fmt.Sprintf("page-%d", idx+1))
+	} else {
+		out.NextToken = m.lastToken
+	}
+	return out, nil
+}
+
+func TestListFirewallPoliciesPaginator(t *testing.T) {
+	cases := map[string]struct {
+		Params           *ListFirewallPoliciesInput
+		OptFn            func(*ListFirewallPoliciesPaginatorOptions)
+		LastToken        *string
+		ExpectPolicies   []string
+		ExpectNextTokens []*string
+		ExpectLimit      *int32
+	}{
+		"two pages": {
+			Params:           &ListFirewallPoliciesInput{},
+			ExpectPolicies:   []string{"policy-a", "policy-b", "policy-c"},
+			ExpectNextTokens: []*string{nil, aws.String("page-1")},
+		},
+		"input limit": {
+			Params:           &ListFirewallPoliciesInput{MaxResults: aws.Int32(2)},
+			ExpectPolicies:   []string{"policy-a", "policy-b", "policy-c"},
+			ExpectNextTokens: []*string{nil, aws.String("page-1")},
+			ExpectLimit:      aws.Int32(2),
+		},
+		"option limit": {
+			Params: &ListFirewallPoliciesInput{MaxResults: aws.Int32(2)},
+			OptFn: func(o *ListFirewallPoliciesPaginatorOptions) {
+				o.Limit = 5
+			},
+			ExpectPolicies:   []string{"policy-a", "policy-b", "policy-c"},
+			ExpectNextTokens: []*string{nil, aws.String("page-1")},
+			ExpectLimit:      aws.Int32(5),
+		},
+		"duplicate token": {
+			Params: &ListFirewallPoliciesInput{},
+			OptFn: func(o *ListFirewallPoliciesPaginatorOptions) {
+				o.StopOnDuplicateToken = true
+			},
+			LastToken:        aws.String("page-1"),
+			ExpectPolicies:   []string{"policy-a", "policy-b", "policy-c"},
+			ExpectNextTokens: []*string{nil, aws.String("page-1")},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockListFirewallPoliciesClient{
+				pages:     [][]string{{"policy-a", "policy-b"}, {"policy-c"}},
+				lastToken: c.LastToken,
+			}
+
+			var optFns []func(*ListFirewallPoliciesPaginatorOptions)
+			if c.OptFn != nil {
+				optFns = append(optFns, c.OptFn)
+			}
+			p := NewListFirewallPoliciesPaginator(client, c.Params, optFns...)
+
+			var policies []string
+			for p.HasMorePages() {
+				out, err := p.NextPage(context.Background())
+				if err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+				for _, policy := range out.FirewallPolicies {
+					policies = append(policies, aws.ToString(policy.Name))
+				}
+			}
+			if _, err := p.NextPage(context.Background()); err == nil {
+				t.Errorf("expect error after last page, got none")
+			}
+
+			if e, a := c.ExpectPolicies, policies; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v policies, got %v", e, a)
+			}
+
+			var nextTokens []*string
+			for _, input := range client.inputs {
+				nextTokens = append(nextTokens, input.NextToken)
+				if e, a := c.ExpectLimit, input.MaxResults; !reflect.DeepEqual(e, a) {
+					t.Errorf("expect %v max results, got %v", aws.ToInt32(e), aws.ToInt32(a))
+				}
+			}
+			if e, a := c.ExpectNextTokens, nextTokens; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v next tokens, got %v", e, a)
+			}
+		})
+	}
+}
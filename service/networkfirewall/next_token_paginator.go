@@ -0,0 +1,79 @@
+package networkfirewall
+
+import (
+	"context"
+	"fmt"
+)
+
+// NextTokenPageFunc retrieves the page of a list operation for the NextToken,
+// returning the NextToken of the page retrieved. The nextToken is nil for the
+// first page.
+type NextTokenPageFunc func(ctx context.Context, nextToken *string, optFns ...func(*Options)) (*string, error)
+
+// NextTokenPaginator is a paginator for any of the client's list operations
+// that use NextToken. The page function is responsible for calling the
+// operation and collecting the output of each page.
+//
+//    var firewalls []types.FirewallMetadata
+//    p := networkfirewall.NewNextTokenPaginator(
+//        func(ctx context.Context, nextToken *string, optFns ...func(*networkfirewall.Options)) (*string, error) {
+//            out, err := client.ListFirewalls(ctx, &networkfirewall.ListFirewallsInput{
+//                NextToken: nextToken,
+//            }, optFns...)
+//            if err != nil {
+//                return nil, err
+//            }
+//            firewalls = append(firewalls, out.Firewalls...)
+//            return out.NextToken, nil
+//        })
+//    for p.HasMorePages() {
+//        if err := p.NextPage(ctx); err != nil {
+//            return err
+//        }
+//    }
+type NextTokenPaginator struct {
+	page      NextTokenPageFunc
+	nextToken *string
+	firstPage bool
+}
+
+// NewNextTokenPaginator returns a new NextTokenPaginator for the page
+// function.
+func NewNextTokenPaginator(page NextTokenPageFunc) *NextTokenPaginator {
+	return &NextTokenPaginator{
+		page:      page,
+		firstPage: true,
+	}
+}
+
+// HasMorePages returns a boolean indicating whether more pages are available.
+// Pagination ends when a page's NextToken is nil or empty, or is the same as
+// the previous page's NextToken.
+func (p *NextTokenPaginator) HasMorePages() bool {
+	return p.firstPage || p.nextToken != nil
+}
+
+// NextPage retrieves the next page.
+func (p *NextTokenPaginator) NextPage(ctx context.Context, optFns ...func(*Options)) error {
+	if !p.HasMorePages() {
+		return fmt.Errorf("no more pages available")
+	}
+
+	nextToken, err := p.page(ctx, p.nextToken, optFns...)
+	if err != nil {
+		return err
+	}
+	p.firstPage = false
+
+	prevToken := p.nextToken
+	p.nextToken = nextToken
+
+	if p.nextToken != nil && len(*p.nextToken) == 0 {
+		p.nextToken = nil
+	}
+	if prevToken != nil && p.nextToken != nil && *prevToken == *p.nextToken {
+		p.nextToken = nil
+	}
+
+	return nil
+}
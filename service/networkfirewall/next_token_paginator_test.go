@@ -0,0 +1,98 @@
+package networkfirewall
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+)
+
+type mockListFirewallsClient struct {
+	pages      [][]string
+	lastToken  *string
+	nextTokens []*string
+}
+
+func (m *mockListFirewallsClient) ListFirewalls(ctx context.Context, params *ListFirewallsInput, optFns ...func(*Options)) (*ListFirewallsOutput, error) {
+	m.nextTokens = append(m.nextTokens, params.NextToken)
+
+	var idx int
+	if params.NextToken != nil {
+		fmt.Sscanf(*params.NextToken, "page-%d", &idx)
+	}
+
+	out := &ListFirewallsOutput{}
+	for _, name := range m.pages[idx] {
+		out.Firewalls = append(out.Firewalls, types.FirewallMetadata{FirewallName: aws.String(name)})
+	}
+	if idx+1 < len(m.pages) {
+		out.NextToken = aws.String(fmt.Sprintf("page-%d", idx+1))
+	} else {
+		out.NextToken = m.lastToken
+	}
+	return out, nil
+}
+
+func TestNextTokenPaginator(t *testing.T) {
+	cases := map[string]struct {
+		LastToken        *string
+		ExpectNextTokens []*string
+	}{
+		"nil token": {
+			ExpectNextTokens: []*string{nil, aws.String("page-1")},
+		},
+		"empty token": {
+			LastToken:        aws.String(""),
+			ExpectNextTokens: []*string{nil, aws.String("page-1")},
+		},
+		"duplicate token": {
+			LastToken:        aws.String("page-1"),
+			ExpectNextTokens: []*string{nil, aws.String("page-1")},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockListFirewallsClient{
+				pages:     [][]string{{"fw-a", "fw-b"}, {"fw-c"}},
+				lastToken: c.LastToken,
+			}
+
+			var firewalls []string
+			p := NewNextTokenPaginator(func(ctx context.Context, nextToken *string, optFns ...func(*Options)) (*string, error) {
+				out, err := client.ListFirewalls(ctx, &ListFirewallsInput{NextToken: nextToken}, optFns...)
+				if err != nil {
+					return nil, err
+				}
+				for _, f := range out.Firewalls {
+					firewalls = append(firewalls, aws.ToString(f.FirewallName))
+				}
+				return out.NextToken, nil
+			})
+
+			var pages int
+			for p.HasMorePages() {
+				if err := p.NextPage(context.Background()); err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+				pages++
+			}
+
+			if e, a := 2, pages; e != a {
+				t.Errorf("expect %v pages, got %v", e, a)
+			}
+			if e, a := []string{"fw-a", "fw-b", "fw-c"}, firewalls; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v firewalls, got %v", e, a)
+			}
+			if e, a := c.ExpectNextTokens, client.nextTokens; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v next tokens, got %v", e, a)
+			}
+			if err := p.NextPage(context.Background()); err == nil {
+				t.Errorf("expect error for no more pages, got none")
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package networkfirewall
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// ListTagsForResourceMap returns all of the tags of the resource, as a map of
+// tag key to value. The tags are listed with ListTagsForResource, across all
+// pages.
+func ListTagsForResourceMap(ctx context.Context, client ListTagsForResourceAPIClient, resourceARN string, optFns ...func(*Options)) (map[string]string, error) {
+	tags := map[string]string{}
+
+	p := NewNextTokenPaginator(func(ctx context.Context, nextToken *string, optFns ...func(*Options)) (*string, error) {
+		out, err := client.ListTagsForResource(ctx, &ListTagsForResourceInput{
+			ResourceArn: aws.String(resourceARN),
+			NextToken:   nextToken,
+		}, optFns...)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range out.Tags {
+			tags[aws.ToString(t.Key)] = aws.ToString(t.Value)
+		}
+		return out.NextToken, nil
+	})
+	for p.HasMorePages() {
+		if err := p.NextPage(ctx, optFns...); err != nil {
+			return nil, fmt.Errorf("failed to list tags for resource %s, %w", resourceARN, err)
+		}
+	}
+
+	return tags, nil
+}
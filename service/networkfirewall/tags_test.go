@@ -0,0 +1,74 @@
+package networkfirewall
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+)
+
+type mockListTagsForResourceClient struct {
+	pages [][]types.Tag
+	err   error
+}
+
+func (m *mockListTagsForResourceClient) ListTagsForResource(ctx context.Context, params *ListTagsForResourceInput, optFns ...func(*Options)) (*ListTagsForResourceOutput, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	if e, a := "arn:aws:network-firewall:us-west-2:123456789012:firewall/fw", aws.ToString(params.ResourceArn); e != a {
+		return nil, fmt.Errorf("expect %v resource, got %v", e, a)
+	}
+
+	var idx int
+	if params.NextToken != nil {
+		fmt.Sscanf(*params.NextToken, "%d", &idx)
+	}
+	out := &ListTagsForResourceOutput{Tags: m.pages[idx]}
+	if idx+1 < len(m.pages) {
+		out.NextToken = aws.String(fmt.Sprintf("%d", idx+1))
+	}
+	return out, nil
+}
+
+func TestListTagsForResourceMap(t *testing.T) {
+	client := &mockListTagsForResourceClient{
+		pages: [][]types.Tag{
+			{
+				{Key: aws.String("team"), Value: aws.String("network")},
+				{Key: aws.String("env"), Value: aws.String("prod")},
+			},
+			{
+				{Key: aws.String("empty"), Value: aws.String("")},
+			},
+		},
+	}
+
+	tags, err := ListTagsForResourceMap(context.Background(), client,
+		"arn:aws:network-firewall:us-west-2:123456789012:firewall/fw")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expect := map[string]string{
+		"team":  "network",
+		"env":   "prod",
+		"empty": "",
+	}
+	if e, a := expect, tags; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v tags, got %v", e, a)
+	}
+}
+
+func TestListTagsForResourceMap_Error(t *testing.T) {
+	client := &mockListTagsForResourceClient{err: &types.ResourceNotFoundException{}}
+
+	_, err := ListTagsForResourceMap(context.Background(), client,
+		"arn:aws:network-firewall:us-west-2:123456789012:firewall/fw")
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+}
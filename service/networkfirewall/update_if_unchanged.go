@@ -0,0 +1,102 @@
+package networkfirewall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+)
+
+// DescribeFirewallAPIClient is a client that implements the DescribeFirewall
+// operation.
+type DescribeFirewallAPIClient interface {
+	DescribeFirewall(context.Context, *DescribeFirewallInput, ...func(*Options)) (*DescribeFirewallOutput, error)
+}
+
+var _ DescribeFirewallAPIClient = (*Client)(nil)
+
+// UpdateIfUnchangedOptions provides the options for UpdateIfUnchanged.
+type UpdateIfUnchangedOptions struct {
+	// The maximum number of times the firewall will be described and the
+	// update attempted. If zero, 3 attempts will be made.
+	MaxAttempts int
+
+	// The functional options passed to each DescribeFirewall operation
+	// invoked.
+	ClientOptions []func(*Options)
+}
+
+// StaleUpdateTokenError is returned by UpdateIfUnchanged when the firewall
+// was changed by another writer during every attempt to update it.
+type StaleUpdateTokenError struct {
+	FirewallARN string
+	Attempts    int
+	Err         error
+}
+
+func (e *StaleUpdateTokenError) Error() string {
+	return fmt.Sprintf("firewall %s update token still stale after %d attempt(s), %v",
+		e.FirewallARN, e.Attempts, e.Err)
+}
+
+// Unwrap returns the *types.InvalidTokenException of the last attempt.
+func (e *StaleUpdateTokenError) Unwrap() error {
+	return e.Err
+}
+
+// UpdateIfUnchanged performs an optimistic-lock read-modify-write of the
+// firewall. The firewall is described, and mutate is called with the current
+// firewall to apply the update with the UpdateToken of the output, such as
+// with UpdateFirewallDescription. The update only succeeds if the firewall has
+// not been changed since it was described.
+//
+// If mutate returns a *types.InvalidTokenException, the firewall was changed
+// by another writer, so it is described again and mutate is called with the
+// new UpdateToken, up to the MaxAttempts of the options. If the token is still
+// stale a *StaleUpdateTokenError is returned. Any other error returned by
+// mutate is returned as is.
+//
+//    err := networkfirewall.UpdateIfUnchanged(ctx, client, firewallARN,
+//        func(current *networkfirewall.DescribeFirewallOutput) error {
+//            _, err := client.UpdateFirewallDescription(ctx, &networkfirewall.UpdateFirewallDescriptionInput{
+//                FirewallArn: current.Firewall.FirewallArn,
+//                Description: aws.String("updated"),
+//                UpdateToken: current.UpdateToken,
+//            })
+//            return err
+//        })
+func UpdateIfUnchanged(ctx context.Context, client DescribeFirewallAPIClient, firewallARN string, mutate func(current *DescribeFirewallOutput) error, optFns ...func(*UpdateIfUnchangedOptions)) error {
+	options := UpdateIfUnchangedOptions{
+		MaxAttempts: 3,
+	}
+	for _, fn := range optFns {
+		fn(&options)
+	}
+	if options.MaxAttempts <= 0 {
+		options.MaxAttempts = 3
+	}
+
+	for attempt := 1; ; attempt++ {
+		current, err := client.DescribeFirewall(ctx, &DescribeFirewallInput{
+			FirewallArn: aws.String(firewallARN),
+		}, options.ClientOptions...)
+		if err != nil {
+			return fmt.Errorf("failed to describe firewall %s, %w", firewallARN, err)
+		}
+
+		err = mutate(current)
+		var invalidToken *types.InvalidTokenException
+		if err == nil || !errors.As(err, &invalidToken) {
+			return err
+		}
+		if attempt >= options.MaxAttempts {
+			return &StaleUpdateTokenError{
+				FirewallARN: firewallARN,
+				Attempts:    attempt,
+				Err:         err,
+			}
+		}
+	}
+}
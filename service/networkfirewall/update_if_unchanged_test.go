@@ -0,0 +1,123 @@
+package networkfirewall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+)
+
+// mockFirewallClient is a firewall whose update token changes with each
+// update, and which is concurrently updated by another writer the first
+// staleUpdates times it is described.
+type mockFirewallClient struct {
+	staleUpdates int
+	version      int
+	describes    int
+	updates      []string
+}
+
+func (m *mockFirewallClient) DescribeFirewall(ctx context.Context, params *DescribeFirewallInput, optFns ...func(*Options)) (*DescribeFirewallOutput, error) {
+	m.describes++
+	out := &DescribeFirewallOutput{
+		Firewall: &types.Firewall{
+			FirewallArn: params.FirewallArn,
+		},
+		UpdateToken: aws.String(fmt.Sprintf("token-%d", m.version)),
+	}
+	if m.describes <= m.staleUpdates {
+		m.version++
+	}
+	return out, nil
+}
+
+func (m *mockFirewallClient) UpdateFirewallDescription(ctx context.Context, params *UpdateFirewallDescriptionInput, optFns ...func(*Options)) (*UpdateFirewallDescriptionOutput, error) {
+	if e, a := fmt.Sprintf("token-%d", m.version), aws.ToString(params.UpdateToken); e != a {
+		return nil, &types.InvalidTokenException{Message: aws.String("stale token")}
+	}
+	m.version++
+	m.updates = append(m.updates, aws.ToString(params.Description))
+	return &UpdateFirewallDescriptionOutput{}, nil
+}
+
+func TestUpdateIfUnchanged(t *testing.T) {
+	cases := map[string]struct {
+		StaleUpdates    int
+		ExpectDescribes int
+		ExpectUpdates   int
+		ExpectErr       bool
+	}{
+		"unchanged": {
+			ExpectDescribes: 1,
+			ExpectUpdates:   1,
+		},
+		"stale token retried": {
+			StaleUpdates:    1,
+			ExpectDescribes: 2,
+			ExpectUpdates:   1,
+		},
+		"stale token max attempts": {
+			StaleUpdates:    3,
+			ExpectDescribes: 3,
+			ExpectErr:       true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockFirewallClient{staleUpdates: c.StaleUpdates}
+
+			err := UpdateIfUnchanged(context.Background(), client, "arn:aws:network-firewall:us-west-2:123456789012:firewall/fw",
+				func(current *DescribeFirewallOutput) error {
+					_, err := client.UpdateFirewallDescription(context.Background(), &UpdateFirewallDescriptionInput{
+						FirewallArn: current.Firewall.FirewallArn,
+						Description: aws.String("updated"),
+						UpdateToken: current.UpdateToken,
+					})
+					return err
+				})
+			if c.ExpectErr {
+				var staleErr *StaleUpdateTokenError
+				if !errors.As(err, &staleErr) {
+					t.Fatalf("expect %T error, got %v", staleErr, err)
+				}
+				if e, a := c.ExpectDescribes, staleErr.Attempts; e != a {
+					t.Errorf("expect %v attempts, got %v", e, a)
+				}
+				var invalidToken *types.InvalidTokenException
+				if !errors.As(err, &invalidToken) {
+					t.Errorf("expect error to wrap %T, got %v", invalidToken, err)
+				}
+			} else if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.ExpectDescribes, client.describes; e != a {
+				t.Errorf("expect %v describes, got %v", e, a)
+			}
+			if e, a := c.ExpectUpdates, len(client.updates); e != a {
+				t.Errorf("expect %v updates, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestUpdateIfUnchanged_MutateError(t *testing.T) {
+	mutateErr := fmt.Errorf("invalid description")
+	client := &mockFirewallClient{}
+
+	var calls int
+	err := UpdateIfUnchanged(context.Background(), client, "fw", func(current *DescribeFirewallOutput) error {
+		calls++
+		return mutateErr
+	})
+	if e, a := mutateErr, err; e != a {
+		t.Errorf("expect %v error, got %v", e, a)
+	}
+	if e, a := 1, calls; e != a {
+		t.Errorf("expect %v mutate calls, got %v", e, a)
+	}
+}
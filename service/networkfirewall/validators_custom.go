@@ -0,0 +1,51 @@
+package networkfirewall
+
+import (
+	"context"
+	"fmt"
+
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+)
+
+type customValidateOpUpdateFirewallDeleteProtection struct {
+}
+
+func (*customValidateOpUpdateFirewallDeleteProtection) ID() string {
+	return "OperationInputCustomValidation"
+}
+
+func (m *customValidateOpUpdateFirewallDeleteProtection) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	input, ok := in.Parameters.(*UpdateFirewallDeleteProtectionInput)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown input parameters type %T", in.Parameters)
+	}
+	if err := customValidateOpUpdateFirewallDeleteProtectionInput(input); err != nil {
+		return out, metadata, err
+	}
+	return next.HandleInitialize(ctx, in)
+}
+
+// addOpUpdateFirewallDeleteProtectionCustomValidationMiddleware adds the
+// validation that the input identifies the firewall by either its ARN or its
+// name.
+func addOpUpdateFirewallDeleteProtectionCustomValidationMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(&customValidateOpUpdateFirewallDeleteProtection{}, middleware.After)
+}
+
+func customValidateOpUpdateFirewallDeleteProtectionInput(v *UpdateFirewallDeleteProtectionInput) error {
+	if v == nil {
+		return nil
+	}
+	invalidParams := smithy.InvalidParamsError{Context: "UpdateFirewallDeleteProtectionInput"}
+	if v.FirewallArn == nil && v.FirewallName == nil {
+		invalidParams.Add(smithy.NewErrParamRequired("FirewallArn or FirewallName"))
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	} else {
+		return nil
+	}
+}
@@ -0,0 +1,67 @@
+package networkfirewall
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+)
+
+func TestCustomValidateOpUpdateFirewallDeleteProtection(t *testing.T) {
+	cases := map[string]struct {
+		Input     *UpdateFirewallDeleteProtectionInput
+		ExpectErr bool
+	}{
+		"neither": {
+			Input:     &UpdateFirewallDeleteProtectionInput{DeleteProtection: true},
+			ExpectErr: true,
+		},
+		"arn only": {
+			Input: &UpdateFirewallDeleteProtectionInput{
+				FirewallArn: aws.String("arn:aws:network-firewall:us-west-2:111122223333:firewall/example"),
+			},
+		},
+		"name only": {
+			Input: &UpdateFirewallDeleteProtectionInput{
+				FirewallName: aws.String("example"),
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var called bool
+			next := middleware.InitializeHandlerFunc(func(ctx context.Context, in middleware.InitializeInput) (
+				out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+			) {
+				called = true
+				return out, metadata, nil
+			})
+
+			m := &customValidateOpUpdateFirewallDeleteProtection{}
+			_, _, err := m.HandleInitialize(context.Background(), middleware.InitializeInput{Parameters: c.Input}, next)
+			if c.ExpectErr {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				var invalidParams smithy.InvalidParamsError
+				if !errors.As(err, &invalidParams) {
+					t.Errorf("expect %T error, got %T", invalidParams, err)
+				}
+				if called {
+					t.Errorf("expect request not to be sent")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if !called {
+				t.Errorf("expect request to be sent")
+			}
+		})
+	}
+}
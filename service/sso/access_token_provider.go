@@ -0,0 +1,154 @@
+package sso
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/internal/sdk"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// AccessTokenProvider provides the SSO access token used by the client's
+// operations whose AccessToken input parameter is nil.
+type AccessTokenProvider interface {
+	RetrieveAccessToken(ctx context.Context) (string, error)
+}
+
+// AccessTokenExpiredError is returned by the FileAccessTokenProvider when the
+// cached access token has expired. The user must sign in again, such as with
+// "aws sso login", to obtain a new access token.
+type AccessTokenExpiredError struct {
+	Path      string
+	ExpiresAt time.Time
+}
+
+func (e *AccessTokenExpiredError) Error() string {
+	return fmt.Sprintf("SSO access token in %s expired at %s, reauthentication required",
+		e.Path, e.ExpiresAt.Format(time.RFC3339))
+}
+
+// FileAccessTokenProvider is an AccessTokenProvider that reads the access
+// token from an SSO cached token file, as written by "aws sso login". The
+// file is read each time the token is retrieved, so tokens refreshed by
+// another process are picked up.
+type FileAccessTokenProvider struct {
+	path string
+}
+
+var _ AccessTokenProvider = (*FileAccessTokenProvider)(nil)
+
+// NewFileAccessTokenProvider returns a FileAccessTokenProvider reading the
+// cached token file at the path. Use CachedTokenFilepath for the path of the
+// token cached for an SSO start URL.
+func NewFileAccessTokenProvider(path string) *FileAccessTokenProvider {
+	return &FileAccessTokenProvider{path: path}
+}
+
+// CachedTokenFilepath returns the path of the file the access token of the
+// SSO start URL is cached in, within the ~/.aws/sso/cache directory.
+func CachedTokenFilepath(startURL string) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("unable to get home directory, %w", err)
+	}
+
+	hash := sha1.Sum([]byte(startURL))
+	return filepath.Join(home, ".aws", "sso", "cache", hex.EncodeToString(hash[:])+".json"), nil
+}
+
+// cachedToken is the format of an SSO cached token file.
+type cachedToken struct {
+	AccessToken string `json:"accessToken"`
+	ExpiresAt   string `json:"expiresAt"`
+}
+
+// RetrieveAccessToken reads the access token from the cached token file. If
+// the token has expired an *AccessTokenExpiredError is returned.
+func (p *FileAccessTokenProvider) RetrieveAccessToken(ctx context.Context) (string, error) {
+	b, err := ioutil.ReadFile(p.path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read SSO cached token file %s, %w", p.path, err)
+	}
+
+	var token cachedToken
+	if err := json.Unmarshal(b, &token); err != nil {
+		return "", fmt.Errorf("unable to decode SSO cached token file %s, %w", p.path, err)
+	}
+	if len(token.AccessToken) == 0 {
+		return "", fmt.Errorf("SSO cached token file %s has no accessToken", p.path)
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, token.ExpiresAt)
+	if err != nil {
+		return "", fmt.Errorf("SSO cached token file %s has invalid expiresAt, %w", p.path, err)
+	}
+	if !sdk.NowTime().Before(expiresAt) {
+		return "", &AccessTokenExpiredError{Path: p.path, ExpiresAt: expiresAt}
+	}
+
+	return token.AccessToken, nil
+}
+
+// addAccessTokenProvider adds the middleware setting the AccessToken input
+// parameter of operations from the client's AccessTokenProvider.
+func addAccessTokenProvider(stack *middleware.Stack, o Options) error {
+	if o.AccessTokenProvider == nil {
+		return nil
+	}
+	return stack.Initialize.Add(&accessTokenProvider{provider: o.AccessTokenProvider}, middleware.Before)
+}
+
+// accessTokenProvider sets the AccessToken input parameter of operations
+// from the AccessTokenProvider, when it is nil.
+type accessTokenProvider struct {
+	provider AccessTokenProvider
+}
+
+// ID returns the id of the middleware
+func (*accessTokenProvider) ID() string {
+	return "AccessTokenProvider"
+}
+
+// HandleInitialize implements the InitializeMiddleware interface
+func (m *accessTokenProvider) HandleInitialize(
+	ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	// The input is copied so the caller's input is not modified.
+	var accessToken **string
+	switch input := in.Parameters.(type) {
+	case *GetRoleCredentialsInput:
+		cpy := *input
+		in.Parameters, accessToken = &cpy, &cpy.AccessToken
+	case *ListAccountRolesInput:
+		cpy := *input
+		in.Parameters, accessToken = &cpy, &cpy.AccessToken
+	case *ListAccountsInput:
+		cpy := *input
+		in.Parameters, accessToken = &cpy, &cpy.AccessToken
+	case *LogoutInput:
+		cpy := *input
+		in.Parameters, accessToken = &cpy, &cpy.AccessToken
+	default:
+		return next.HandleInitialize(ctx, in)
+	}
+	if *accessToken != nil {
+		return next.HandleInitialize(ctx, in)
+	}
+
+	token, err := m.provider.RetrieveAccessToken(ctx)
+	if err != nil {
+		return out, metadata, fmt.Errorf("failed to retrieve SSO access token, %w", err)
+	}
+	*accessToken = &token
+
+	return next.HandleInitialize(ctx, in)
+}
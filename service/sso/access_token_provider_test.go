@@ -0,0 +1,199 @@
+package sso
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting/unit"
+	"github.com/aws/aws-sdk-go-v2/internal/sdk"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestFileAccessTokenProvider(t *testing.T) {
+	origNowTime := sdk.NowTime
+	defer func() { sdk.NowTime = origNowTime }()
+	sdk.NowTime = func() time.Time {
+		return time.Date(2021, 2, 3, 12, 0, 0, 0, time.UTC)
+	}
+
+	cases := map[string]struct {
+		Cache       string
+		ExpectToken string
+		ExpectErr   func(*testing.T, error)
+	}{
+		"valid token": {
+			Cache:       `{"accessToken":"token","expiresAt":"2021-02-03T13:00:00Z","region":"us-west-2","startUrl":"https://example.awsapps.com/start"}`,
+			ExpectToken: "token",
+		},
+		"expired token": {
+			Cache: `{"accessToken":"token","expiresAt":"2021-02-03T11:00:00Z"}`,
+			ExpectErr: func(t *testing.T, err error) {
+				var expired *AccessTokenExpiredError
+				if !errors.As(err, &expired) {
+					t.Fatalf("expect %T error, got %v", expired, err)
+				}
+				if e, a := time.Date(2021, 2, 3, 11, 0, 0, 0, time.UTC), expired.ExpiresAt; !e.Equal(a) {
+					t.Errorf("expect %v expires at, got %v", e, a)
+				}
+			},
+		},
+		"no access token": {
+			Cache: `{"expiresAt":"2021-02-03T13:00:00Z"}`,
+			ExpectErr: func(t *testing.T, err error) {
+				if e, a := "no accessToken", err.Error(); !strings.Contains(a, e) {
+					t.Errorf("expect %v error, got %v", e, a)
+				}
+			},
+		},
+		"invalid expires at": {
+			Cache: `{"accessToken":"token","expiresAt":"tomorrow"}`,
+			ExpectErr: func(t *testing.T, err error) {
+				if e, a := "invalid expiresAt", err.Error(); !strings.Contains(a, e) {
+					t.Errorf("expect %v error, got %v", e, a)
+				}
+			},
+		},
+		"invalid json": {
+			Cache: `{`,
+			ExpectErr: func(t *testing.T, err error) {
+				if e, a := "unable to decode", err.Error(); !strings.Contains(a, e) {
+					t.Errorf("expect %v error, got %v", e, a)
+				}
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "token.json")
+			if err := ioutil.WriteFile(path, []byte(c.Cache), 0600); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			token, err := NewFileAccessTokenProvider(path).RetrieveAccessToken(context.Background())
+			if c.ExpectErr != nil {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				c.ExpectErr(t, err)
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectToken, token; e != a {
+				t.Errorf("expect %v token, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestFileAccessTokenProvider_MissingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.json")
+	_, err := NewFileAccessTokenProvider(path).RetrieveAccessToken(context.Background())
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expect not exist error, got %v", err)
+	}
+}
+
+func TestCachedTokenFilepath(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skipf("no home directory, %v", err)
+	}
+
+	path, err := CachedTokenFilepath("https://example.awsapps.com/start")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expect := filepath.Join(home, ".aws", "sso", "cache", "e8be5486177c5b5392bd9aa76563515b29358e6e.json")
+	if e, a := expect, path; e != a {
+		t.Errorf("expect %v path, got %v", e, a)
+	}
+}
+
+type mockAccessTokenProvider struct {
+	token string
+	err   error
+	calls int
+}
+
+func (m *mockAccessTokenProvider) RetrieveAccessToken(ctx context.Context) (string, error) {
+	m.calls++
+	return m.token, m.err
+}
+
+func TestClient_AccessTokenProvider(t *testing.T) {
+	cases := map[string]struct {
+		Provider    *mockAccessTokenProvider
+		AccessToken *string
+		ExpectToken string
+		ExpectCalls int
+		ExpectErr   bool
+	}{
+		"from provider": {
+			Provider:    &mockAccessTokenProvider{token: "provided"},
+			ExpectToken: "provided",
+			ExpectCalls: 1,
+		},
+		"explicit token": {
+			Provider:    &mockAccessTokenProvider{token: "provided"},
+			AccessToken: aws.String("explicit"),
+			ExpectToken: "explicit",
+		},
+		"provider error": {
+			Provider:    &mockAccessTokenProvider{err: &AccessTokenExpiredError{}},
+			ExpectCalls: 1,
+			ExpectErr:   true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var token string
+			client := New(Options{
+				Region:              "us-west-2",
+				Credentials:         unit.StubCredentialsProvider{},
+				AccessTokenProvider: c.Provider,
+				HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+					token = r.Header.Get("X-Amz-Sso_bearer_token")
+					return &http.Response{
+						StatusCode: 200,
+						Header:     http.Header{},
+						Body:       ioutil.NopCloser(strings.NewReader(`{}`)),
+					}, nil
+				}),
+			})
+
+			input := &ListAccountsInput{AccessToken: c.AccessToken}
+			_, err := client.ListAccounts(context.Background(), input)
+			if c.ExpectErr {
+				var expired *AccessTokenExpiredError
+				if !errors.As(err, &expired) {
+					t.Fatalf("expect %T error, got %v", expired, err)
+				}
+			} else if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.ExpectToken, token; e != a {
+				t.Errorf("expect %v access token sent, got %v", e, a)
+			}
+			if e, a := c.ExpectCalls, c.Provider.calls; e != a {
+				t.Errorf("expect %v provider calls, got %v", e, a)
+			}
+			if e, a := c.AccessToken, input.AccessToken; e != a {
+				t.Errorf("expect input access token to not be modified, got %v", aws.ToString(a))
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package sso
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// DefaultListAllAccountRolesConcurrency is the default number of accounts
+// whose roles are listed concurrently by ListAllAccountRoles.
+const DefaultListAllAccountRolesConcurrency = 5
+
+// AccountRole is a role the user is assigned within an AWS account.
+type AccountRole struct {
+	AccountID   string
+	AccountName string
+	RoleName    string
+}
+
+// ListAllAccountRolesAPIClient is a client that implements the ListAccounts
+// and ListAccountRoles operations.
+type ListAllAccountRolesAPIClient interface {
+	ListAccountsAPIClient
+	ListAccountRolesAPIClient
+}
+
+var _ ListAllAccountRolesAPIClient = (*Client)(nil)
+
+// ListAllAccountRolesOptions provides the options for ListAllAccountRoles.
+type ListAllAccountRolesOptions struct {
+	// The number of accounts whose roles will be listed concurrently. If zero,
+	// DefaultListAllAccountRolesConcurrency will be used.
+	Concurrency int
+
+	// The functional options passed to each operation invoked.
+	ClientOptions []func(*Options)
+}
+
+// AccountRolesError is returned by ListAllAccountRoles when the roles of one
+// or more accounts could not be listed. Errors are keyed by account ID.
+type AccountRolesError struct {
+	Errs map[string]error
+}
+
+func (e *AccountRolesError) Error() string {
+	ids := make([]string, 0, len(e.Errs))
+	for id := range e.Errs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var msgs []string
+	for _, id := range ids {
+		msgs = append(msgs, fmt.Sprintf("%s: %v", id, e.Errs[id]))
+	}
+	return fmt.Sprintf("failed to list roles for %d account(s), %s", len(ids), strings.Join(msgs, "; "))
+}
+
+// ListAllAccountRoles returns every role the access token's user is assigned
+// across all of the accounts assigned to the user. All pages of ListAccounts,
+// and ListAccountRoles for each account, are retrieved before returning.
+// Roles are returned in the order their accounts were listed.
+//
+// If listing the roles of an account fails, the roles of the other accounts
+// are still returned along with an *AccountRolesError describing the failed
+// accounts.
+func ListAllAccountRoles(ctx context.Context, client ListAllAccountRolesAPIClient, accessToken string, optFns ...func(*ListAllAccountRolesOptions)) ([]AccountRole, error) {
+	options := ListAllAccountRolesOptions{
+		Concurrency: DefaultListAllAccountRolesConcurrency,
+	}
+	for _, fn := range optFns {
+		fn(&options)
+	}
+	if options.Concurrency <= 0 {
+		options.Concurrency = DefaultListAllAccountRolesConcurrency
+	}
+
+	var accounts []AccountRole
+	p := NewListAccountsPaginator(client, &ListAccountsInput{
+		AccessToken: &accessToken,
+	})
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx, options.ClientOptions...)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range page.AccountList {
+			accounts = append(accounts, AccountRole{
+				AccountID:   aws.ToString(a.AccountId),
+				AccountName: aws.ToString(a.AccountName),
+			})
+		}
+	}
+
+	roles := make([][]AccountRole, len(accounts))
+	errs := make([]error, len(accounts))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, options.Concurrency)
+	for i := range accounts {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			roles[i], errs[i] = listAccountRoles(ctx, client, accessToken, accounts[i], options.ClientOptions)
+		}(i)
+	}
+	wg.Wait()
+
+	var all []AccountRole
+	var rolesErr *AccountRolesError
+	for i, err := range errs {
+		if err != nil {
+			if rolesErr == nil {
+				rolesErr = &AccountRolesError{Errs: map[string]error{}}
+			}
+			rolesErr.Errs[accounts[i].AccountID] = err
+			continue
+		}
+		all = append(all, roles[i]...)
+	}
+	if rolesErr != nil {
+		return all, rolesErr
+	}
+
+	return all, nil
+}
+
+func listAccountRoles(ctx context.Context, client ListAccountRolesAPIClient, accessToken string, account AccountRole, optFns []func(*Options)) ([]AccountRole, error) {
+	var roles []AccountRole
+	p := NewListAccountRolesPaginator(client, &ListAccountRolesInput{
+		AccessToken: &accessToken,
+		AccountId:   &account.AccountID,
+	})
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx, optFns...)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range page.RoleList {
+			role := account
+			role.RoleName = aws.ToString(r.RoleName)
+			roles = append(roles, role)
+		}
+	}
+
+	return roles, nil
+}
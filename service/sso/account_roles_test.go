@@ -0,0 +1,148 @@
+package sso
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sso/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+type mockAccountRolesClient struct {
+	accounts []types.AccountInfo
+	roles    map[string][]string
+	errs     map[string]error
+
+	mu     sync.Mutex
+	active int
+	peak   int
+}
+
+func (m *mockAccountRolesClient) ListAccounts(ctx context.Context, params *ListAccountsInput, optFns ...func(*Options)) (*ListAccountsOutput, error) {
+	if e, a := "token", aws.ToString(params.AccessToken); e != a {
+		return nil, fmt.Errorf("expect %v access token, got %v", e, a)
+	}
+
+	// Return one account per page.
+	var idx int
+	if params.NextToken != nil {
+		fmt.Sscanf(*params.NextToken, "%d", &idx)
+	}
+	out := &ListAccountsOutput{AccountList: m.accounts[idx : idx+1]}
+	if idx+1 < len(m.accounts) {
+		out.NextToken = aws.String(fmt.Sprintf("%d", idx+1))
+	}
+	return out, nil
+}
+
+func (m *mockAccountRolesClient) ListAccountRoles(ctx context.Context, params *ListAccountRolesInput, optFns ...func(*Options)) (*ListAccountRolesOutput, error) {
+	m.mu.Lock()
+	m.active++
+	if m.active > m.peak {
+		m.peak = m.active
+	}
+	m.mu.Unlock()
+	defer func() {
+		m.mu.Lock()
+		m.active--
+		m.mu.Unlock()
+	}()
+
+	id := aws.ToString(params.AccountId)
+	if err := m.errs[id]; err != nil {
+		return nil, err
+	}
+
+	// Return one role per page.
+	var idx int
+	if params.NextToken != nil {
+		fmt.Sscanf(*params.NextToken, "%d", &idx)
+	}
+	roles := m.roles[id]
+	out := &ListAccountRolesOutput{
+		RoleList: []types.RoleInfo{{AccountId: params.AccountId, RoleName: aws.String(roles[idx])}},
+	}
+	if idx+1 < len(roles) {
+		out.NextToken = aws.String(fmt.Sprintf("%d", idx+1))
+	}
+	return out, nil
+}
+
+func newMockAccountRolesClient() *mockAccountRolesClient {
+	return &mockAccountRolesClient{
+		accounts: []types.AccountInfo{
+			{AccountId: aws.String("111111111111"), AccountName: aws.String("dev")},
+			{AccountId: aws.String("222222222222"), AccountName: aws.String("prod")},
+		},
+		roles: map[string][]string{
+			"111111111111": {"Admin", "ReadOnly"},
+			"222222222222": {"Deploy", "ReadOnly"},
+		},
+	}
+}
+
+func TestListAllAccountRoles(t *testing.T) {
+	for _, concurrency := range []int{0, 1, 2} {
+		t.Run(fmt.Sprintf("concurrency %d", concurrency), func(t *testing.T) {
+			client := newMockAccountRolesClient()
+
+			roles, err := ListAllAccountRoles(context.Background(), client, "token",
+				func(o *ListAllAccountRolesOptions) {
+					o.Concurrency = concurrency
+				})
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			expect := []AccountRole{
+				{AccountID: "111111111111", AccountName: "dev", RoleName: "Admin"},
+				{AccountID: "111111111111", AccountName: "dev", RoleName: "ReadOnly"},
+				{AccountID: "222222222222", AccountName: "prod", RoleName: "Deploy"},
+				{AccountID: "222222222222", AccountName: "prod", RoleName: "ReadOnly"},
+			}
+			if diff := cmp.Diff(expect, roles); len(diff) != 0 {
+				t.Errorf("expect roles match\n%s", diff)
+			}
+
+			if concurrency == 1 && client.peak > 1 {
+				t.Errorf("expect at most 1 concurrent call, got %v", client.peak)
+			}
+		})
+	}
+}
+
+func TestListAllAccountRoles_AccountError(t *testing.T) {
+	client := newMockAccountRolesClient()
+	client.errs = map[string]error{
+		"222222222222": fmt.Errorf("access denied"),
+	}
+
+	roles, err := ListAllAccountRoles(context.Background(), client, "token")
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+
+	var rolesErr *AccountRolesError
+	if !errors.As(err, &rolesErr) {
+		t.Fatalf("expect %T error, got %T", rolesErr, err)
+	}
+	if e, a := 1, len(rolesErr.Errs); e != a {
+		t.Fatalf("expect %v account errors, got %v", e, a)
+	}
+	if _, ok := rolesErr.Errs["222222222222"]; !ok {
+		t.Errorf("expect error for account 222222222222, got %v", rolesErr.Errs)
+	}
+
+	if e, a := 2, len(roles); e != a {
+		t.Fatalf("expect %v roles, got %v", e, a)
+	}
+	for _, r := range roles {
+		if e, a := "111111111111", r.AccountID; e != a {
+			t.Errorf("expect %v account, got %v", e, a)
+		}
+	}
+}
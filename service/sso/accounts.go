@@ -0,0 +1,50 @@
+package sso
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/sso/types"
+)
+
+// FindAccountsOptions provides the options for FindAccounts.
+type FindAccountsOptions struct {
+	// The functional options passed to each operation invoked.
+	ClientOptions []func(*Options)
+}
+
+// FindAccounts returns the accounts assigned to the access token's user whose
+// AccountName contains nameContains, ignoring case, such as for choosing an
+// account to log in to interactively. All pages of ListAccounts are
+// retrieved, and accounts are returned in the order they were listed. An
+// empty nameContains returns all of the accounts.
+func FindAccounts(ctx context.Context, client ListAccountsAPIClient, accessToken, nameContains string, optFns ...func(*FindAccountsOptions)) ([]types.AccountInfo, error) {
+	var options FindAccountsOptions
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	nameContains = strings.ToLower(nameContains)
+
+	var accounts []types.AccountInfo
+	p := NewListAccountsPaginator(client, &ListAccountsInput{
+		AccessToken: &accessToken,
+	})
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx, options.ClientOptions...)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range page.AccountList {
+			var name string
+			if a.AccountName != nil {
+				name = *a.AccountName
+			}
+			if strings.Contains(strings.ToLower(name), nameContains) {
+				accounts = append(accounts, a)
+			}
+		}
+	}
+
+	return accounts, nil
+}
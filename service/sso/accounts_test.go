@@ -0,0 +1,83 @@
+package sso
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sso/types"
+)
+
+type mockListAccountsClient struct {
+	pages [][]types.AccountInfo
+}
+
+func (m *mockListAccountsClient) ListAccounts(ctx context.Context, params *ListAccountsInput, optFns ...func(*Options)) (*ListAccountsOutput, error) {
+	if e, a := "token", aws.ToString(params.AccessToken); e != a {
+		return nil, fmt.Errorf("expect %v access token, got %v", e, a)
+	}
+
+	var idx int
+	if params.NextToken != nil {
+		fmt.Sscanf(*params.NextToken, "%d", &idx)
+	}
+	out := &ListAccountsOutput{AccountList: m.pages[idx]}
+	if idx+1 < len(m.pages) {
+		out.NextToken = aws.String(fmt.Sprintf("%d", idx+1))
+	}
+	return out, nil
+}
+
+func TestFindAccounts(t *testing.T) {
+	client := &mockListAccountsClient{
+		pages: [][]types.AccountInfo{
+			{
+				{AccountId: aws.String("111111111111"), AccountName: aws.String("Payments-Dev")},
+				{AccountId: aws.String("222222222222"), AccountName: aws.String("Payments-Prod")},
+				{AccountId: aws.String("333333333333")},
+			},
+			{
+				{AccountId: aws.String("444444444444"), AccountName: aws.String("search-dev")},
+				{AccountId: aws.String("555555555555"), AccountName: aws.String("Sandbox")},
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		NameContains string
+		ExpectIDs    []string
+	}{
+		"case insensitive": {
+			NameContains: "DEV",
+			ExpectIDs:    []string{"111111111111", "444444444444"},
+		},
+		"single page": {
+			NameContains: "payments",
+			ExpectIDs:    []string{"111111111111", "222222222222"},
+		},
+		"all": {
+			ExpectIDs: []string{"111111111111", "222222222222", "333333333333", "444444444444", "555555555555"},
+		},
+		"no match": {
+			NameContains: "staging",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			accounts, err := FindAccounts(context.Background(), client, "token", c.NameContains)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			var ids []string
+			for _, a := range accounts {
+				ids = append(ids, aws.ToString(a.AccountId))
+			}
+			if e, a := c.ExpectIDs, ids; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v accounts, got %v", e, a)
+			}
+		})
+	}
+}
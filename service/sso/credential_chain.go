@@ -0,0 +1,41 @@
+package sso
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// CredentialChain returns a cached credentials provider of the role the SSO
+// user is assigned within the account. The credentials are retrieved with a
+// RoleCredentialsProvider, authorized by the SSO access token of the client's
+// AccessTokenProvider, such as a FileAccessTokenProvider reading the token
+// cached by "aws sso login". The credentials are retrieved once before the
+// provider is returned, so an access token that is missing, expired, or not
+// authorized for the role fails early, and are refreshed by the provider
+// after they expire.
+//
+// The provider can be used as the Credentials of any service client, or as
+// the source credentials of an STS assume role provider for access to roles
+// in other accounts:
+//
+//    client := sso.New(sso.Options{
+//        Region:              "us-east-1",
+//        AccessTokenProvider: sso.NewFileAccessTokenProvider(tokenPath),
+//    })
+//    ssoCreds, err := sso.CredentialChain(ctx, client, "111122223333", "ReadOnly")
+//    if err != nil {
+//        return err
+//    }
+//
+//    stsClient := sts.New(sts.Options{Region: "us-east-1", Credentials: ssoCreds})
+//    creds := aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(stsClient,
+//        "arn:aws:iam::444455556666:role/CrossAccount"))
+func CredentialChain(ctx context.Context, client GetRoleCredentialsAPIClient, accountID, roleName string, optFns ...func(*RoleCredentialsProviderOptions)) (*aws.CredentialsCache, error) {
+	provider := aws.NewCredentialsCache(NewRoleCredentialsProvider(client, accountID, roleName, "", optFns...))
+	if _, err := provider.Retrieve(ctx); err != nil {
+		return nil, fmt.Errorf("failed to retrieve credentials of role %s in account %s, %w", roleName, accountID, err)
+	}
+	return provider, nil
+}
@@ -0,0 +1,135 @@
+package sso
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting/unit"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// assumeRoleProvider is a stand-in for an STS assume role provider, deriving
+// its credentials from the source provider's.
+type assumeRoleProvider struct {
+	source aws.CredentialsProvider
+}
+
+func (p *assumeRoleProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	source, err := p.source.Retrieve(ctx)
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+	return aws.Credentials{
+		AccessKeyID:     "ASSUMED-" + source.AccessKeyID,
+		SecretAccessKey: "SECRET",
+		Source:          "AssumeRoleProvider",
+	}, nil
+}
+
+func TestCredentialChain(t *testing.T) {
+	expiration := time.Now().Add(time.Hour).Truncate(time.Millisecond).UTC()
+
+	cases := map[string]struct {
+		TokenProvider   *mockAccessTokenProvider
+		StatusCode      int
+		Body            string
+		ExpectToken     string
+		ExpectExpired   bool
+		ExpectReauth    bool
+		ExpectAccessKey string
+	}{
+		"success": {
+			TokenProvider: &mockAccessTokenProvider{token: "token"},
+			StatusCode:    200,
+			Body: fmt.Sprintf(`{"roleCredentials":{"accessKeyId":"AKID","secretAccessKey":"SECRET","sessionToken":"SESSION","expiration":%d}}`,
+				expiration.UnixNano()/int64(time.Millisecond)),
+			ExpectToken:     "token",
+			ExpectAccessKey: "AKID",
+		},
+		"expired access token": {
+			TokenProvider: &mockAccessTokenProvider{err: &AccessTokenExpiredError{}},
+			ExpectExpired: true,
+		},
+		"unauthorized": {
+			TokenProvider: &mockAccessTokenProvider{token: "token"},
+			StatusCode:    401,
+			Body:          `{"message":"session expired"}`,
+			ExpectToken:   "token",
+			ExpectReauth:  true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var token string
+			var requests int
+			client := New(Options{
+				Region:              "us-east-1",
+				Credentials:         unit.StubCredentialsProvider{},
+				AccessTokenProvider: c.TokenProvider,
+				HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+					requests++
+					token = r.Header.Get("X-Amz-Sso_bearer_token")
+					header := http.Header{}
+					if c.StatusCode == 401 {
+						header.Set("X-Amzn-Errortype", "UnauthorizedException")
+					}
+					return &http.Response{
+						StatusCode: c.StatusCode,
+						Header:     header,
+						Body:       ioutil.NopCloser(strings.NewReader(c.Body)),
+					}, nil
+				}),
+			})
+
+			provider, err := CredentialChain(context.Background(), client, "111122223333", "ReadOnly")
+			if e, a := c.ExpectToken, token; e != a {
+				t.Errorf("expect %v access token sent, got %v", e, a)
+			}
+			if c.ExpectExpired {
+				var expired *AccessTokenExpiredError
+				if !errors.As(err, &expired) {
+					t.Fatalf("expect %T error, got %v", expired, err)
+				}
+				return
+			}
+			if c.ExpectReauth {
+				var reauth *ReauthenticationRequiredError
+				if !errors.As(err, &reauth) {
+					t.Fatalf("expect %T error, got %v", reauth, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			chained := &assumeRoleProvider{source: provider}
+			creds, err := chained.Retrieve(context.Background())
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := "ASSUMED-"+c.ExpectAccessKey, creds.AccessKeyID; e != a {
+				t.Errorf("expect %v access key, got %v", e, a)
+			}
+
+			sourceCreds, err := provider.Retrieve(context.Background())
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := expiration, sourceCreds.Expires; !e.Equal(a) {
+				t.Errorf("expect %v expiration, got %v", e, a)
+			}
+			if e, a := 1, requests; e != a {
+				t.Errorf("expect credentials to be cached after %v request, got %v", e, a)
+			}
+		})
+	}
+}
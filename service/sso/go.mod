@@ -5,6 +5,7 @@ go 1.15
 require (
 	github.com/aws/aws-sdk-go-v2 v1.0.1-0.20210122214637-6cf9ad2f8e2f
 	github.com/aws/smithy-go v1.0.0
+	github.com/google/go-cmp v0.5.4
 )
 
 replace github.com/aws/aws-sdk-go-v2 => ../../
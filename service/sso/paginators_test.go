@@ -0,0 +1,111 @@
+package sso
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sso/types"
+	"github.com/aws/smithy-go/middleware"
+)
+
+type mockListAccountsPagesClient struct {
+	pages  [][]types.AccountInfo
+	limits []int32
+}
+
+func (m *mockListAccountsPagesClient) ListAccounts(ctx context.Context, params *ListAccountsInput, optFns ...func(*Options)) (*ListAccountsOutput, error) {
+	m.limits = append(m.limits, aws.ToInt32(params.MaxResults))
+
+	var idx int
+	if params.NextToken != nil {
+		fmt.Sscanf(*params.NextToken, "%d", &idx)
+	}
+	out := &ListAccountsOutput{AccountList: m.pages[idx]}
+	out.ResultMetadata.Set("page", idx)
+	if idx+1 < len(m.pages) {
+		out.NextToken = aws.String(fmt.Sprintf("%d", idx+1))
+	}
+	return out, nil
+}
+
+func TestListAccountsPaginator_OnPage(t *testing.T) {
+	client := &mockListAccountsPagesClient{
+		pages: [][]types.AccountInfo{{{}, {}}, {}, {{}}},
+	}
+
+	var pageNums, itemCounts, metadataPages []int
+	p := NewListAccountsPaginator(client, &ListAccountsInput{}, func(o *ListAccountsPaginatorOptions) {
+		o.OnPage = func(pageNum int, itemCount int, metadata middleware.Metadata) {
+			pageNums = append(pageNums, pageNum)
+			itemCounts = append(itemCounts, itemCount)
+			metadataPages = append(metadataPages, metadata.Get("page").(int))
+		}
+	})
+	for p.HasMorePages() {
+		if _, err := p.NextPage(context.Background()); err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+	}
+
+	if e, a := []int{1, 2, 3}, pageNums; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v page numbers, got %v", e, a)
+	}
+	if e, a := []int{2, 0, 1}, itemCounts; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v item counts, got %v", e, a)
+	}
+	if e, a := []int{0, 1, 2}, metadataPages; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v page metadata, got %v", e, a)
+	}
+}
+
+func TestListAccountsPaginator_AdaptiveLimit(t *testing.T) {
+	cases := map[string]struct {
+		Limit         int32
+		AdaptiveLimit int32
+		ExpectLimits  []int32
+	}{
+		"grows to limit": {
+			Limit:         10,
+			AdaptiveLimit: 2,
+			ExpectLimits:  []int32{2, 4, 8, 10, 10},
+		},
+		"not adaptive": {
+			Limit:        10,
+			ExpectLimits: []int32{10, 10, 10, 10, 10},
+		},
+		"adaptive limit above limit": {
+			Limit:         10,
+			AdaptiveLimit: 20,
+			ExpectLimits:  []int32{10, 10, 10, 10, 10},
+		},
+		"no limit": {
+			AdaptiveLimit: 2,
+			ExpectLimits:  []int32{0, 0, 0, 0, 0},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockListAccountsPagesClient{
+				pages: make([][]types.AccountInfo, 5),
+			}
+
+			p := NewListAccountsPaginator(client, &ListAccountsInput{}, func(o *ListAccountsPaginatorOptions) {
+				o.Limit = c.Limit
+				o.AdaptiveLimit = c.AdaptiveLimit
+			})
+			for p.HasMorePages() {
+				if _, err := p.NextPage(context.Background()); err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+			}
+
+			if e, a := c.ExpectLimits, client.limits; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v limits, got %v", e, a)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package sso
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sso/types"
+)
+
+// RoleCredentialsProviderName is the Source of credentials retrieved by the
+// RoleCredentialsProvider.
+const RoleCredentialsProviderName = "SSORoleCredentialsProvider"
+
+// GetRoleCredentialsAPIClient is a client that implements the
+// GetRoleCredentials operation.
+type GetRoleCredentialsAPIClient interface {
+	GetRoleCredentials(context.Context, *GetRoleCredentialsInput, ...func(*Options)) (*GetRoleCredentialsOutput, error)
+}
+
+var _ GetRoleCredentialsAPIClient = (*Client)(nil)
+
+// RoleCredentialsProviderOptions provides the options for the
+// RoleCredentialsProvider.
+type RoleCredentialsProviderOptions struct {
+	// The functional options passed to each operation invoked.
+	ClientOptions []func(*Options)
+}
+
+// ReauthenticationRequiredError is returned by the RoleCredentialsProvider when
+// the SSO access token is no longer authorized to retrieve the role's
+// credentials, such as when the token has expired. The user must sign in
+// again to obtain a new access token.
+type ReauthenticationRequiredError struct {
+	AccountID string
+	RoleName  string
+	Err       error
+}
+
+func (e *ReauthenticationRequiredError) Error() string {
+	return fmt.Sprintf("SSO access token not authorized for role %s in account %s, reauthentication required, %v",
+		e.RoleName, e.AccountID, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ReauthenticationRequiredError) Unwrap() error {
+	return e.Err
+}
+
+// RoleCredentialsProvider is an aws.CredentialsProvider that retrieves the
+// short-term credentials of a role the SSO user is assigned within an
+// account, with GetRoleCredentials. The provider can be used as the
+// Credentials of any service client.
+//
+// Each call to Retrieve calls GetRoleCredentials. Wrap the provider with
+// aws.NewCredentialsCache so the credentials are reused until they expire,
+// and are refreshed afterwards.
+//
+//    provider := aws.NewCredentialsCache(sso.NewRoleCredentialsProvider(
+//        client, "111122223333", "ReadOnly", accessToken))
+type RoleCredentialsProvider struct {
+	client      GetRoleCredentialsAPIClient
+	accountID   string
+	roleName    string
+	accessToken string
+	options     RoleCredentialsProviderOptions
+}
+
+var _ aws.CredentialsProvider = (*RoleCredentialsProvider)(nil)
+
+// NewRoleCredentialsProvider returns a RoleCredentialsProvider for the role in
+// the account, authorized by the SSO access token. If the access token is
+// empty, the AccessToken of GetRoleCredentials is left nil, to be provided by
+// the client's AccessTokenProvider.
+func NewRoleCredentialsProvider(client GetRoleCredentialsAPIClient, accountID, roleName, accessToken string, optFns ...func(*RoleCredentialsProviderOptions)) *RoleCredentialsProvider {
+	var options RoleCredentialsProviderOptions
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	return &RoleCredentialsProvider{
+		client:      client,
+		accountID:   accountID,
+		roleName:    roleName,
+		accessToken: accessToken,
+		options:     options,
+	}
+}
+
+// Retrieve retrieves the role's credentials with GetRoleCredentials. If the
+// access token is not authorized a *ReauthenticationRequiredError is
+// returned.
+func (p *RoleCredentialsProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	params := &GetRoleCredentialsInput{
+		AccountId: aws.String(p.accountID),
+		RoleName:  aws.String(p.roleName),
+	}
+	if len(p.accessToken) != 0 {
+		params.AccessToken = aws.String(p.accessToken)
+	}
+	out, err := p.client.GetRoleCredentials(ctx, params, p.options.ClientOptions...)
+	if err != nil {
+		var unauthorized *types.UnauthorizedException
+		if errors.As(err, &unauthorized) {
+			err = &ReauthenticationRequiredError{
+				AccountID: p.accountID,
+				RoleName:  p.roleName,
+				Err:       err,
+			}
+		}
+		return aws.Credentials{Source: RoleCredentialsProviderName}, err
+	}
+
+	if out.RoleCredentials == nil {
+		return aws.Credentials{Source: RoleCredentialsProviderName},
+			fmt.Errorf("no credentials returned for role %s in account %s", p.roleName, p.accountID)
+	}
+
+	creds := out.RoleCredentials
+	return aws.Credentials{
+		AccessKeyID:     aws.ToString(creds.AccessKeyId),
+		SecretAccessKey: aws.ToString(creds.SecretAccessKey),
+		SessionToken:    aws.ToString(creds.SessionToken),
+		Source:          RoleCredentialsProviderName,
+
+		CanExpire: true,
+		Expires:   time.Unix(0, creds.Expiration*int64(time.Millisecond)).UTC(),
+	}, nil
+}
@@ -0,0 +1,141 @@
+package sso
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/sdk"
+	"github.com/aws/aws-sdk-go-v2/service/sso/types"
+)
+
+type mockGetRoleCredentialsClient struct {
+	expiration time.Time
+	err        error
+	calls      int
+}
+
+func (m *mockGetRoleCredentialsClient) GetRoleCredentials(ctx context.Context, params *GetRoleCredentialsInput, optFns ...func(*Options)) (*GetRoleCredentialsOutput, error) {
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	if e, a := "token", aws.ToString(params.AccessToken); e != a {
+		return nil, fmt.Errorf("expect %v access token, got %v", e, a)
+	}
+	if e, a := "111122223333", aws.ToString(params.AccountId); e != a {
+		return nil, fmt.Errorf("expect %v account, got %v", e, a)
+	}
+	if e, a := "ReadOnly", aws.ToString(params.RoleName); e != a {
+		return nil, fmt.Errorf("expect %v role, got %v", e, a)
+	}
+
+	return &GetRoleCredentialsOutput{
+		RoleCredentials: &types.RoleCredentials{
+			AccessKeyId:     aws.String(fmt.Sprintf("AKID%d", m.calls)),
+			SecretAccessKey: aws.String("SECRET"),
+			SessionToken:    aws.String("SESSION"),
+			Expiration:      m.expiration.UnixNano() / int64(time.Millisecond),
+		},
+	}, nil
+}
+
+func TestRoleCredentialsProvider(t *testing.T) {
+	expiration := time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC)
+	client := &mockGetRoleCredentialsClient{expiration: expiration}
+
+	provider := NewRoleCredentialsProvider(client, "111122223333", "ReadOnly", "token")
+	creds, err := provider.Retrieve(context.Background())
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expect := aws.Credentials{
+		AccessKeyID:     "AKID1",
+		SecretAccessKey: "SECRET",
+		SessionToken:    "SESSION",
+		Source:          RoleCredentialsProviderName,
+		CanExpire:       true,
+		Expires:         expiration,
+	}
+	if e, a := expect, creds; e != a {
+		t.Errorf("expect %v credentials, got %v", e, a)
+	}
+}
+
+func TestRoleCredentialsProvider_Refresh(t *testing.T) {
+	now := time.Date(2021, 2, 3, 4, 0, 0, 0, time.UTC)
+	origNowTime := sdk.NowTime
+	defer func() { sdk.NowTime = origNowTime }()
+	sdk.NowTime = func() time.Time { return now }
+
+	client := &mockGetRoleCredentialsClient{expiration: now.Add(time.Hour)}
+	provider := aws.NewCredentialsCache(
+		NewRoleCredentialsProvider(client, "111122223333", "ReadOnly", "token"))
+
+	for i := 0; i < 2; i++ {
+		creds, err := provider.Retrieve(context.Background())
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		if e, a := "AKID1", creds.AccessKeyID; e != a {
+			t.Errorf("expect %v access key, got %v", e, a)
+		}
+	}
+	if e, a := 1, client.calls; e != a {
+		t.Errorf("expect %v calls before expiry, got %v", e, a)
+	}
+
+	// Move past the credentials' expiration.
+	sdk.NowTime = func() time.Time { return now.Add(2 * time.Hour) }
+	client.expiration = now.Add(3 * time.Hour)
+
+	creds, err := provider.Retrieve(context.Background())
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "AKID2", creds.AccessKeyID; e != a {
+		t.Errorf("expect %v refreshed access key, got %v", e, a)
+	}
+	if e, a := 2, client.calls; e != a {
+		t.Errorf("expect %v calls after expiry, got %v", e, a)
+	}
+}
+
+func TestRoleCredentialsProvider_Unauthorized(t *testing.T) {
+	cases := map[string]struct {
+		Err                  error
+		ExpectReauthenticate bool
+	}{
+		"unauthorized": {
+			Err:                  &types.UnauthorizedException{Message: aws.String("session token not found or invalid")},
+			ExpectReauthenticate: true,
+		},
+		"other error": {
+			Err: &types.TooManyRequestsException{},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockGetRoleCredentialsClient{err: c.Err}
+			provider := NewRoleCredentialsProvider(client, "111122223333", "ReadOnly", "token")
+
+			_, err := provider.Retrieve(context.Background())
+			if err == nil {
+				t.Fatalf("expect error, got none")
+			}
+
+			var reauthErr *ReauthenticationRequiredError
+			if e, a := c.ExpectReauthenticate, errors.As(err, &reauthErr); e != a {
+				t.Fatalf("expect %v reauthentication required, got %v, %v", e, a, err)
+			}
+			if !errors.Is(err, c.Err) {
+				t.Errorf("expect error to wrap %v, got %v", c.Err, err)
+			}
+		})
+	}
+}
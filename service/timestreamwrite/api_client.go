@@ -9,10 +9,12 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
 	smithy "github.com/aws/smithy-go"
 	"github.com/aws/smithy-go/logging"
 	"github.com/aws/smithy-go/middleware"
 	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"golang.org/x/time/rate"
 	"net/http"
 	"time"
 )
@@ -42,6 +44,12 @@ func New(options Options, optFns ...func(*Options)) *Client {
 
 	resolveDefaultEndpointConfiguration(&options)
 
+	resolveWriteRateLimiter(&options)
+
+	resolveTableRetentionCache(&options)
+
+	resolveConcurrencyLimiter(&options)
+
 	for _, fn := range optFns {
 		fn(&options)
 	}
@@ -59,31 +67,181 @@ type Options struct {
 	// modify this list for per operation behavior.
 	APIOptions []func(*middleware.Stack) error
 
+	// The optional application specific identifier appended to the User-Agent
+	// header as "app/<AppID>". Characters not valid within a User-Agent token are
+	// replaced with "-".
+	AppID string
+
 	// Configures the events that will be sent to the configured logger.
 	ClientLogMode aws.ClientLogMode
 
-	// The credentials object to use when signing requests.
+	// The header the correlation ID of an operation's context, set with
+	// awsmiddleware.WithCorrelationID, is sent in. Defaults to X-Correlation-Id.
+	CorrelationIDHeader string
+
+	// The credentials object to use when signing requests. Set as an operation
+	// option, such as with WithCredentials, to sign that operation's requests only.
 	Credentials aws.CredentialsProvider
 
+	// The TimeUnit of WriteRecords records that do not set a TimeUnit, either
+	// themselves or in the request's CommonAttributes. If empty, the service
+	// assumes MILLISECONDS for such records.
+	DefaultTimeUnit types.TimeUnit
+
 	// The endpoint options to be used when attempting to resolve an endpoint.
 	EndpointOptions EndpointResolverOptions
 
-	// The service endpoint resolver.
+	// The service endpoint resolver. If the default endpoint resolver is used, the
+	// endpoint URL can be overridden with the environment variable named by
+	// aws.ServiceEndpointURLEnvVar, such as for sending requests to a local
+	// emulator.
 	EndpointResolver EndpointResolver
 
+	// The per operation endpoint resolver, called with the name of the operation
+	// and the client's region before the EndpointResolver. If it returns an
+	// aws.EndpointNotFoundError the endpoint will be resolved by the
+	// EndpointResolver instead. Operation endpoint host prefixes are applied to
+	// the endpoint returned, unless it is HostnameImmutable.
+	EndpointResolverFunc func(operation, region string) (aws.Endpoint, error)
+
 	// Signature Version 4 (SigV4) Signer
 	HTTPSignerV4 HTTPSignerV4
 
+	// The duration after which a second, identical, request is sent if a response
+	// to the first request has not been received. The first response received is
+	// used, and the other request is canceled. Only applies to the idempotent
+	// Describe, Get, and List operations of the client. Zero disables hedging.
+	HedgeAfter time.Duration
+
 	// The logger writer interface to write logging messages to.
 	Logger logging.Logger
 
+	// The maximum number of operations the client invokes concurrently. Operations
+	// over the limit wait until another operation completes, or their context is
+	// done. The limit is shared by all operations of the client, and cannot be
+	// changed per operation. Zero means unlimited.
+	MaxConcurrentRequests int
+
+	// The maximum number of bytes of a response body the client will read. If the
+	// response body is larger, the operation returns an awshttp.ErrResponseTooLarge.
+	// Zero means unlimited.
+	MaxResponseBytes int64
+
+	// The function called with the name of the operation and a copy of the
+	// serialized body of each request the client sends, such as for auditing.
+	// The body is empty if the request has no body. The request body is read into
+	// memory in order to be copied.
+	OnRequestBody func(opName string, body []byte)
+
+	// The function called with the name of the operation, and the status code and
+	// a copy of the body of each response the client receives, before the
+	// response is deserialized, such as for auditing. The body is empty if the
+	// response has no body. The response body is read into memory in order to be
+	// copied.
+	OnResponseBody func(opName string, status int, body []byte)
+
+	// The headers, such as X-Amz-Target, that are restored to their serialized
+	// values, with a warning logged, if they are removed from a request after it
+	// is serialized, such as by a middleware or HTTP client wrapper. The headers
+	// are restored before the request is signed.
+	PreserveHeaders []string
+
+	// Indents the serialized JSON body of requests, for debugging. The indented
+	// body is sent, and is the body logged and passed to OnRequestBody. Disabled
+	// by default.
+	PrettyJSON bool
+
 	// The region to send requests to. (Required)
 	Region string
 
+	// How WriteRecords handles records with a Time before the end of the
+	// table's memory store window, which the service rejects. The window is
+	// computed from the table's memory store retention, retrieved with
+	// DescribeTable and cached by the client. Defaults to
+	// OutOfWindowRecordsModeSend, sending every record.
+	RejectOutOfWindowRecords OutOfWindowRecordsMode
+
+	// Reject WriteRecords operations with a record, or common attributes, whose
+	// MeasureValueType or TimeUnit is not one of the values known to the client, with
+	// a parameter validation error, without the request being sent. Values the service
+	// added after the client was released are rejected as well.
+	RejectUnknownEnumValues bool
+
+	// The tag keys the Tags of the client's CreateDatabase and CreateTable
+	// operations must include. Operations missing any of the keys fail with a
+	// parameter validation error, without the request being sent.
+	RequiredTags []string
+
+	// The retry token budget every retry attempt of the client's operations must
+	// retrieve a token from, in addition to the Retryer's own retry quota. Share a
+	// budget, such as a ratelimit.TokenRateLimit, between clients to cap the retry
+	// attempts they make collectively. When the budget is exhausted the error of
+	// the failed attempt is returned without being retried. Nil means no budget.
+	RetryBudget retry.RateLimiter
+
+	// Retry transient network errors, such as connection resets, unexpected EOFs, and
+	// network timeouts, including those that occur while reading the response, for
+	// operations modeled as read-only or idempotent. Other operations are retried as
+	// determined by the Retryer, since the failed request may have been received by
+	// the service.
+	RetryTransientNetworkErrors bool
+
 	// Retryer guides how HTTP requests should be retried in case of recoverable
 	// failures. When nil the API client will use a default retryer.
 	Retryer aws.Retryer
 
+	// Completes WriteRecords operations without sending the request, after the
+	// input is validated and serialized, such as for testing how records are
+	// shaped. The request that would have been sent is returned by
+	// GetSimulatedRequest from the output's ResultMetadata. The
+	// RejectOutOfWindowRecords check is still made, which may describe the
+	// table.
+	SimulateOnly bool
+
+	// The function called with the name of the operation when each operation of
+	// the client is invoked, to start a tracing span for the operation. The
+	// returned context is used for the rest of the operation, and the returned
+	// function is called with the operation's final error, or nil, to end the
+	// span once the operation has completed.
+	StartSpan func(ctx context.Context, opName string) (context.Context, func(err error))
+
+	// The target prefix, in place of Timestream_20181101, of the X-Amz-Target
+	// header of the client's requests, such as for testing against a mock server
+	// that expects a different API version. If empty, the prefix is unchanged.
+	TargetPrefixOverride string
+
+	// Sign the requests of the client's write operations with an unsigned
+	// payload, when sent over HTTPS, instead of the SHA256 hash of the payload.
+	// This avoids hashing large WriteRecords payloads, but the payload is no
+	// longer covered by the request's signature, and its integrity is protected
+	// by TLS alone. Requests sent over HTTP are always signed with the payload
+	// hash.
+	UseUnsignedPayload bool
+
+	// Allows you to enable the client's validation of response integrity using the
+	// CRC32 checksum of the X-Amz-Crc32 response header, when present. A response
+	// whose checksum does not match returns an error wrapping
+	// awshttp.ErrChecksumMismatch. Disabled by default.
+	ValidateResponseChecksum bool
+
+	// The maximum rate, in requests per second, the client will send WriteRecords
+	// requests at, including retry attempts. Requests that would exceed the
+	// rate wait until they are allowed, or the operation's context is done. The
+	// limit is shared by all WriteRecords operations of the client, and cannot be
+	// changed per operation other than to zero. Zero means unlimited.
+	WriteRateLimit rate.Limit
+
+	// The limiter enforcing MaxConcurrentRequests, shared by the client's
+	// operations.
+	concurrencyLimiter *awsmiddleware.SharedConcurrencyLimiter
+
+	// The cache of described tables used by RejectOutOfWindowRecords, shared by
+	// the client's operations.
+	tableRetentionCache *aws.DescribeCache
+
+	// The limiter enforcing WriteRateLimit, shared by the client's operations.
+	writeRateLimiter *sharedRateLimiter
+
 	// The HTTP client to invoke API calls with. Defaults to client's default HTTP
 	// implementation if nil.
 	HTTPClient HTTPClient
@@ -97,6 +255,14 @@ func WithAPIOptions(optFns ...func(*middleware.Stack) error) func(*Options) {
 	}
 }
 
+// WithCredentials returns a functional option for setting the Client's
+// Credentials option.
+func WithCredentials(v aws.CredentialsProvider) func(*Options) {
+	return func(o *Options) {
+		o.Credentials = v
+	}
+}
+
 // WithEndpointResolver returns a functional option for setting the Client's
 // EndpointResolver option.
 func WithEndpointResolver(v EndpointResolver) func(*Options) {
@@ -105,6 +271,13 @@ func WithEndpointResolver(v EndpointResolver) func(*Options) {
 	}
 }
 
+// WithRegion returns a functional option for setting the Client's Region option.
+func WithRegion(v string) func(*Options) {
+	return func(o *Options) {
+		o.Region = v
+	}
+}
+
 type HTTPClient interface {
 	Do(*http.Request) (*http.Response, error)
 }
@@ -234,13 +407,24 @@ func newDefaultV4Signer(o Options) *v4.Signer {
 }
 
 func addRetryMiddlewares(stack *middleware.Stack, o Options) error {
+	retryer := o.Retryer
+	if o.RetryBudget != nil {
+		retryer = retry.AddWithRetryBudget(retryer, o.RetryBudget)
+	}
 	mo := retry.AddRetryMiddlewaresOptions{
-		Retryer:          o.Retryer,
+		Retryer:          retryer,
 		LogRetryAttempts: o.ClientLogMode.IsRetries(),
 	}
 	return retry.AddRetryMiddlewares(stack, mo)
 }
 
+func addRetryTransientNetworkErrors(stack *middleware.Stack, o Options) error {
+	if !o.RetryTransientNetworkErrors {
+		return nil
+	}
+	return retry.AddRetryTransientNetworkErrors(stack)
+}
+
 func addRequestIDRetrieverMiddleware(stack *middleware.Stack) error {
 	return awsmiddleware.AddRequestIDRetrieverMiddleware(stack)
 }
@@ -257,3 +441,100 @@ func addRequestResponseLogging(stack *middleware.Stack, o Options) error {
 		LogResponseWithBody: o.ClientLogMode.IsResponseWithBody(),
 	}, middleware.After)
 }
+
+func addUserAgentAppID(stack *middleware.Stack, o Options) error {
+	return awsmiddleware.AddUserAgentAppID(o.AppID)(stack)
+}
+
+func addResponseSizeLimit(stack *middleware.Stack, o Options) error {
+	return awshttp.AddResponseSizeLimitMiddleware(stack, o.MaxResponseBytes)
+}
+
+func addOperationSpan(stack *middleware.Stack, o Options) error {
+	return awsmiddleware.AddOperationSpanMiddleware(stack, o.StartSpan)
+}
+
+func addOnRequestBody(stack *middleware.Stack, o Options) error {
+	return awshttp.AddRequestBodyMiddleware(stack, o.OnRequestBody)
+}
+
+func addValidateResponseChecksum(stack *middleware.Stack, o Options) error {
+	if !o.ValidateResponseChecksum {
+		return nil
+	}
+	return awshttp.AddValidateResponseCRC32ChecksumMiddleware(stack)
+}
+
+func resolveConcurrencyLimiter(o *Options) {
+	o.concurrencyLimiter = &awsmiddleware.SharedConcurrencyLimiter{}
+}
+
+func addConcurrencyLimit(stack *middleware.Stack, o Options) error {
+	return awsmiddleware.AddConcurrencyLimitMiddleware(stack, o.concurrencyLimiter.Get(o.MaxConcurrentRequests))
+}
+
+func addCorrelationID(stack *middleware.Stack, o Options) error {
+	return awsmiddleware.AddCorrelationIDMiddleware(stack, o.CorrelationIDHeader)
+}
+
+func addPrettyJSON(stack *middleware.Stack, o Options) error {
+	if !o.PrettyJSON {
+		return nil
+	}
+	return awshttp.AddPrettyJSONMiddleware(stack)
+}
+
+func addPreserveHeaders(stack *middleware.Stack, o Options) error {
+	return awshttp.AddPreserveHeadersMiddleware(stack, o.PreserveHeaders)
+}
+
+// NewOptions returns the Options composed from the functional options, such as
+// WithRegion and WithCredentials. The functional options are applied in order, so
+// later functional options override earlier ones.
+//
+//    client := New(NewOptions(
+//        WithRegion("us-west-2"),
+//        WithRetryMaxAttempts(5),
+//    ))
+func NewOptions(optFns ...func(*Options)) Options {
+	var o Options
+	for _, fn := range optFns {
+		fn(&o)
+	}
+	return o
+}
+
+// WithHTTPClient returns a functional option for setting the Client's HTTPClient
+// option.
+func WithHTTPClient(v HTTPClient) func(*Options) {
+	return func(o *Options) {
+		o.HTTPClient = v
+	}
+}
+
+// WithRetryMaxAttempts returns a functional option for setting the maximum number
+// of attempts of the Client's Retryer option, wrapping the Retryer if one is set,
+// or the default standard retryer otherwise.
+func WithRetryMaxAttempts(v int) func(*Options) {
+	return func(o *Options) {
+		if o.Retryer == nil {
+			o.Retryer = retry.NewStandard(func(so *retry.StandardOptions) {
+				so.MaxAttempts = v
+			})
+			return
+		}
+		o.Retryer = retry.AddWithMaxAttempts(o.Retryer, v)
+	}
+}
+
+func addOnResponseBody(stack *middleware.Stack, o Options) error {
+	return awshttp.AddResponseBodyMiddleware(stack, o.OnResponseBody)
+}
+
+func addRequestBodySize(stack *middleware.Stack) error {
+	return awshttp.AddRequestBodySizeMiddleware(stack)
+}
+
+func addDisableMiddlewareGuards(stack *middleware.Stack) error {
+	return awsmiddleware.AddDisableMiddlewareGuards(stack)
+}
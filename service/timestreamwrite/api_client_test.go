@@ -0,0 +1,1018 @@
+package timestreamwrite
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting/unit"
+	"github.com/aws/aws-sdk-go-v2/internal/sdk"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func newMockClient(fn func(*http.Request), optFns ...func(*Options)) *Client {
+	return New(Options{
+		Region:      "us-west-2",
+		Credentials: unit.StubCredentialsProvider{},
+		HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			if fn != nil {
+				fn(r)
+			}
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{}`))),
+			}, nil
+		}),
+	}, optFns...)
+}
+
+func TestClient_UserAgentAppID(t *testing.T) {
+	cases := map[string]struct {
+		AppID          string
+		ExpectContains string
+	}{
+		"no app id": {},
+		"app id": {
+			AppID:          "fleet-metrics",
+			ExpectContains: " app/fleet-metrics",
+		},
+		"invalid characters": {
+			AppID:          "fleet metrics/v1",
+			ExpectContains: " app/fleet-metrics-v1",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var ua string
+			client := newMockClient(func(r *http.Request) {
+				ua = r.Header.Get("User-Agent")
+			}, func(o *Options) {
+				o.AppID = c.AppID
+			})
+
+			_, err := client.DescribeDatabase(context.Background(), &DescribeDatabaseInput{
+				DatabaseName: aws.String("db"),
+			})
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := aws.SDKName+"/"+aws.SDKVersion, ua; !strings.HasPrefix(a, e) {
+				t.Errorf("expect User-Agent to start with %v, got %v", e, a)
+			}
+			if len(c.ExpectContains) == 0 {
+				if strings.Contains(ua, " app/") {
+					t.Errorf("expect no app id in User-Agent, got %v", ua)
+				}
+				return
+			}
+			if e, a := c.ExpectContains, ua; !strings.HasSuffix(a, e) {
+				t.Errorf("expect User-Agent to end with %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestClient_UserAgent(t *testing.T) {
+	var header http.Header
+	client := newMockClient(func(r *http.Request) {
+		header = r.Header.Clone()
+	}, func(o *Options) {
+		// Registering the user agent middleware a second time must not add a
+		// second User-Agent middleware, or duplicate the SDK version.
+		o.APIOptions = append(o.APIOptions, addClientUserAgent)
+	})
+
+	_, err := client.DescribeDatabase(context.Background(), &DescribeDatabaseInput{
+		DatabaseName: aws.String("db"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expectAgent := aws.SDKName + "/" + aws.SDKVersion
+	if e, a := expectAgent, header.Get("User-Agent"); e != a {
+		t.Errorf("expect %v User-Agent, got %v", e, a)
+	}
+	if e, a := expectAgent, header.Get("X-Amz-User-Agent"); !strings.HasPrefix(a, e) {
+		t.Errorf("expect X-Amz-User-Agent to start with %v, got %v", e, a)
+	}
+	if e, a := 1, strings.Count(header.Get("X-Amz-User-Agent"), expectAgent); e != a {
+		t.Errorf("expect SDK version %v times, got %v", e, a)
+	}
+}
+
+func TestAddClientUserAgent_NoDuplicate(t *testing.T) {
+	stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+	if err := addOperationDescribeDatabaseMiddlewares(stack, Options{}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if err := addClientUserAgent(stack); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	var count int
+	for _, id := range stack.Build.List() {
+		if id == "UserAgent" {
+			count++
+		}
+	}
+	if e, a := 1, count; e != a {
+		t.Errorf("expect %v UserAgent middleware, got %v", e, a)
+	}
+}
+
+func TestClient_MaxResponseBytes(t *testing.T) {
+	const body = `{"Database":{"DatabaseName":"db"}}`
+
+	cases := map[string]struct {
+		MaxResponseBytes int64
+		ExpectErr        bool
+	}{
+		"unlimited": {},
+		"at limit": {
+			MaxResponseBytes: int64(len(body)),
+		},
+		"over limit": {
+			MaxResponseBytes: int64(len(body) - 1),
+			ExpectErr:        true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := newMockClient(nil, func(o *Options) {
+				o.MaxResponseBytes = c.MaxResponseBytes
+				o.HTTPClient = smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: 200,
+						Header:     http.Header{},
+						Body:       ioutil.NopCloser(strings.NewReader(body)),
+					}, nil
+				})
+			})
+
+			out, err := client.DescribeDatabase(context.Background(), &DescribeDatabaseInput{
+				DatabaseName: aws.String("db"),
+			})
+			if !c.ExpectErr {
+				if err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+				if e, a := "db", aws.ToString(out.Database.DatabaseName); e != a {
+					t.Errorf("expect %v database, got %v", e, a)
+				}
+				return
+			}
+
+			var tooLargeErr *awshttp.ErrResponseTooLarge
+			if !errors.As(err, &tooLargeErr) {
+				t.Fatalf("expect %T error, got %v", tooLargeErr, err)
+			}
+		})
+	}
+}
+
+func TestClient_StackString(t *testing.T) {
+	var dump string
+	client := newMockClient(nil, func(o *Options) {
+		o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+			dump = stack.String()
+			return nil
+		})
+	})
+
+	_, err := client.DescribeDatabase(context.Background(), &DescribeDatabaseInput{
+		DatabaseName: aws.String("db"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expectInOrder := []string{
+		"Initialize stack step\n",
+		"\t\tRegisterServiceMetadata\n",
+		"\t\tOperationInputValidation\n",
+		"Serialize stack step\n",
+		"\t\tOperationSerializer\n",
+		"Build stack step\n",
+		"\t\tComputeContentLength\n",
+		"Finalize stack step\n",
+		"\t\tRetry\n",
+		"\t\tSigning\n",
+		"Deserialize stack step\n",
+		"\t\tOperationDeserializer\n",
+	}
+	remaining := dump
+	for _, id := range expectInOrder {
+		i := strings.Index(remaining, id)
+		if i < 0 {
+			t.Fatalf("expect %q in order in stack dump\n%v", id, dump)
+		}
+		remaining = remaining[i+len(id):]
+	}
+}
+
+func TestClient_HedgeAfter(t *testing.T) {
+	cases := map[string]struct {
+		Invoke         func(*Client) error
+		ExpectRequests int32
+	}{
+		"describe hedged": {
+			Invoke: func(c *Client) error {
+				_, err := c.DescribeDatabase(context.Background(), &DescribeDatabaseInput{
+					DatabaseName: aws.String("db"),
+				})
+				return err
+			},
+			ExpectRequests: 2,
+		},
+		"write not hedged": {
+			Invoke: func(c *Client) error {
+				_, err := c.DeleteDatabase(context.Background(), &DeleteDatabaseInput{
+					DatabaseName: aws.String("db"),
+				})
+				return err
+			},
+			ExpectRequests: 1,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var requests int32
+			client := newMockClient(nil, func(o *Options) {
+				o.HedgeAfter = 10 * time.Millisecond
+				o.HTTPClient = smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+					// The first request is slow, subsequent requests are fast.
+					if atomic.AddInt32(&requests, 1) == 1 {
+						select {
+						case <-time.After(100 * time.Millisecond):
+						case <-r.Context().Done():
+							return nil, r.Context().Err()
+						}
+					}
+					return &http.Response{
+						StatusCode: 200,
+						Header:     http.Header{},
+						Body:       ioutil.NopCloser(strings.NewReader(`{}`)),
+					}, nil
+				})
+			})
+
+			if err := c.Invoke(client); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectRequests, atomic.LoadInt32(&requests); e != a {
+				t.Errorf("expect %v requests, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestClient_UseUnsignedPayload(t *testing.T) {
+	cases := map[string]struct {
+		UseUnsignedPayload bool
+		Invoke             func(*Client) error
+		ExpectHeader       string
+	}{
+		"disabled": {
+			Invoke: func(c *Client) error {
+				_, err := c.DeleteDatabase(context.Background(), &DeleteDatabaseInput{
+					DatabaseName: aws.String("db"),
+				})
+				return err
+			},
+		},
+		"write operation": {
+			UseUnsignedPayload: true,
+			Invoke: func(c *Client) error {
+				_, err := c.DeleteDatabase(context.Background(), &DeleteDatabaseInput{
+					DatabaseName: aws.String("db"),
+				})
+				return err
+			},
+			ExpectHeader: "UNSIGNED-PAYLOAD",
+		},
+		"read operation": {
+			UseUnsignedPayload: true,
+			Invoke: func(c *Client) error {
+				_, err := c.DescribeDatabase(context.Background(), &DescribeDatabaseInput{
+					DatabaseName: aws.String("db"),
+				})
+				return err
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var header, authorization string
+			client := newMockClient(func(r *http.Request) {
+				header = r.Header.Get("X-Amz-Content-Sha256")
+				authorization = r.Header.Get("Authorization")
+			}, func(o *Options) {
+				o.UseUnsignedPayload = c.UseUnsignedPayload
+			})
+
+			if err := c.Invoke(client); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectHeader, header; e != a {
+				t.Errorf("expect %q content sha256 header, got %q", e, a)
+			}
+			if len(authorization) == 0 {
+				t.Errorf("expect request to be signed")
+			}
+		})
+	}
+}
+
+func TestClient_WithAPIOptions(t *testing.T) {
+	var counts []string
+	counter := func(id string) func(*middleware.Stack) error {
+		return func(stack *middleware.Stack) error {
+			return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(id,
+				func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (
+					out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+				) {
+					counts = append(counts, stack.ID())
+					return next.HandleInitialize(ctx, in)
+				}), middleware.Before)
+		}
+	}
+
+	client := newMockClient(nil, WithAPIOptions(counter("clientCounter")))
+
+	if _, err := client.DescribeDatabase(context.Background(), &DescribeDatabaseInput{
+		DatabaseName: aws.String("db"),
+	}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if _, err := client.ListTables(context.Background(), &ListTablesInput{}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if _, err := client.DeleteDatabase(context.Background(), &DeleteDatabaseInput{
+		DatabaseName: aws.String("db"),
+	}, WithAPIOptions(counter("operationCounter"))); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expect := []string{"DescribeDatabase", "ListTables", "DeleteDatabase", "DeleteDatabase"}
+	if e, a := expect, counts; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v operations counted, got %v", e, a)
+	}
+	if e, a := 1, len(client.options.APIOptions); e != a {
+		t.Errorf("expect per operation options to not modify client, expect %v APIOptions, got %v", e, a)
+	}
+}
+
+func TestClient_EndpointResolverFunc(t *testing.T) {
+	cases := map[string]struct {
+		Invoke     func(*Client) error
+		ExpectHost string
+	}{
+		"overridden operation": {
+			Invoke: func(c *Client) error {
+				_, err := c.DescribeDatabase(context.Background(), &DescribeDatabaseInput{
+					DatabaseName: aws.String("db"),
+				})
+				return err
+			},
+			ExpectHost: "timestream.vpce.example.com",
+		},
+		"default operation": {
+			Invoke: func(c *Client) error {
+				_, err := c.ListDatabases(context.Background(), &ListDatabasesInput{})
+				return err
+			},
+			ExpectHost: "ingest.timestream.us-west-2.amazonaws.com",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var host, authorization string
+			client := newMockClient(func(r *http.Request) {
+				host = r.URL.Host
+				authorization = r.Header.Get("Authorization")
+			}, func(o *Options) {
+				o.EndpointResolverFunc = func(operation, region string) (aws.Endpoint, error) {
+					if operation != "DescribeDatabase" {
+						return aws.Endpoint{}, &aws.EndpointNotFoundError{}
+					}
+					return aws.Endpoint{URL: "https://timestream.vpce.example.com"}, nil
+				}
+			})
+
+			if err := c.Invoke(client); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectHost, host; e != a {
+				t.Errorf("expect %v host, got %v", e, a)
+			}
+			if e, a := "/us-west-2/timestream/aws4_request", authorization; !strings.Contains(a, e) {
+				t.Errorf("expect %v signing scope in %v", e, a)
+			}
+		})
+	}
+}
+
+func TestClient_EndpointResolverFuncError(t *testing.T) {
+	client := newMockClient(nil, func(o *Options) {
+		o.EndpointResolverFunc = func(operation, region string) (aws.Endpoint, error) {
+			return aws.Endpoint{}, fmt.Errorf("no private link for %s", region)
+		}
+	})
+
+	_, err := client.DescribeDatabase(context.Background(), &DescribeDatabaseInput{
+		DatabaseName: aws.String("db"),
+	})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := "no private link for us-west-2", err.Error(); !strings.Contains(a, e) {
+		t.Errorf("expect %v in error, got %v", e, a)
+	}
+}
+
+func TestClient_RetryBudget(t *testing.T) {
+	restoreSleep := sdk.TestingUseNopSleep()
+	defer restoreSleep()
+
+	var requests int
+	client := newMockClient(nil, func(o *Options) {
+		o.RetryBudget = ratelimit.NewTokenRateLimit(2 * retry.DefaultRetryCost)
+		o.HTTPClient = smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{
+				StatusCode: 503,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(strings.NewReader(`{}`)),
+			}, nil
+		})
+	})
+
+	// The first operation is retried until the budget is exhausted, the second
+	// operation is not retried.
+	for _, expect := range []int{3, 1} {
+		requests = 0
+		_, err := client.DescribeDatabase(context.Background(), &DescribeDatabaseInput{
+			DatabaseName: aws.String("db"),
+		})
+		if err == nil {
+			t.Fatalf("expect error, got none")
+		}
+		if e, a := expect, requests; e != a {
+			t.Errorf("expect %v requests, got %v", e, a)
+		}
+
+		var respErr *smithyhttp.ResponseError
+		if !errors.As(err, &respErr) {
+			t.Fatalf("expect %T error, got %v", respErr, err)
+		}
+		if e, a := 503, respErr.HTTPStatusCode(); e != a {
+			t.Errorf("expect %v status code, got %v", e, a)
+		}
+	}
+}
+
+func TestClient_StartSpan(t *testing.T) {
+	type span struct {
+		Operation string
+		Finished  int
+		Err       error
+	}
+	var spans []*span
+
+	client := newMockClient(nil, func(o *Options) {
+		o.StartSpan = func(ctx context.Context, opName string) (context.Context, func(error)) {
+			s := &span{Operation: opName}
+			spans = append(spans, s)
+			return ctx, func(err error) {
+				s.Finished++
+				s.Err = err
+			}
+		}
+	})
+
+	_, err := client.DescribeDatabase(context.Background(), &DescribeDatabaseInput{
+		DatabaseName: aws.String("db"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	// Missing the required TableName fails validation.
+	_, err = client.DescribeTable(context.Background(), &DescribeTableInput{
+		DatabaseName: aws.String("db"),
+	})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+
+	if e, a := 2, len(spans); e != a {
+		t.Fatalf("expect %v spans, got %v", e, a)
+	}
+	for i, op := range []string{"DescribeDatabase", "DescribeTable"} {
+		if e, a := op, spans[i].Operation; e != a {
+			t.Errorf("expect %v span operation, got %v", e, a)
+		}
+		if e, a := 1, spans[i].Finished; e != a {
+			t.Errorf("expect %v span finished %v times, got %v", op, e, a)
+		}
+	}
+	if spans[0].Err != nil {
+		t.Errorf("expect no DescribeDatabase span error, got %v", spans[0].Err)
+	}
+	var invalidParams smithy.InvalidParamsError
+	if !errors.As(spans[1].Err, &invalidParams) {
+		t.Errorf("expect DescribeTable span %T error, got %v", invalidParams, spans[1].Err)
+	}
+}
+
+func TestClient_OnRequestBody(t *testing.T) {
+	var sent []byte
+	var captured []string
+	var capturedBody []byte
+
+	client := newMockClient(func(r *http.Request) {
+		sent, _ = ioutil.ReadAll(r.Body)
+	}, func(o *Options) {
+		o.OnRequestBody = func(opName string, body []byte) {
+			captured = append(captured, opName)
+			capturedBody = body
+		}
+	})
+
+	_, err := client.DescribeDatabase(context.Background(), &DescribeDatabaseInput{
+		DatabaseName: aws.String("db"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := []string{"DescribeDatabase"}, captured; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v operations captured, got %v", e, a)
+	}
+	if e, a := `{"DatabaseName":"db"}`, string(capturedBody); e != a {
+		t.Errorf("expect %v captured body, got %v", e, a)
+	}
+	if e, a := sent, capturedBody; !bytes.Equal(e, a) {
+		t.Errorf("expect captured body to match sent body %q, got %q", e, a)
+	}
+}
+
+func TestClient_OnResponseBody(t *testing.T) {
+	const responseBody = `{"Database":{"DatabaseName":"db","TableCount":2}}`
+
+	var captured []string
+	var capturedStatus int
+	var capturedBody []byte
+
+	client := New(Options{
+		Region:      "us-west-2",
+		Credentials: unit.StubCredentialsProvider{},
+		HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(strings.NewReader(responseBody)),
+			}, nil
+		}),
+		OnResponseBody: func(opName string, status int, body []byte) {
+			captured = append(captured, opName)
+			capturedStatus = status
+			capturedBody = body
+		},
+	})
+
+	out, err := client.DescribeDatabase(context.Background(), &DescribeDatabaseInput{
+		DatabaseName: aws.String("db"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := []string{"DescribeDatabase"}, captured; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v operations captured, got %v", e, a)
+	}
+	if e, a := 200, capturedStatus; e != a {
+		t.Errorf("expect %v captured status, got %v", e, a)
+	}
+	if e, a := responseBody, string(capturedBody); e != a {
+		t.Errorf("expect %v captured body, got %v", e, a)
+	}
+	if e, a := "db", aws.ToString(out.Database.DatabaseName); e != a {
+		t.Errorf("expect %v database, got %v", e, a)
+	}
+	if e, a := int64(2), out.Database.TableCount; e != a {
+		t.Errorf("expect %v table count, got %v", e, a)
+	}
+}
+
+func TestClient_WithCredentials(t *testing.T) {
+	var mu sync.Mutex
+	authorizations := map[string]string{}
+
+	client := newMockClient(func(r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		authorizations[r.Header.Get("X-Test-Call")] = r.Header.Get("Authorization")
+	})
+
+	credentials := func(akid string) aws.CredentialsProvider {
+		return aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: akid, SecretAccessKey: "SECRET"}, nil
+		})
+	}
+	testCallHeader := func(v string) func(*middleware.Stack) error {
+		return func(stack *middleware.Stack) error {
+			return stack.Build.Add(middleware.BuildMiddlewareFunc("TestCallHeader",
+				func(ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler) (
+					middleware.BuildOutput, middleware.Metadata, error,
+				) {
+					in.Request.(*smithyhttp.Request).Header.Set("X-Test-Call", v)
+					return next.HandleBuild(ctx, in)
+				}), middleware.After)
+		}
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 2)
+	for _, akid := range []string{"AKID_A", "AKID_B"} {
+		wg.Add(1)
+		go func(akid string) {
+			defer wg.Done()
+			_, err := client.DescribeDatabase(context.Background(), &DescribeDatabaseInput{
+				DatabaseName: aws.String("db"),
+			}, WithCredentials(credentials(akid)), WithAPIOptions(testCallHeader(akid)))
+			errs <- err
+		}(akid)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+	}
+
+	for _, akid := range []string{"AKID_A", "AKID_B"} {
+		if e, a := "Credential="+akid+"/", authorizations[akid]; !strings.Contains(a, e) {
+			t.Errorf("expect %v in authorization, got %v", e, a)
+		}
+	}
+	if _, ok := client.options.Credentials.(unit.StubCredentialsProvider); !ok {
+		t.Errorf("expect client credentials not to be modified, got %T", client.options.Credentials)
+	}
+}
+
+func TestClient_ValidateResponseChecksum(t *testing.T) {
+	const body = `{"Databases":[]}`
+
+	cases := map[string]struct {
+		Validate  bool
+		Checksum  string
+		ExpectErr bool
+	}{
+		"disabled": {
+			Checksum: "1234",
+		},
+		"matching checksum": {
+			Validate: true,
+			Checksum: fmt.Sprint(crc32.ChecksumIEEE([]byte(body))),
+		},
+		"no checksum": {
+			Validate: true,
+		},
+		"mismatched checksum": {
+			Validate:  true,
+			Checksum:  "1234",
+			ExpectErr: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := New(Options{
+				Region:                   "us-west-2",
+				Credentials:              unit.StubCredentialsProvider{},
+				ValidateResponseChecksum: c.Validate,
+				HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+					header := http.Header{}
+					if len(c.Checksum) != 0 {
+						header.Set("X-Amz-Crc32", c.Checksum)
+					}
+					return &http.Response{
+						StatusCode: 200,
+						Header:     header,
+						Body:       ioutil.NopCloser(strings.NewReader(body)),
+					}, nil
+				}),
+			})
+
+			_, err := client.ListDatabases(context.Background(), &ListDatabasesInput{})
+			if c.ExpectErr {
+				if !errors.Is(err, awshttp.ErrChecksumMismatch) {
+					t.Fatalf("expect checksum mismatch error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestClient_PrettyJSON(t *testing.T) {
+	cases := map[string]struct {
+		PrettyJSON bool
+		Expect     string
+	}{
+		"compact": {
+			Expect: `{"DatabaseName":"db","Records":[{"MeasureName":"cpu","MeasureValue":"13.5"}],"TableName":"table"}`,
+		},
+		"indented": {
+			PrettyJSON: true,
+			Expect: `{
+  "DatabaseName": "db",
+  "Records": [
+    {
+      "MeasureName": "cpu",
+      "MeasureValue": "13.5"
+    }
+  ],
+  "TableName": "table"
+}`,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var body []byte
+			client := newMockClient(func(r *http.Request) {
+				body, _ = ioutil.ReadAll(r.Body)
+			}, func(o *Options) {
+				o.PrettyJSON = c.PrettyJSON
+			})
+
+			_, err := client.WriteRecords(context.Background(), &WriteRecordsInput{
+				DatabaseName: aws.String("db"),
+				TableName:    aws.String("table"),
+				Records: []types.Record{
+					{MeasureName: aws.String("cpu"), MeasureValue: aws.String("13.5")},
+				},
+			})
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.Expect, string(body); e != a {
+				t.Errorf("expect %v body, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestClient_EndpointURLFromEnv(t *testing.T) {
+	restoreEnv := awstesting.StashEnv()
+	defer awstesting.PopEnv(restoreEnv)
+	os.Setenv("AWS_ENDPOINT_URL_TIMESTREAM_WRITE", "http://localhost:4566")
+
+	var host string
+	client := newMockClient(func(r *http.Request) {
+		host = r.URL.Host
+	})
+
+	_, err := client.WriteRecords(context.Background(), &WriteRecordsInput{
+		DatabaseName: aws.String("db"),
+		TableName:    aws.String("table"),
+		Records: []types.Record{
+			{MeasureName: aws.String("cpu"), MeasureValue: aws.String("13.5")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "localhost:4566", host; e != a {
+		t.Errorf("expect %v host, got %v", e, a)
+	}
+}
+
+func TestClient_PreserveHeaders(t *testing.T) {
+	cases := map[string]struct {
+		PreserveHeaders []string
+		ExpectTarget    string
+	}{
+		"stripped": {},
+		"restored": {
+			PreserveHeaders: []string{"X-Amz-Target"},
+			ExpectTarget:    "Timestream_20181101.DescribeDatabase",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var target string
+			client := newMockClient(func(r *http.Request) {
+				target = r.Header.Get("X-Amz-Target")
+			}, func(o *Options) {
+				o.PreserveHeaders = c.PreserveHeaders
+				o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+					return stack.Build.Add(middleware.BuildMiddlewareFunc("StripTarget", func(
+						ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler,
+					) (
+						out middleware.BuildOutput, metadata middleware.Metadata, err error,
+					) {
+						in.Request.(*smithyhttp.Request).Header.Del("X-Amz-Target")
+						return next.HandleBuild(ctx, in)
+					}), middleware.After)
+				})
+			})
+
+			_, err := client.DescribeDatabase(context.Background(), &DescribeDatabaseInput{
+				DatabaseName: aws.String("db"),
+			})
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectTarget, target; e != a {
+				t.Errorf("expect %q target, got %q", e, a)
+			}
+		})
+	}
+}
+
+func TestNewOptions(t *testing.T) {
+	httpClient := smithyhttp.NopClient{}
+
+	cases := map[string]struct {
+		OptFns            []func(*Options)
+		ExpectRegion      string
+		ExpectMaxAttempts int
+	}{
+		"empty": {},
+		"composed": {
+			OptFns: []func(*Options){
+				WithRegion("us-west-2"),
+				WithCredentials(unit.StubCredentialsProvider{}),
+				WithHTTPClient(httpClient),
+				WithRetryMaxAttempts(5),
+			},
+			ExpectRegion:      "us-west-2",
+			ExpectMaxAttempts: 5,
+		},
+		"later overrides earlier": {
+			OptFns: []func(*Options){
+				WithRegion("us-west-2"),
+				WithRetryMaxAttempts(5),
+				WithRegion("eu-west-1"),
+				WithRetryMaxAttempts(2),
+			},
+			ExpectRegion:      "eu-west-1",
+			ExpectMaxAttempts: 2,
+		},
+		"wraps retryer": {
+			OptFns: []func(*Options){
+				func(o *Options) { o.Retryer = retry.NewStandard() },
+				WithRetryMaxAttempts(7),
+			},
+			ExpectMaxAttempts: 7,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := NewOptions(c.OptFns...)
+			if e, a := c.ExpectRegion, o.Region; e != a {
+				t.Errorf("expect %v region, got %v", e, a)
+			}
+			if c.ExpectMaxAttempts == 0 {
+				if o.Retryer != nil {
+					t.Errorf("expect no retryer, got %T", o.Retryer)
+				}
+				return
+			}
+			if e, a := c.ExpectMaxAttempts, o.Retryer.MaxAttempts(); e != a {
+				t.Errorf("expect %v max attempts, got %v", e, a)
+			}
+		})
+	}
+
+	o := NewOptions(WithCredentials(unit.StubCredentialsProvider{}), WithHTTPClient(httpClient))
+	if o.Credentials == nil {
+		t.Errorf("expect credentials to be set")
+	}
+	if e, a := httpClient, o.HTTPClient; e != a {
+		t.Errorf("expect %v HTTP client, got %v", e, a)
+	}
+}
+
+func TestWithRetryMaxAttempts_PerOperation(t *testing.T) {
+	restoreSleep := sdk.TestingUseNopSleep()
+	defer restoreSleep()
+
+	var attempts int
+	client := New(Options{
+		Region:      "us-west-2",
+		Credentials: unit.StubCredentialsProvider{},
+		HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			attempts++
+			return &http.Response{
+				StatusCode: 500,
+				Header:     http.Header{},
+				Body: ioutil.NopCloser(strings.NewReader(
+					`{"__type":"InternalServerException","message":"internal error"}`)),
+			}, nil
+		}),
+	})
+
+	cases := []struct {
+		OptFns         []func(*Options)
+		ExpectAttempts int
+	}{
+		{
+			OptFns:         []func(*Options){WithRetryMaxAttempts(1)},
+			ExpectAttempts: 1,
+		},
+		{
+			ExpectAttempts: retry.DefaultMaxAttempts,
+		},
+		{
+			OptFns:         []func(*Options){WithRetryMaxAttempts(2)},
+			ExpectAttempts: 2,
+		},
+	}
+
+	for i, c := range cases {
+		attempts = 0
+		_, err := client.DescribeDatabase(context.Background(), &DescribeDatabaseInput{
+			DatabaseName: aws.String("db"),
+		}, c.OptFns...)
+		if err == nil {
+			t.Fatalf("%d, expect error, got none", i)
+		}
+		if e, a := c.ExpectAttempts, attempts; e != a {
+			t.Errorf("%d, expect %v attempts, got %v", i, e, a)
+		}
+	}
+}
+
+func TestClient_RequestBodyBytes(t *testing.T) {
+	var body []byte
+	client := newMockClient(func(r *http.Request) {
+		var err error
+		if body, err = ioutil.ReadAll(r.Body); err != nil {
+			t.Errorf("expect no error, got %v", err)
+		}
+	})
+
+	out, err := client.WriteRecords(context.Background(), &WriteRecordsInput{
+		DatabaseName: aws.String("db"),
+		TableName:    aws.String("table"),
+		Records: []types.Record{
+			{
+				MeasureName:      aws.String("cpu"),
+				MeasureValue:     aws.String("13.5"),
+				MeasureValueType: types.MeasureValueTypeDouble,
+				Time:             aws.String("1612325106123"),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	size, ok := awshttp.GetRequestBodyBytes(out.ResultMetadata)
+	if !ok {
+		t.Fatalf("expect request body bytes to be recorded")
+	}
+	if len(body) == 0 {
+		t.Fatalf("expect request body to be sent")
+	}
+	if e, a := int64(len(body)), size; e != a {
+		t.Errorf("expect %v request body bytes, got %v", e, a)
+	}
+}
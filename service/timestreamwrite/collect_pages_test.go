@@ -0,0 +1,40 @@
+//go:build go1.21
+// +build go1.21
+
+package timestreamwrite
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+)
+
+func TestCollectPages_ListDatabases(t *testing.T) {
+	client := &mockListDatabasesPagesClient{
+		pages: [][]types.Database{
+			{{DatabaseName: aws.String("a")}, {DatabaseName: aws.String("b")}},
+			{},
+			{{DatabaseName: aws.String("c")}},
+		},
+	}
+
+	databases, err := aws.CollectPages(context.Background(),
+		NewListDatabasesPaginator(client, &ListDatabasesInput{}),
+		func(page *ListDatabasesOutput) []types.Database {
+			return page.Databases
+		})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	var names []string
+	for _, db := range databases {
+		names = append(names, aws.ToString(db.DatabaseName))
+	}
+	if e, a := []string{"a", "b", "c"}, names; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v databases, got %v", e, a)
+	}
+}
@@ -0,0 +1,58 @@
+package timestreamwrite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// addDefaultTimeUnit adds the middleware setting the client's DefaultTimeUnit
+// on the records of WriteRecords operations that do not set a TimeUnit.
+func addDefaultTimeUnit(stack *middleware.Stack, o Options) error {
+	if len(o.DefaultTimeUnit) == 0 {
+		return nil
+	}
+	return stack.Initialize.Add(&defaultTimeUnit{unit: o.DefaultTimeUnit}, middleware.Before)
+}
+
+// defaultTimeUnit sets the TimeUnit of the records of a WriteRecords request
+// that do not specify one, and do not inherit one from the common attributes.
+type defaultTimeUnit struct {
+	unit types.TimeUnit
+}
+
+func (*defaultTimeUnit) ID() string {
+	return "DefaultTimeUnit"
+}
+
+func (m *defaultTimeUnit) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	input, ok := in.Parameters.(*WriteRecordsInput)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown input parameters type %T", in.Parameters)
+	}
+	if input.CommonAttributes != nil && len(input.CommonAttributes.TimeUnit) != 0 {
+		return next.HandleInitialize(ctx, in)
+	}
+
+	// Copy the input so the caller's parameters are not modified.
+	params := *input
+	if input.CommonAttributes != nil {
+		common := *input.CommonAttributes
+		common.TimeUnit = m.unit
+		params.CommonAttributes = &common
+	}
+	params.Records = make([]types.Record, len(input.Records))
+	for i, r := range input.Records {
+		if len(r.TimeUnit) == 0 {
+			r.TimeUnit = m.unit
+		}
+		params.Records[i] = r
+	}
+	in.Parameters = &params
+
+	return next.HandleInitialize(ctx, in)
+}
@@ -0,0 +1,104 @@
+package timestreamwrite
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+)
+
+func TestClient_DefaultTimeUnit(t *testing.T) {
+	cases := map[string]struct {
+		DefaultTimeUnit  types.TimeUnit
+		CommonAttributes *types.Record
+		Records          []types.Record
+		ExpectCommonUnit string
+		ExpectUnits      []string
+	}{
+		"no default": {
+			Records: []types.Record{
+				{Time: aws.String("1")},
+				{Time: aws.String("2"), TimeUnit: types.TimeUnitSeconds},
+			},
+			ExpectUnits: []string{"", "SECONDS"},
+		},
+		"records lacking unit": {
+			DefaultTimeUnit: types.TimeUnitNanoseconds,
+			Records: []types.Record{
+				{Time: aws.String("1")},
+				{Time: aws.String("2"), TimeUnit: types.TimeUnitSeconds},
+			},
+			ExpectUnits: []string{"NANOSECONDS", "SECONDS"},
+		},
+		"common attributes lacking unit": {
+			DefaultTimeUnit:  types.TimeUnitNanoseconds,
+			CommonAttributes: &types.Record{Time: aws.String("1")},
+			Records: []types.Record{
+				{MeasureName: aws.String("cpu")},
+				{MeasureName: aws.String("mem"), TimeUnit: types.TimeUnitMicroseconds},
+			},
+			ExpectCommonUnit: "NANOSECONDS",
+			ExpectUnits:      []string{"NANOSECONDS", "MICROSECONDS"},
+		},
+		"common attributes unit": {
+			DefaultTimeUnit:  types.TimeUnitNanoseconds,
+			CommonAttributes: &types.Record{TimeUnit: types.TimeUnitSeconds},
+			Records: []types.Record{
+				{Time: aws.String("1")},
+			},
+			ExpectCommonUnit: "SECONDS",
+			ExpectUnits:      []string{""},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var body struct {
+				CommonAttributes struct{ TimeUnit string }
+				Records          []struct{ TimeUnit string }
+			}
+			client := newMockClient(func(r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("expect no error, got %v", err)
+				}
+			}, func(o *Options) {
+				o.DefaultTimeUnit = c.DefaultTimeUnit
+			})
+
+			params := &WriteRecordsInput{
+				DatabaseName:     aws.String("db"),
+				TableName:        aws.String("table"),
+				CommonAttributes: c.CommonAttributes,
+				Records:          c.Records,
+			}
+			var inputUnits []types.TimeUnit
+			for _, r := range c.Records {
+				inputUnits = append(inputUnits, r.TimeUnit)
+			}
+			if _, err := client.WriteRecords(context.Background(), params); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.ExpectCommonUnit, body.CommonAttributes.TimeUnit; e != a {
+				t.Errorf("expect %q common time unit, got %q", e, a)
+			}
+			var units []string
+			for _, r := range body.Records {
+				units = append(units, r.TimeUnit)
+			}
+			if e, a := c.ExpectUnits, units; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v time units, got %v", e, a)
+			}
+
+			for i, r := range params.Records {
+				if e, a := inputUnits[i], r.TimeUnit; e != a {
+					t.Errorf("expect input record %d time unit %q not to be modified, got %q", i, e, a)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package timestreamwrite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+)
+
+// CreateDatabaseAPIClient is a client that implements the CreateDatabase
+// operation.
+type CreateDatabaseAPIClient interface {
+	CreateDatabase(context.Context, *CreateDatabaseInput, ...func(*Options)) (*CreateDatabaseOutput, error)
+}
+
+var _ CreateDatabaseAPIClient = (*Client)(nil)
+
+// EnsureDatabaseAPIClient is a client that implements the CreateDatabase and
+// DescribeDatabase operations.
+type EnsureDatabaseAPIClient interface {
+	CreateDatabaseAPIClient
+	DescribeDatabaseAPIClient
+}
+
+var _ EnsureDatabaseAPIClient = (*Client)(nil)
+
+// EnsureDatabaseOptions provides the options for EnsureDatabase.
+type EnsureDatabaseOptions struct {
+	// The KMS key the database will be encrypted with if it is created. Not
+	// applied to a database that already exists.
+	KmsKeyId *string
+
+	// The tags the database will be created with. Not applied to a database
+	// that already exists.
+	Tags []types.Tag
+
+	// The functional options passed to each operation invoked.
+	ClientOptions []func(*Options)
+}
+
+// EnsureDatabaseOutput is the result of EnsureDatabase.
+type EnsureDatabaseOutput struct {
+	// The database that was created, or that already existed.
+	Database *types.Database
+
+	// Whether the database already existed, and was not created.
+	AlreadyExisted bool
+}
+
+// EnsureDatabase creates the database if it does not already exist. If
+// CreateDatabase fails with a ConflictException the database is described to
+// confirm it exists, and is returned with AlreadyExisted set. This allows
+// scripts that create databases to be run repeatedly.
+func EnsureDatabase(ctx context.Context, client EnsureDatabaseAPIClient, databaseName string, optFns ...func(*EnsureDatabaseOptions)) (*EnsureDatabaseOutput, error) {
+	var options EnsureDatabaseOptions
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	created, err := client.CreateDatabase(ctx, &CreateDatabaseInput{
+		DatabaseName: aws.String(databaseName),
+		KmsKeyId:     options.KmsKeyId,
+		Tags:         options.Tags,
+	}, options.ClientOptions...)
+	if err == nil {
+		return &EnsureDatabaseOutput{Database: created.Database}, nil
+	}
+
+	var conflict *types.ConflictException
+	if !errors.As(err, &conflict) {
+		return nil, fmt.Errorf("failed to create database %s, %w", databaseName, err)
+	}
+
+	described, err := client.DescribeDatabase(ctx, &DescribeDatabaseInput{
+		DatabaseName: aws.String(databaseName),
+	}, options.ClientOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe existing database %s, %w", databaseName, err)
+	}
+
+	return &EnsureDatabaseOutput{
+		Database:       described.Database,
+		AlreadyExisted: true,
+	}, nil
+}
@@ -0,0 +1,121 @@
+package timestreamwrite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+)
+
+type mockEnsureDatabaseClient struct {
+	createErr   error
+	describeErr error
+
+	createCalls   int
+	describeCalls int
+}
+
+func (m *mockEnsureDatabaseClient) CreateDatabase(ctx context.Context, params *CreateDatabaseInput, optFns ...func(*Options)) (*CreateDatabaseOutput, error) {
+	m.createCalls++
+	if m.createErr != nil {
+		return nil, m.createErr
+	}
+	return &CreateDatabaseOutput{
+		Database: &types.Database{DatabaseName: params.DatabaseName, KmsKeyId: params.KmsKeyId},
+	}, nil
+}
+
+func (m *mockEnsureDatabaseClient) DescribeDatabase(ctx context.Context, params *DescribeDatabaseInput, optFns ...func(*Options)) (*DescribeDatabaseOutput, error) {
+	m.describeCalls++
+	if m.describeErr != nil {
+		return nil, m.describeErr
+	}
+	return &DescribeDatabaseOutput{
+		Database: &types.Database{DatabaseName: params.DatabaseName, KmsKeyId: aws.String("existing-key")},
+	}, nil
+}
+
+func TestEnsureDatabase(t *testing.T) {
+	cases := map[string]struct {
+		CreateErr            error
+		ExpectAlreadyExisted bool
+		ExpectKmsKeyID       string
+		ExpectDescribeCalls  int
+	}{
+		"create new": {
+			ExpectKmsKeyID: "new-key",
+		},
+		"already exists": {
+			CreateErr:            &types.ConflictException{Message: aws.String("database already exists")},
+			ExpectAlreadyExisted: true,
+			ExpectKmsKeyID:       "existing-key",
+			ExpectDescribeCalls:  1,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockEnsureDatabaseClient{createErr: c.CreateErr}
+
+			out, err := EnsureDatabase(context.Background(), client, "db", func(o *EnsureDatabaseOptions) {
+				o.KmsKeyId = aws.String("new-key")
+			})
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.ExpectAlreadyExisted, out.AlreadyExisted; e != a {
+				t.Errorf("expect %v already existed, got %v", e, a)
+			}
+			if e, a := "db", aws.ToString(out.Database.DatabaseName); e != a {
+				t.Errorf("expect %v database, got %v", e, a)
+			}
+			if e, a := c.ExpectKmsKeyID, aws.ToString(out.Database.KmsKeyId); e != a {
+				t.Errorf("expect %v kms key, got %v", e, a)
+			}
+			if e, a := c.ExpectDescribeCalls, client.describeCalls; e != a {
+				t.Errorf("expect %v describe calls, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestEnsureDatabase_Error(t *testing.T) {
+	accessDenied := &types.AccessDeniedException{}
+
+	cases := map[string]struct {
+		CreateErr   error
+		DescribeErr error
+		ExpectErr   error
+	}{
+		"create error": {
+			CreateErr: accessDenied,
+			ExpectErr: accessDenied,
+		},
+		"describe error": {
+			CreateErr:   &types.ConflictException{},
+			DescribeErr: accessDenied,
+			ExpectErr:   accessDenied,
+		},
+		"create unexpected error": {
+			CreateErr: fmt.Errorf("some error"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockEnsureDatabaseClient{createErr: c.CreateErr, describeErr: c.DescribeErr}
+
+			_, err := EnsureDatabase(context.Background(), client, "db")
+			if err == nil {
+				t.Fatalf("expect error, got none")
+			}
+			if c.ExpectErr != nil && !errors.Is(err, c.ExpectErr) {
+				t.Errorf("expect %v error, got %v", c.ExpectErr, err)
+			}
+		})
+	}
+}
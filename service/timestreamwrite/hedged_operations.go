@@ -0,0 +1,13 @@
+package timestreamwrite
+
+import (
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// addRequestHedging adds the request hedging middleware to the stacks of the
+// idempotent operations requests are hedged for, when the client's HedgeAfter
+// option is set.
+func addRequestHedging(stack *middleware.Stack, o Options) error {
+	return awshttp.AddRequestHedgingMiddleware(stack, o.HedgeAfter)
+}
@@ -0,0 +1,100 @@
+package timestreamwrite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// MagneticStoreRejectedDataLocation is the location in Amazon S3 the reports
+// of the records rejected by a table's magnetic store are written to.
+type MagneticStoreRejectedDataLocation struct {
+	// The name of the S3 bucket the reports are written to.
+	BucketName string
+
+	// The prefix of the keys of the S3 objects of the reports. Empty if the
+	// reports are written to the root of the bucket.
+	ObjectKeyPrefix string
+}
+
+// URI returns the S3 URI of the location, such as "s3://bucket/prefix".
+func (l *MagneticStoreRejectedDataLocation) URI() string {
+	uri := "s3://" + l.BucketName
+	if len(l.ObjectKeyPrefix) != 0 {
+		uri += "/" + strings.TrimPrefix(l.ObjectKeyPrefix, "/")
+	}
+	return uri
+}
+
+// GetMagneticStoreRejectedDataLocation describes the table with DescribeTable,
+// and returns the location the reports of the records rejected by the table's
+// magnetic store are written to, as configured by the table's
+// MagneticStoreWriteProperties. Returns nil if magnetic store writes are not
+// enabled for the table, or no location is configured.
+//
+// A WriteRecords RejectedRecordsException does not include the location of the
+// report, so the location is read from the table. The
+// MagneticStoreWriteProperties are not modeled by the client's types.Table, so
+// are read from the DescribeTable response body, passed to the client's
+// OnResponseBody option. The client must apply the functional options passed
+// to DescribeTable.
+func GetMagneticStoreRejectedDataLocation(ctx context.Context, client DescribeTableAPIClient, databaseName, tableName string, optFns ...func(*Options)) (*MagneticStoreRejectedDataLocation, error) {
+	var body []byte
+	optFns = append(optFns, func(o *Options) {
+		onResponseBody := o.OnResponseBody
+		o.OnResponseBody = func(opName string, status int, b []byte) {
+			if onResponseBody != nil {
+				onResponseBody(opName, status, b)
+			}
+			if opName == "DescribeTable" && status < 300 {
+				body = b
+			}
+		}
+	})
+
+	_, err := client.DescribeTable(ctx, &DescribeTableInput{
+		DatabaseName: aws.String(databaseName),
+		TableName:    aws.String(tableName),
+	}, optFns...)
+	if err != nil {
+		return nil, err
+	}
+	if len(body) == 0 {
+		return nil, fmt.Errorf("failed to read table %s.%s magnetic store write properties, no response body",
+			databaseName, tableName)
+	}
+
+	var out struct {
+		Table struct {
+			MagneticStoreWriteProperties *struct {
+				EnableMagneticStoreWrites         bool
+				MagneticStoreRejectedDataLocation *struct {
+					S3Configuration *struct {
+						BucketName      string
+						ObjectKeyPrefix string
+					}
+				}
+			}
+		}
+	}
+	if err := json.Unmarshal(body, &out); err != nil {
+		return nil, fmt.Errorf("failed to read table %s.%s magnetic store write properties, %w",
+			databaseName, tableName, err)
+	}
+
+	props := out.Table.MagneticStoreWriteProperties
+	if props == nil || !props.EnableMagneticStoreWrites || props.MagneticStoreRejectedDataLocation == nil {
+		return nil, nil
+	}
+	s3 := props.MagneticStoreRejectedDataLocation.S3Configuration
+	if s3 == nil || len(s3.BucketName) == 0 {
+		return nil, nil
+	}
+	return &MagneticStoreRejectedDataLocation{
+		BucketName:      s3.BucketName,
+		ObjectKeyPrefix: s3.ObjectKeyPrefix,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package timestreamwrite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting/unit"
+)
+
+func TestGetMagneticStoreRejectedDataLocation(t *testing.T) {
+	cases := map[string]struct {
+		Body      string
+		ExpectURI string
+	}{
+		"report location": {
+			Body: `{"Table":{"TableName":"table","TableStatus":"ACTIVE","MagneticStoreWriteProperties":{` +
+				`"EnableMagneticStoreWrites":true,"MagneticStoreRejectedDataLocation":{"S3Configuration":{` +
+				`"BucketName":"rejects","ObjectKeyPrefix":"db/table","EncryptionOption":"SSE_S3"}}}}}`,
+			ExpectURI: "s3://rejects/db/table",
+		},
+		"no prefix": {
+			Body: `{"Table":{"MagneticStoreWriteProperties":{"EnableMagneticStoreWrites":true,` +
+				`"MagneticStoreRejectedDataLocation":{"S3Configuration":{"BucketName":"rejects"}}}}}`,
+			ExpectURI: "s3://rejects",
+		},
+		"magnetic store writes disabled": {
+			Body: `{"Table":{"MagneticStoreWriteProperties":{"EnableMagneticStoreWrites":false,` +
+				`"MagneticStoreRejectedDataLocation":{"S3Configuration":{"BucketName":"rejects"}}}}}`,
+		},
+		"no magnetic store write properties": {
+			Body: `{"Table":{"TableName":"table","TableStatus":"ACTIVE"}}`,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			stub := awstesting.NewStubTransport()
+			stub.Add("DescribeTable", awstesting.StubResponse{Body: c.Body})
+
+			var observed int
+			client := New(Options{
+				Region:      "us-west-2",
+				Credentials: unit.StubCredentialsProvider{},
+				HTTPClient:  stub,
+				OnResponseBody: func(opName string, status int, body []byte) {
+					observed++
+				},
+			})
+
+			loc, err := GetMagneticStoreRejectedDataLocation(context.Background(), client, "db", "table")
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := 1, observed; e != a {
+				t.Errorf("expect client OnResponseBody called %v times, got %v", e, a)
+			}
+			if len(c.ExpectURI) == 0 {
+				if loc != nil {
+					t.Errorf("expect no location, got %v", loc.URI())
+				}
+				return
+			}
+			if loc == nil {
+				t.Fatalf("expect location, got none")
+			}
+			if e, a := c.ExpectURI, loc.URI(); e != a {
+				t.Errorf("expect %v location, got %v", e, a)
+			}
+		})
+	}
+}
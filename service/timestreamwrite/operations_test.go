@@ -0,0 +1,31 @@
+package timestreamwrite
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestClient_Operations(t *testing.T) {
+	client := New(Options{})
+
+	expect := []string{
+		"CreateDatabase",
+		"CreateTable",
+		"DeleteDatabase",
+		"DeleteTable",
+		"DescribeDatabase",
+		"DescribeEndpoints",
+		"DescribeTable",
+		"ListDatabases",
+		"ListTables",
+		"ListTagsForResource",
+		"TagResource",
+		"UntagResource",
+		"UpdateDatabase",
+		"UpdateTable",
+		"WriteRecords",
+	}
+	if e, a := expect, client.Operations(); !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v operations, got %v", e, a)
+	}
+}
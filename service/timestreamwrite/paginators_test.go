@@ -0,0 +1,111 @@
+package timestreamwrite
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+	"github.com/aws/smithy-go/middleware"
+)
+
+type mockListDatabasesPagesClient struct {
+	pages  [][]types.Database
+	limits []int32
+}
+
+func (m *mockListDatabasesPagesClient) ListDatabases(ctx context.Context, params *ListDatabasesInput, optFns ...func(*Options)) (*ListDatabasesOutput, error) {
+	m.limits = append(m.limits, aws.ToInt32(params.MaxResults))
+
+	var idx int
+	if params.NextToken != nil {
+		fmt.Sscanf(*params.NextToken, "%d", &idx)
+	}
+	out := &ListDatabasesOutput{Databases: m.pages[idx]}
+	out.ResultMetadata.Set("page", idx)
+	if idx+1 < len(m.pages) {
+		out.NextToken = aws.String(fmt.Sprintf("%d", idx+1))
+	}
+	return out, nil
+}
+
+func TestListDatabasesPaginator_OnPage(t *testing.T) {
+	client := &mockListDatabasesPagesClient{
+		pages: [][]types.Database{{{}, {}}, {}, {{}}},
+	}
+
+	var pageNums, itemCounts, metadataPages []int
+	p := NewListDatabasesPaginator(client, &ListDatabasesInput{}, func(o *ListDatabasesPaginatorOptions) {
+		o.OnPage = func(pageNum int, itemCount int, metadata middleware.Metadata) {
+			pageNums = append(pageNums, pageNum)
+			itemCounts = append(itemCounts, itemCount)
+			metadataPages = append(metadataPages, metadata.Get("page").(int))
+		}
+	})
+	for p.HasMorePages() {
+		if _, err := p.NextPage(context.Background()); err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+	}
+
+	if e, a := []int{1, 2, 3}, pageNums; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v page numbers, got %v", e, a)
+	}
+	if e, a := []int{2, 0, 1}, itemCounts; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v item counts, got %v", e, a)
+	}
+	if e, a := []int{0, 1, 2}, metadataPages; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v page metadata, got %v", e, a)
+	}
+}
+
+func TestListDatabasesPaginator_AdaptiveLimit(t *testing.T) {
+	cases := map[string]struct {
+		Limit         int32
+		AdaptiveLimit int32
+		ExpectLimits  []int32
+	}{
+		"grows to limit": {
+			Limit:         10,
+			AdaptiveLimit: 2,
+			ExpectLimits:  []int32{2, 4, 8, 10, 10},
+		},
+		"not adaptive": {
+			Limit:        10,
+			ExpectLimits: []int32{10, 10, 10, 10, 10},
+		},
+		"adaptive limit above limit": {
+			Limit:         10,
+			AdaptiveLimit: 20,
+			ExpectLimits:  []int32{10, 10, 10, 10, 10},
+		},
+		"no limit": {
+			AdaptiveLimit: 2,
+			ExpectLimits:  []int32{0, 0, 0, 0, 0},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockListDatabasesPagesClient{
+				pages: make([][]types.Database, 5),
+			}
+
+			p := NewListDatabasesPaginator(client, &ListDatabasesInput{}, func(o *ListDatabasesPaginatorOptions) {
+				o.Limit = c.Limit
+				o.AdaptiveLimit = c.AdaptiveLimit
+			})
+			for p.HasMorePages() {
+				if _, err := p.NextPage(context.Background()); err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+			}
+
+			if e, a := c.ExpectLimits, client.limits; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v limits, got %v", e, a)
+			}
+		})
+	}
+}
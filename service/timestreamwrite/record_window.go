@@ -0,0 +1,168 @@
+package timestreamwrite
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// OutOfWindowRecordsMode is how WriteRecords handles records with a Time
+// before the end of the table's memory store window, which the service
+// rejects.
+type OutOfWindowRecordsMode int
+
+// Enumerations of the OutOfWindowRecordsMode
+const (
+	// Records are sent regardless of their Time.
+	OutOfWindowRecordsModeSend OutOfWindowRecordsMode = iota
+
+	// Records outside the window are removed from the request before it is
+	// sent. The RecordIndex of any records rejected by the service refers to
+	// the records sent.
+	OutOfWindowRecordsModeDrop
+
+	// The operation fails with an *OutOfWindowRecordsError, and no records are
+	// sent, if any records are outside the window.
+	OutOfWindowRecordsModeError
+)
+
+// tableRetentionTTL is how long the described tables used to compute the
+// memory store window are cached for.
+const tableRetentionTTL = 5 * time.Minute
+
+// OutOfWindowRecordsError is returned by WriteRecords when records have a Time
+// before the end of the table's memory store window, and the client's
+// RejectOutOfWindowRecords is OutOfWindowRecordsModeError.
+type OutOfWindowRecordsError struct {
+	// The indexes of the records outside the window.
+	RecordIndexes []int
+
+	// The end of the table's memory store window.
+	MemoryStoreWindowEnd time.Time
+}
+
+func (e *OutOfWindowRecordsError) Error() string {
+	return fmt.Sprintf("%d record(s) older than the memory store window end %v",
+		len(e.RecordIndexes), e.MemoryStoreWindowEnd)
+}
+
+// resolveTableRetentionCache creates the cache of described tables shared by
+// all WriteRecords operations of the client.
+func resolveTableRetentionCache(o *Options) {
+	o.tableRetentionCache = aws.NewDescribeCache(tableRetentionTTL)
+}
+
+// addRejectOutOfWindowRecords adds the RejectOutOfWindowRecords middleware to
+// WriteRecords operations. Tables are described by a client with the
+// operation's options, so they are described with the client's shared write
+// rate and concurrency limits.
+func addRejectOutOfWindowRecords(stack *middleware.Stack, o Options) error {
+	if o.RejectOutOfWindowRecords == OutOfWindowRecordsModeSend || o.tableRetentionCache == nil {
+		return nil
+	}
+	return stack.Initialize.Add(&rejectOutOfWindowRecords{
+		mode:   o.RejectOutOfWindowRecords,
+		cache:  o.tableRetentionCache,
+		client: &Client{options: o},
+	}, middleware.After)
+}
+
+// rejectOutOfWindowRecords drops, or fails the operation for, records of a
+// WriteRecords request that are older than the table's memory store window.
+type rejectOutOfWindowRecords struct {
+	mode   OutOfWindowRecordsMode
+	cache  *aws.DescribeCache
+	client DescribeTableAPIClient
+}
+
+func (*rejectOutOfWindowRecords) ID() string {
+	return "RejectOutOfWindowRecords"
+}
+
+func (m *rejectOutOfWindowRecords) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	input, ok := in.Parameters.(*WriteRecordsInput)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown input parameters type %T", in.Parameters)
+	}
+
+	var databaseName, tableName string
+	if input.DatabaseName != nil {
+		databaseName = *input.DatabaseName
+	}
+	if input.TableName != nil {
+		tableName = *input.TableName
+	}
+	health, err := TableHealth(ctx, m.client, databaseName, tableName, func(o *TableHealthOptions) {
+		o.Cache = m.cache
+	})
+	if err != nil {
+		return out, metadata, fmt.Errorf("failed to get memory store window, %w", err)
+	}
+	windowEnd := health.MemoryStoreWindowEnd
+
+	var outOfWindow []int
+	for i, r := range input.Records {
+		t, ok := recordTime(r, input.CommonAttributes)
+		if ok && t.Before(windowEnd) {
+			outOfWindow = append(outOfWindow, i)
+		}
+	}
+	if len(outOfWindow) == 0 {
+		return next.HandleInitialize(ctx, in)
+	}
+
+	if m.mode == OutOfWindowRecordsModeError {
+		return out, metadata, &OutOfWindowRecordsError{
+			RecordIndexes:        outOfWindow,
+			MemoryStoreWindowEnd: windowEnd,
+		}
+	}
+
+	if len(outOfWindow) == len(input.Records) {
+		out.Result = &WriteRecordsOutput{}
+		return out, metadata, nil
+	}
+
+	params := *input
+	params.Records = make([]types.Record, 0, len(input.Records)-len(outOfWindow))
+	for i, r := range input.Records {
+		if len(outOfWindow) != 0 && outOfWindow[0] == i {
+			outOfWindow = outOfWindow[1:]
+			continue
+		}
+		params.Records = append(params.Records, r)
+	}
+	in.Parameters = &params
+
+	return next.HandleInitialize(ctx, in)
+}
+
+// recordTime returns the time of the record, using the common attributes for
+// the Time or TimeUnit the record does not specify. Returns false if the
+// record has no time, or its time is not valid.
+func recordTime(r types.Record, common *types.Record) (time.Time, bool) {
+	value, unit := r.Time, r.TimeUnit
+	if common != nil {
+		if value == nil {
+			value = common.Time
+		}
+		if len(unit) == 0 {
+			unit = common.TimeUnit
+		}
+	}
+	if value == nil {
+		return time.Time{}, false
+	}
+
+	t, err := types.ParseTime(*value, unit)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
@@ -0,0 +1,176 @@
+package timestreamwrite
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting/unit"
+	"github.com/aws/aws-sdk-go-v2/internal/sdk"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestClient_RejectOutOfWindowRecords(t *testing.T) {
+	now := time.Date(2021, 2, 3, 12, 0, 0, 0, time.UTC)
+	origNowTime := sdk.NowTime
+	defer func() { sdk.NowTime = origNowTime }()
+	sdk.NowTime = func() time.Time { return now }
+
+	millis := func(t time.Time) *string {
+		return aws.String(strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10))
+	}
+	inWindow := types.Record{MeasureName: aws.String("in"), Time: millis(now.Add(-time.Hour))}
+	outOfWindow := types.Record{MeasureName: aws.String("out"), Time: millis(now.Add(-7 * time.Hour))}
+	inWindowSeconds := types.Record{
+		MeasureName: aws.String("in seconds"),
+		Time:        aws.String(strconv.FormatInt(now.Add(-time.Hour).Unix(), 10)),
+		TimeUnit:    types.TimeUnitSeconds,
+	}
+
+	cases := map[string]struct {
+		Mode          OutOfWindowRecordsMode
+		Records       []types.Record
+		ExpectWritten []string
+		ExpectErr     []int
+	}{
+		"send": {
+			Mode:          OutOfWindowRecordsModeSend,
+			Records:       []types.Record{inWindow, outOfWindow},
+			ExpectWritten: []string{"in", "out"},
+		},
+		"drop": {
+			Mode:          OutOfWindowRecordsModeDrop,
+			Records:       []types.Record{outOfWindow, inWindow, outOfWindow, inWindowSeconds},
+			ExpectWritten: []string{"in", "in seconds"},
+		},
+		"drop all": {
+			Mode:    OutOfWindowRecordsModeDrop,
+			Records: []types.Record{outOfWindow},
+		},
+		"error": {
+			Mode:      OutOfWindowRecordsModeError,
+			Records:   []types.Record{inWindow, outOfWindow, inWindowSeconds, outOfWindow},
+			ExpectErr: []int{1, 3},
+		},
+		"error in window": {
+			Mode:          OutOfWindowRecordsModeError,
+			Records:       []types.Record{inWindow, inWindowSeconds},
+			ExpectWritten: []string{"in", "in seconds"},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var describeCalls int
+			var written []string
+			client := New(Options{
+				Region:                   "us-west-2",
+				Credentials:              unit.StubCredentialsProvider{},
+				RejectOutOfWindowRecords: c.Mode,
+				HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+					body := `{}`
+					switch r.Header.Get("X-Amz-Target") {
+					case "Timestream_20181101.DescribeTable":
+						describeCalls++
+						body = `{"Table":{"RetentionProperties":{"MemoryStoreRetentionPeriodInHours":6,"MagneticStoreRetentionPeriodInDays":7}}}`
+					case "Timestream_20181101.WriteRecords":
+						var input struct {
+							Records []struct{ MeasureName string }
+						}
+						if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+							t.Errorf("expect no error, got %v", err)
+						}
+						for _, r := range input.Records {
+							written = append(written, r.MeasureName)
+						}
+					}
+					return &http.Response{
+						StatusCode: 200,
+						Header:     http.Header{},
+						Body:       ioutil.NopCloser(strings.NewReader(body)),
+					}, nil
+				}),
+			})
+
+			// The table's retention is cached between operations.
+			for i := 0; i < 2; i++ {
+				written = nil
+				_, err := client.WriteRecords(context.Background(), &WriteRecordsInput{
+					DatabaseName: aws.String("db"),
+					TableName:    aws.String("table"),
+					Records:      c.Records,
+				})
+				if c.ExpectErr != nil {
+					var windowErr *OutOfWindowRecordsError
+					if !errors.As(err, &windowErr) {
+						t.Fatalf("expect %T error, got %v", windowErr, err)
+					}
+					if e, a := c.ExpectErr, windowErr.RecordIndexes; !reflect.DeepEqual(e, a) {
+						t.Errorf("expect %v out of window records, got %v", e, a)
+					}
+				} else if err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+				if e, a := c.ExpectWritten, written; !reflect.DeepEqual(e, a) {
+					t.Errorf("expect %v records written, got %v", e, a)
+				}
+			}
+
+			expectDescribeCalls := 1
+			if c.Mode == OutOfWindowRecordsModeSend {
+				expectDescribeCalls = 0
+			}
+			if e, a := expectDescribeCalls, describeCalls; e != a {
+				t.Errorf("expect %v describe calls, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestClient_RejectOutOfWindowRecords_MaxConcurrentRequests(t *testing.T) {
+	var describeCalls int
+	client := New(Options{
+		Region:                   "us-west-2",
+		Credentials:              unit.StubCredentialsProvider{},
+		RejectOutOfWindowRecords: OutOfWindowRecordsModeDrop,
+		MaxConcurrentRequests:    1,
+		HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			body := `{}`
+			if r.Header.Get("X-Amz-Target") == "Timestream_20181101.DescribeTable" {
+				describeCalls++
+				body = `{"Table":{"RetentionProperties":{"MemoryStoreRetentionPeriodInHours":6,"MagneticStoreRetentionPeriodInDays":7}}}`
+			}
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+			}, nil
+		}),
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// The table is described by the client invoking WriteRecords, sharing the
+	// operation's hold on the client's concurrency limit.
+	_, err := client.WriteRecords(ctx, &WriteRecordsInput{
+		DatabaseName: aws.String("db"),
+		TableName:    aws.String("table"),
+		Records:      []types.Record{{MeasureName: aws.String("m"), Time: aws.String("0")}},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 1, describeCalls; e != a {
+		t.Errorf("expect %v describe calls, got %v", e, a)
+	}
+}
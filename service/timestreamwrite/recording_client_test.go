@@ -0,0 +1,65 @@
+package timestreamwrite
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting/unit"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestRecordingClient_DescribeDatabase(t *testing.T) {
+	dir := t.TempDir()
+
+	var sent int
+	live := smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+		sent++
+		return &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{},
+			Body: ioutil.NopCloser(strings.NewReader(
+				`{"Database":{"DatabaseName":"db","TableCount":3}}`)),
+		}, nil
+	})
+
+	describe := func(optFn func(*aws.RecordingClientOptions)) {
+		client := New(Options{
+			Region:      "us-west-2",
+			Credentials: unit.StubCredentialsProvider{},
+			HTTPClient:  aws.NewRecordingClient(dir, optFn),
+		})
+		out, err := client.DescribeDatabase(context.Background(), &DescribeDatabaseInput{
+			DatabaseName: aws.String("db"),
+		})
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		if e, a := "db", aws.ToString(out.Database.DatabaseName); e != a {
+			t.Errorf("expect %v database, got %v", e, a)
+		}
+		if e, a := int64(3), out.Database.TableCount; e != a {
+			t.Errorf("expect %v tables, got %v", e, a)
+		}
+	}
+
+	// Record
+	describe(func(o *aws.RecordingClientOptions) {
+		o.Client = live
+	})
+	if e, a := 1, sent; e != a {
+		t.Errorf("expect %v requests sent, got %v", e, a)
+	}
+
+	// Replay
+	describe(func(o *aws.RecordingClientOptions) {
+		o.Client = live
+		o.ReplayOnly = true
+	})
+	if e, a := 1, sent; e != a {
+		t.Errorf("expect %v requests sent, got %v", e, a)
+	}
+}
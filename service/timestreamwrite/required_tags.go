@@ -0,0 +1,67 @@
+package timestreamwrite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/internal/validation"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// addRequiredTags adds the middleware validating the Tags of CreateDatabase
+// and CreateTable operations against the client's RequiredTags.
+func addRequiredTags(stack *middleware.Stack, o Options) error {
+	if len(o.RequiredTags) == 0 {
+		return nil
+	}
+	return stack.Initialize.Add(&validateRequiredTags{keys: o.RequiredTags}, middleware.After)
+}
+
+// validateRequiredTags fails create operations whose Tags do not include all
+// of the required tag keys, before the request is sent.
+type validateRequiredTags struct {
+	keys []string
+}
+
+func (*validateRequiredTags) ID() string {
+	return "RequiredTagsValidation"
+}
+
+func (m *validateRequiredTags) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	var tags []types.Tag
+	var inputName string
+	switch v := in.Parameters.(type) {
+	case *CreateDatabaseInput:
+		tags, inputName = v.Tags, "CreateDatabaseInput"
+	case *CreateTableInput:
+		tags, inputName = v.Tags, "CreateTableInput"
+	default:
+		return out, metadata, fmt.Errorf("unknown input parameters type %T", in.Parameters)
+	}
+
+	present := map[string]struct{}{}
+	for _, t := range tags {
+		if t.Key != nil {
+			present[*t.Key] = struct{}{}
+		}
+	}
+	var missing []string
+	for _, k := range m.keys {
+		if _, ok := present[k]; !ok {
+			missing = append(missing, k)
+		}
+	}
+	if len(missing) != 0 {
+		invalidParams := smithy.InvalidParamsError{Context: inputName}
+		invalidParams.Add(validation.NewErrInvalidValue("Tags",
+			fmt.Sprintf("missing required tag key(s) %s", strings.Join(missing, ", "))))
+		return out, metadata, invalidParams
+	}
+
+	return next.HandleInitialize(ctx, in)
+}
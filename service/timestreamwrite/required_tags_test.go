@@ -0,0 +1,112 @@
+package timestreamwrite
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+	smithy "github.com/aws/smithy-go"
+)
+
+func TestClient_RequiredTags(t *testing.T) {
+	present := []types.Tag{
+		{Key: aws.String("team"), Value: aws.String("metrics")},
+		{Key: aws.String("cost-center"), Value: aws.String("1234")},
+	}
+	partial := []types.Tag{
+		{Key: aws.String("team"), Value: aws.String("metrics")},
+	}
+
+	cases := map[string]struct {
+		RequiredTags []string
+		Invoke       func(*Client) error
+		ExpectSent   bool
+		ExpectErr    string
+	}{
+		"create database present": {
+			RequiredTags: []string{"team", "cost-center"},
+			Invoke: func(c *Client) error {
+				_, err := c.CreateDatabase(context.Background(), &CreateDatabaseInput{
+					DatabaseName: aws.String("db"),
+					Tags:         present,
+				})
+				return err
+			},
+			ExpectSent: true,
+		},
+		"create database missing": {
+			RequiredTags: []string{"team", "cost-center"},
+			Invoke: func(c *Client) error {
+				_, err := c.CreateDatabase(context.Background(), &CreateDatabaseInput{
+					DatabaseName: aws.String("db"),
+					Tags:         partial,
+				})
+				return err
+			},
+			ExpectErr: "missing required tag key(s) cost-center",
+		},
+		"create table missing": {
+			RequiredTags: []string{"team", "cost-center"},
+			Invoke: func(c *Client) error {
+				_, err := c.CreateTable(context.Background(), &CreateTableInput{
+					DatabaseName: aws.String("db"),
+					TableName:    aws.String("table"),
+				})
+				return err
+			},
+			ExpectErr: "missing required tag key(s) team, cost-center",
+		},
+		"no required tags": {
+			Invoke: func(c *Client) error {
+				_, err := c.CreateTable(context.Background(), &CreateTableInput{
+					DatabaseName: aws.String("db"),
+					TableName:    aws.String("table"),
+				})
+				return err
+			},
+			ExpectSent: true,
+		},
+		"other operation": {
+			RequiredTags: []string{"team"},
+			Invoke: func(c *Client) error {
+				_, err := c.DescribeDatabase(context.Background(), &DescribeDatabaseInput{
+					DatabaseName: aws.String("db"),
+				})
+				return err
+			},
+			ExpectSent: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var sent bool
+			client := newMockClient(func(r *http.Request) {
+				sent = true
+			}, func(o *Options) {
+				o.RequiredTags = c.RequiredTags
+			})
+
+			err := c.Invoke(client)
+			if len(c.ExpectErr) != 0 {
+				var invalidParams smithy.InvalidParamsError
+				if !errors.As(err, &invalidParams) {
+					t.Fatalf("expect %T error, got %v", invalidParams, err)
+				}
+				if e, a := c.ExpectErr, err.Error(); !strings.Contains(a, e) {
+					t.Errorf("expect error to contain %q, got %v", e, a)
+				}
+			} else if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.ExpectSent, sent; e != a {
+				t.Errorf("expect request sent %v, got %v", e, a)
+			}
+		})
+	}
+}
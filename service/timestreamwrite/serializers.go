@@ -744,6 +744,41 @@ func awsAwsjson10_serializeDocumentDimensions(v []types.Dimension, value smithyj
 	return nil
 }
 
+func awsAwsjson10_serializeDocumentMeasureValue(v *types.MeasureValue, value smithyjson.Value) error {
+	object := value.Object()
+	defer object.Close()
+
+	if v.Name != nil {
+		ok := object.Key("Name")
+		ok.String(*v.Name)
+	}
+
+	if len(v.Type) > 0 {
+		ok := object.Key("Type")
+		ok.String(string(v.Type))
+	}
+
+	if v.Value != nil {
+		ok := object.Key("Value")
+		ok.String(*v.Value)
+	}
+
+	return nil
+}
+
+func awsAwsjson10_serializeDocumentMeasureValues(v []types.MeasureValue, value smithyjson.Value) error {
+	array := value.Array()
+	defer array.Close()
+
+	for i := range v {
+		av := array.Value()
+		if err := awsAwsjson10_serializeDocumentMeasureValue(&v[i], av); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func awsAwsjson10_serializeDocumentRecord(v *types.Record, value smithyjson.Value) error {
 	object := value.Object()
 	defer object.Close()
@@ -770,6 +805,13 @@ func awsAwsjson10_serializeDocumentRecord(v *types.Record, value smithyjson.Valu
 		ok.String(string(v.MeasureValueType))
 	}
 
+	if v.MeasureValues != nil {
+		ok := object.Key("MeasureValues")
+		if err := awsAwsjson10_serializeDocumentMeasureValues(v.MeasureValues, ok); err != nil {
+			return err
+		}
+	}
+
 	if v.Time != nil {
 		ok := object.Key("Time")
 		ok.String(*v.Time)
@@ -780,9 +822,9 @@ func awsAwsjson10_serializeDocumentRecord(v *types.Record, value smithyjson.Valu
 		ok.String(string(v.TimeUnit))
 	}
 
-	if v.Version != 0 {
+	if v.Version != nil {
 		ok := object.Key("Version")
-		ok.Long(v.Version)
+		ok.Long(*v.Version)
 	}
 
 	return nil
@@ -0,0 +1,85 @@
+package timestreamwrite
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+	smithyjson "github.com/aws/smithy-go/encoding/json"
+)
+
+func TestSerializeDocumentRecord_MeasureValues(t *testing.T) {
+	cases := map[string]struct {
+		Record types.Record
+		Expect string
+	}{
+		"single measure": {
+			Record: types.Record{
+				MeasureName:      aws.String("cpu"),
+				MeasureValue:     aws.String("13.5"),
+				MeasureValueType: types.MeasureValueTypeDouble,
+			},
+			Expect: `{"MeasureName":"cpu","MeasureValue":"13.5","MeasureValueType":"DOUBLE"}`,
+		},
+		"multi measure": {
+			Record: types.Record{
+				MeasureName:      aws.String("metrics"),
+				MeasureValueType: types.MeasureValueTypeMulti,
+				MeasureValues: []types.MeasureValue{
+					{Name: aws.String("cpu"), Value: aws.String("13.5"), Type: types.MeasureValueTypeDouble},
+					{Name: aws.String("status"), Value: aws.String("ok"), Type: types.MeasureValueTypeVarchar},
+				},
+			},
+			Expect: `{"MeasureName":"metrics","MeasureValueType":"MULTI","MeasureValues":[` +
+				`{"Name":"cpu","Type":"DOUBLE","Value":"13.5"},` +
+				`{"Name":"status","Type":"VARCHAR","Value":"ok"}]}`,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			encoder := smithyjson.NewEncoder()
+			if err := awsAwsjson10_serializeDocumentRecord(&c.Record, encoder.Value); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.Expect, string(encoder.Bytes()); e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestSerializeDocumentRecord_Version(t *testing.T) {
+	cases := map[string]struct {
+		Version *int64
+		Expect  string
+	}{
+		"unset": {
+			Expect: `{"MeasureName":"cpu"}`,
+		},
+		"explicit zero": {
+			Version: aws.Int64(0),
+			Expect:  `{"MeasureName":"cpu","Version":0}`,
+		},
+		"version": {
+			Version: aws.Int64(42),
+			Expect:  `{"MeasureName":"cpu","Version":42}`,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			record := types.Record{
+				MeasureName: aws.String("cpu"),
+				Version:     c.Version,
+			}
+			encoder := smithyjson.NewEncoder()
+			if err := awsAwsjson10_serializeDocumentRecord(&record, encoder.Value); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.Expect, string(encoder.Bytes()); e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+		})
+	}
+}
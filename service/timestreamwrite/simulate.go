@@ -0,0 +1,76 @@
+package timestreamwrite
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// SimulatedRequest is the request a WriteRecords operation would have sent,
+// when the client's SimulateOnly option is set.
+type SimulatedRequest struct {
+	Method string
+	URL    string
+	Header http.Header
+
+	// The serialized body of the request.
+	Body []byte
+}
+
+type simulatedRequestKey struct{}
+
+// GetSimulatedRequest returns the request a WriteRecords operation would have
+// sent, from the ResultMetadata of the operation's output. Returns false if
+// the operation was not simulated.
+func GetSimulatedRequest(metadata middleware.Metadata) (*SimulatedRequest, bool) {
+	v, ok := metadata.Get(simulatedRequestKey{}).(*SimulatedRequest)
+	return v, ok
+}
+
+// addSimulateOnly adds the middleware completing WriteRecords operations
+// without sending the request, when the client's SimulateOnly option is set.
+func addSimulateOnly(stack *middleware.Stack, o Options) error {
+	if !o.SimulateOnly {
+		return nil
+	}
+	return stack.Finalize.Add(&simulateOnly{}, middleware.Before)
+}
+
+// simulateOnly completes WriteRecords operations without sending the
+// serialized request, returning the request in the output's metadata.
+type simulateOnly struct{}
+
+// ID returns the id of the middleware
+func (*simulateOnly) ID() string {
+	return "SimulateOnly"
+}
+
+// HandleFinalize implements the FinalizeMiddleware interface
+func (*simulateOnly) HandleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (
+	out middleware.FinalizeOutput, metadata middleware.Metadata, err error,
+) {
+	req, ok := in.Request.(*smithyhttp.Request)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown transport type %T", in.Request)
+	}
+
+	var body []byte
+	if stream := req.GetStream(); stream != nil {
+		if body, err = ioutil.ReadAll(stream); err != nil {
+			return out, metadata, fmt.Errorf("failed to read simulated request body, %w", err)
+		}
+	}
+
+	metadata.Set(simulatedRequestKey{}, &SimulatedRequest{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Header: req.Header.Clone(),
+		Body:   body,
+	})
+	out.Result = &WriteRecordsOutput{}
+	return out, metadata, nil
+}
@@ -0,0 +1,64 @@
+package timestreamwrite
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+)
+
+func TestClient_SimulateOnly(t *testing.T) {
+	var sent int
+	client := newMockClient(func(r *http.Request) { sent++ }, func(o *Options) {
+		o.SimulateOnly = true
+	})
+
+	out, err := client.WriteRecords(context.Background(), &WriteRecordsInput{
+		DatabaseName: aws.String("db"),
+		TableName:    aws.String("table"),
+		Records: []types.Record{
+			{MeasureName: aws.String("cpu"), MeasureValue: aws.String("13.5")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 0, sent; e != a {
+		t.Errorf("expect %v requests sent, got %v", e, a)
+	}
+
+	req, ok := GetSimulatedRequest(out.ResultMetadata)
+	if !ok {
+		t.Fatalf("expect simulated request")
+	}
+	if e, a := "POST", req.Method; e != a {
+		t.Errorf("expect %v method, got %v", e, a)
+	}
+	if e, a := "Timestream_20181101.WriteRecords", req.Header.Get("X-Amz-Target"); e != a {
+		t.Errorf("expect %v target, got %v", e, a)
+	}
+	expectBody := `{"DatabaseName":"db","Records":[{"MeasureName":"cpu","MeasureValue":"13.5"}],"TableName":"table"}`
+	if e, a := expectBody, string(req.Body); e != a {
+		t.Errorf("expect %v body, got %v", e, a)
+	}
+
+	// Invalid input is still rejected.
+	_, err = client.WriteRecords(context.Background(), &WriteRecordsInput{
+		TableName: aws.String("table"),
+	})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+
+	// Other operations are sent.
+	if _, err = client.DescribeDatabase(context.Background(), &DescribeDatabaseInput{
+		DatabaseName: aws.String("db"),
+	}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 1, sent; e != a {
+		t.Errorf("expect %v requests sent, got %v", e, a)
+	}
+}
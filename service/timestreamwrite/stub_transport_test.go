@@ -0,0 +1,45 @@
+package timestreamwrite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting"
+	"github.com/aws/aws-sdk-go-v2/internal/awstesting/unit"
+)
+
+func TestStubTransport(t *testing.T) {
+	stub := awstesting.NewStubTransport()
+	stub.Add("DescribeTable", awstesting.StubResponse{
+		Body: `{"Table":{"TableName":"table","TableStatus":"ACTIVE"}}`,
+	})
+
+	client := New(Options{
+		Region:      "us-west-2",
+		Credentials: unit.StubCredentialsProvider{},
+		HTTPClient:  stub,
+	})
+
+	out, err := client.DescribeTable(context.Background(), &DescribeTableInput{
+		DatabaseName: aws.String("db"),
+		TableName:    aws.String("table"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "table", aws.ToString(out.Table.TableName); e != a {
+		t.Errorf("expect %v table, got %v", e, a)
+	}
+
+	requests := stub.Requests()
+	if e, a := 1, len(requests); e != a {
+		t.Fatalf("expect %v requests, got %v", e, a)
+	}
+	if e, a := "DescribeTable", requests[0].Operation; e != a {
+		t.Errorf("expect %v operation, got %v", e, a)
+	}
+	if e, a := `{"DatabaseName":"db","TableName":"table"}`, string(requests[0].Body); e != a {
+		t.Errorf("expect %v body, got %v", e, a)
+	}
+}
@@ -0,0 +1,98 @@
+package timestreamwrite
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/sdk"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+)
+
+// TableHealthOptions provides the options for TableHealth.
+type TableHealthOptions struct {
+	// The cache of described tables, keyed by database and table name. If set,
+	// the table is only described when it is not cached. The memory store
+	// window end is always computed from the current time. Nil means the table
+	// is described for every call.
+	Cache *aws.DescribeCache
+
+	// The functional options passed to each operation invoked.
+	ClientOptions []func(*Options)
+}
+
+// TableHealthOutput is the output of TableHealth.
+type TableHealthOutput struct {
+	// The table described.
+	Table *types.Table
+
+	// The duration records are stored in the table's memory store.
+	MemoryStoreRetention time.Duration
+
+	// The duration records are stored in the table's magnetic store.
+	MagneticStoreRetention time.Duration
+
+	// The end of the table's memory store window, at the time the table was
+	// described. Records with a Time before the end of the window are older
+	// than the memory store retention, and are rejected by WriteRecords.
+	MemoryStoreWindowEnd time.Time
+}
+
+// IsWritable returns whether a record with the time t is within the table's
+// memory store window, and can be written to the table. Records are only
+// writable until they are older than the memory store retention, so a time
+// that is writable when the table was described may no longer be writable
+// later.
+func (h *TableHealthOutput) IsWritable(t time.Time) bool {
+	return !t.Before(h.MemoryStoreWindowEnd)
+}
+
+// TableHealth describes the table with DescribeTable, and returns the table
+// along with its retention settings, and the end of its memory store window,
+// computed from the current time and the memory store retention.
+func TableHealth(ctx context.Context, client DescribeTableAPIClient, databaseName, tableName string, optFns ...func(*TableHealthOptions)) (*TableHealthOutput, error) {
+	var options TableHealthOptions
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	describe := func() (interface{}, error) {
+		out, err := client.DescribeTable(ctx, &DescribeTableInput{
+			DatabaseName: aws.String(databaseName),
+			TableName:    aws.String(tableName),
+		}, options.ClientOptions...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe table %s in database %s, %w", tableName, databaseName, err)
+		}
+		if out.Table == nil || out.Table.RetentionProperties == nil {
+			return nil, fmt.Errorf("no retention properties returned for table %s in database %s", tableName, databaseName)
+		}
+		return out.Table, nil
+	}
+
+	var table interface{}
+	var err error
+	if options.Cache != nil {
+		table, err = options.Cache.Get(databaseName+"/"+tableName, describe)
+	} else {
+		table, err = describe()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return newTableHealthOutput(table.(*types.Table), sdk.NowTime()), nil
+}
+
+func newTableHealthOutput(table *types.Table, now time.Time) *TableHealthOutput {
+	retention := table.RetentionProperties
+	memoryStoreRetention := time.Duration(retention.MemoryStoreRetentionPeriodInHours) * time.Hour
+
+	return &TableHealthOutput{
+		Table:                  table,
+		MemoryStoreRetention:   memoryStoreRetention,
+		MagneticStoreRetention: time.Duration(retention.MagneticStoreRetentionPeriodInDays) * 24 * time.Hour,
+		MemoryStoreWindowEnd:   now.Add(-memoryStoreRetention),
+	}
+}
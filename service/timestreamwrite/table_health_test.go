@@ -0,0 +1,151 @@
+package timestreamwrite
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/sdk"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+)
+
+type mockTableHealthClient struct {
+	table *types.Table
+	err   error
+	calls int
+}
+
+func (m *mockTableHealthClient) DescribeTable(ctx context.Context, params *DescribeTableInput, optFns ...func(*Options)) (*DescribeTableOutput, error) {
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &DescribeTableOutput{Table: m.table}, nil
+}
+
+func TestTableHealth(t *testing.T) {
+	now := time.Date(2021, 2, 3, 12, 0, 0, 0, time.UTC)
+	origNowTime := sdk.NowTime
+	defer func() { sdk.NowTime = origNowTime }()
+	sdk.NowTime = func() time.Time { return now }
+
+	client := &mockTableHealthClient{
+		table: &types.Table{
+			DatabaseName: aws.String("db"),
+			TableName:    aws.String("table"),
+			RetentionProperties: &types.RetentionProperties{
+				MemoryStoreRetentionPeriodInHours:  6,
+				MagneticStoreRetentionPeriodInDays: 7,
+			},
+		},
+	}
+
+	health, err := TableHealth(context.Background(), client, "db", "table")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 6*time.Hour, health.MemoryStoreRetention; e != a {
+		t.Errorf("expect %v memory store retention, got %v", e, a)
+	}
+	if e, a := 7*24*time.Hour, health.MagneticStoreRetention; e != a {
+		t.Errorf("expect %v magnetic store retention, got %v", e, a)
+	}
+	if e, a := now.Add(-6*time.Hour), health.MemoryStoreWindowEnd; !e.Equal(a) {
+		t.Errorf("expect %v memory store window end, got %v", e, a)
+	}
+
+	cases := map[string]struct {
+		Time           time.Time
+		ExpectWritable bool
+	}{
+		"now": {
+			Time:           now,
+			ExpectWritable: true,
+		},
+		"within memory store retention": {
+			Time:           now.Add(-5 * time.Hour),
+			ExpectWritable: true,
+		},
+		"at memory store window end": {
+			Time:           now.Add(-6 * time.Hour),
+			ExpectWritable: true,
+		},
+		"older than memory store retention": {
+			Time: now.Add(-6*time.Hour - time.Second),
+		},
+		"within magnetic store retention": {
+			Time: now.Add(-2 * 24 * time.Hour),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if e, a := c.ExpectWritable, health.IsWritable(c.Time); e != a {
+				t.Errorf("expect %v writable, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestTableHealth_Error(t *testing.T) {
+	cases := map[string]*mockTableHealthClient{
+		"describe error":       {err: errors.New("describe error")},
+		"no retention":         {table: &types.Table{}},
+		"no table description": {},
+	}
+
+	for name, client := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := TableHealth(context.Background(), client, "db", "table"); err == nil {
+				t.Fatalf("expect error, got none")
+			}
+		})
+	}
+}
+
+func TestTableHealth_Cache(t *testing.T) {
+	now := time.Date(2021, 2, 3, 12, 0, 0, 0, time.UTC)
+	origNowTime := sdk.NowTime
+	defer func() { sdk.NowTime = origNowTime }()
+	sdk.NowTime = func() time.Time { return now }
+
+	client := &mockTableHealthClient{
+		table: &types.Table{
+			RetentionProperties: &types.RetentionProperties{
+				MemoryStoreRetentionPeriodInHours:  6,
+				MagneticStoreRetentionPeriodInDays: 7,
+			},
+		},
+	}
+	cache := aws.NewDescribeCache(time.Minute)
+	withCache := func(o *TableHealthOptions) { o.Cache = cache }
+
+	if _, err := TableHealth(context.Background(), client, "db", "table", withCache); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	now = now.Add(30 * time.Second)
+	health, err := TableHealth(context.Background(), client, "db", "table", withCache)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 1, client.calls; e != a {
+		t.Errorf("expect %v describe calls, got %v", e, a)
+	}
+	if e, a := now.Add(-6*time.Hour), health.MemoryStoreWindowEnd; !e.Equal(a) {
+		t.Errorf("expect %v memory store window end, got %v", e, a)
+	}
+
+	if _, err := TableHealth(context.Background(), client, "db", "other", withCache); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	now = now.Add(time.Minute)
+	if _, err := TableHealth(context.Background(), client, "db", "table", withCache); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 3, client.calls; e != a {
+		t.Errorf("expect %v describe calls, got %v", e, a)
+	}
+}
@@ -0,0 +1,97 @@
+package timestreamwrite
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+)
+
+// TagResourceAPIClient is a client that implements the TagResource operation.
+type TagResourceAPIClient interface {
+	TagResource(context.Context, *TagResourceInput, ...func(*Options)) (*TagResourceOutput, error)
+}
+
+var _ TagResourceAPIClient = (*Client)(nil)
+
+// TagAllOptions provides the options for TagAll.
+type TagAllOptions struct {
+	// The number of resources that will be tagged concurrently. If zero,
+	// resources are tagged one at a time.
+	Concurrency int
+
+	// The functional options passed to each operation invoked.
+	ClientOptions []func(*Options)
+}
+
+// TagAll applies the same tags to each of the resources, such as databases
+// and tables, identified by their ARNs, with one TagResource request per
+// resource. Tags the resources already have with other keys are kept.
+//
+// Resources are tagged concurrently up to the Concurrency of the options. If
+// any of the resources could not be tagged, an *aws.BatchError is returned
+// with a failure for each, whose Index is the index of the resource's ARN.
+// The other resources are still tagged.
+func TagAll(ctx context.Context, client TagResourceAPIClient, arns []string, tags map[string]string, optFns ...func(*TagAllOptions)) error {
+	var options TagAllOptions
+	for _, fn := range optFns {
+		fn(&options)
+	}
+	if options.Concurrency <= 0 {
+		options.Concurrency = 1
+	}
+
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	tagList := make([]types.Tag, 0, len(keys))
+	for _, k := range keys {
+		tagList = append(tagList, types.Tag{Key: aws.String(k), Value: aws.String(tags[k])})
+	}
+
+	errs := make([]error, len(arns))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, options.Concurrency)
+	for i, arn := range arns {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, arn string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := ctx.Err(); err != nil {
+				errs[i] = err
+				return
+			}
+
+			_, err := client.TagResource(ctx, &TagResourceInput{
+				ResourceARN: aws.String(arn),
+				Tags:        tagList,
+			}, options.ClientOptions...)
+			if err != nil {
+				errs[i] = fmt.Errorf("failed to tag resource %s, %w", arn, err)
+			}
+		}(i, arn)
+	}
+	wg.Wait()
+
+	var batchErr *aws.BatchError
+	for i, err := range errs {
+		if err != nil {
+			if batchErr == nil {
+				batchErr = &aws.BatchError{}
+			}
+			batchErr.Failures = append(batchErr.Failures, aws.BatchFailure{Index: i, Err: err})
+		}
+	}
+	if batchErr != nil {
+		return batchErr
+	}
+
+	return nil
+}
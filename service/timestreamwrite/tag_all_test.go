@@ -0,0 +1,103 @@
+package timestreamwrite
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+)
+
+type mockTagResourceClient struct {
+	// failures keyed by resource ARN
+	failures map[string]error
+
+	mu     sync.Mutex
+	tagged map[string][]types.Tag
+}
+
+func (m *mockTagResourceClient) TagResource(ctx context.Context, params *TagResourceInput, optFns ...func(*Options)) (*TagResourceOutput, error) {
+	arn := aws.ToString(params.ResourceARN)
+	if err := m.failures[arn]; err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.tagged == nil {
+		m.tagged = map[string][]types.Tag{}
+	}
+	m.tagged[arn] = params.Tags
+	return &TagResourceOutput{}, nil
+}
+
+func TestTagAll(t *testing.T) {
+	arns := []string{
+		"arn:aws:timestream:us-west-2:123456789012:database/a",
+		"arn:aws:timestream:us-west-2:123456789012:database/b",
+		"arn:aws:timestream:us-west-2:123456789012:database/b/table/c",
+	}
+	tagErr := &types.ResourceNotFoundException{Message: aws.String("not found")}
+
+	for _, concurrency := range []int{0, 3} {
+		client := &mockTagResourceClient{
+			failures: map[string]error{arns[1]: tagErr},
+		}
+
+		err := TagAll(context.Background(), client, arns, map[string]string{
+			"team":        "metrics",
+			"cost-center": "1234",
+		}, func(o *TagAllOptions) {
+			o.Concurrency = concurrency
+		})
+
+		var batchErr *aws.BatchError
+		if !errors.As(err, &batchErr) {
+			t.Fatalf("expect %T error, got %v", batchErr, err)
+		}
+		if e, a := 1, len(batchErr.Failures); e != a {
+			t.Fatalf("expect %v failures, got %v", e, a)
+		}
+		if e, a := 1, batchErr.Failures[0].Index; e != a {
+			t.Errorf("expect failure index %v, got %v", e, a)
+		}
+		if !errors.Is(err, tagErr) {
+			t.Errorf("expect error to wrap %v", tagErr)
+		}
+
+		var tagged []string
+		for arn := range client.tagged {
+			tagged = append(tagged, arn)
+		}
+		sort.Strings(tagged)
+		if e, a := []string{arns[0], arns[2]}, tagged; !reflect.DeepEqual(e, a) {
+			t.Errorf("expect %v tagged, got %v", e, a)
+		}
+
+		expectTags := []types.Tag{
+			{Key: aws.String("cost-center"), Value: aws.String("1234")},
+			{Key: aws.String("team"), Value: aws.String("metrics")},
+		}
+		for arn, tags := range client.tagged {
+			if e, a := expectTags, tags; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v tags for %v, got %v", e, a, arn)
+			}
+		}
+	}
+}
+
+func TestTagAll_Success(t *testing.T) {
+	client := &mockTagResourceClient{}
+
+	arns := []string{"arn:aws:timestream:us-west-2:123456789012:database/a"}
+	if err := TagAll(context.Background(), client, arns, map[string]string{"team": "metrics"}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 1, len(client.tagged); e != a {
+		t.Errorf("expect %v resources tagged, got %v", e, a)
+	}
+}
@@ -0,0 +1,47 @@
+package timestreamwrite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// addTargetPrefixOverride adds the middleware replacing the prefix of the
+// X-Amz-Target header with the client's TargetPrefixOverride.
+func addTargetPrefixOverride(stack *middleware.Stack, o Options) error {
+	if len(o.TargetPrefixOverride) == 0 {
+		return nil
+	}
+	return stack.Serialize.Insert(&targetPrefixOverride{prefix: o.TargetPrefixOverride},
+		"OperationSerializer", middleware.After)
+}
+
+// targetPrefixOverride replaces the prefix of the X-Amz-Target header set by
+// the operation's serializer.
+type targetPrefixOverride struct {
+	prefix string
+}
+
+// ID returns the id of the middleware
+func (*targetPrefixOverride) ID() string {
+	return "TargetPrefixOverride"
+}
+
+// HandleSerialize implements the SerializeMiddleware interface
+func (m *targetPrefixOverride) HandleSerialize(ctx context.Context, in middleware.SerializeInput, next middleware.SerializeHandler) (
+	out middleware.SerializeOutput, metadata middleware.Metadata, err error,
+) {
+	req, ok := in.Request.(*smithyhttp.Request)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown transport type %T", in.Request)
+	}
+
+	if target := req.Header.Get("X-Amz-Target"); len(target) != 0 {
+		req.Header.Set("X-Amz-Target", m.prefix+target[strings.LastIndex(target, "."):])
+	}
+
+	return next.HandleSerialize(ctx, in)
+}
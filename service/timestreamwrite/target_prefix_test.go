@@ -0,0 +1,45 @@
+package timestreamwrite
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+func TestClient_TargetPrefixOverride(t *testing.T) {
+	cases := map[string]struct {
+		Prefix       string
+		ExpectTarget string
+	}{
+		"default": {
+			ExpectTarget: "Timestream_20181101.DescribeDatabase",
+		},
+		"override": {
+			Prefix:       "Timestream_20991231",
+			ExpectTarget: "Timestream_20991231.DescribeDatabase",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var target string
+			client := newMockClient(func(r *http.Request) {
+				target = r.Header.Get("X-Amz-Target")
+			}, func(o *Options) {
+				o.TargetPrefixOverride = c.Prefix
+			})
+
+			_, err := client.DescribeDatabase(context.Background(), &DescribeDatabaseInput{
+				DatabaseName: aws.String("db"),
+			})
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectTarget, target; e != a {
+				t.Errorf("expect %v target, got %v", e, a)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"time"
+)
+
+// DatabaseSummary is a compact summary of a Database, such as for displaying
+// in dashboards.
+type DatabaseSummary struct {
+	// The name of the database.
+	Name string
+
+	// The time the database was created. Zero if not known.
+	CreatedAt time.Time
+
+	// The number of tables in the database. Nil unless the
+	// IncludeTableCount option was set.
+	TableCount *int64
+}
+
+// DatabaseSummaryOptions provides the options for Database.Summary.
+type DatabaseSummaryOptions struct {
+	// Include the number of tables in the database in the summary.
+	IncludeTableCount bool
+}
+
+// Summary returns a compact summary of the database. The table count is
+// taken from the TableCount returned by DescribeDatabase and ListDatabases,
+// so including it does not require listing the database's tables.
+func (d Database) Summary(optFns ...func(*DatabaseSummaryOptions)) DatabaseSummary {
+	var options DatabaseSummaryOptions
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	s := DatabaseSummary{}
+	if d.DatabaseName != nil {
+		s.Name = *d.DatabaseName
+	}
+	if d.CreationTime != nil {
+		s.CreatedAt = *d.CreationTime
+	}
+	if options.IncludeTableCount {
+		count := d.TableCount
+		s.TableCount = &count
+	}
+	return s
+}
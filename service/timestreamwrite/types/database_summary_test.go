@@ -0,0 +1,49 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDatabase_Summary(t *testing.T) {
+	created := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	name := "db"
+	count := int64(3)
+
+	db := Database{
+		DatabaseName: &name,
+		CreationTime: &created,
+		TableCount:   count,
+	}
+
+	cases := map[string]struct {
+		Database Database
+		OptFns   []func(*DatabaseSummaryOptions)
+		Expect   DatabaseSummary
+	}{
+		"without table count": {
+			Database: db,
+			Expect:   DatabaseSummary{Name: "db", CreatedAt: created},
+		},
+		"with table count": {
+			Database: db,
+			OptFns: []func(*DatabaseSummaryOptions){
+				func(o *DatabaseSummaryOptions) { o.IncludeTableCount = true },
+			},
+			Expect: DatabaseSummary{Name: "db", CreatedAt: created, TableCount: &count},
+		},
+		"empty database": {
+			Expect: DatabaseSummary{},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			actual := c.Database.Summary(c.OptFns...)
+			if e, a := c.Expect, actual; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %+v summary, got %+v", e, a)
+			}
+		})
+	}
+}
@@ -26,6 +26,7 @@ const (
 	MeasureValueTypeBigint  MeasureValueType = "BIGINT"
 	MeasureValueTypeVarchar MeasureValueType = "VARCHAR"
 	MeasureValueTypeBoolean MeasureValueType = "BOOLEAN"
+	MeasureValueTypeMulti   MeasureValueType = "MULTI"
 )
 
 // Values returns all known values for MeasureValueType. Note that this can be
@@ -37,6 +38,7 @@ func (MeasureValueType) Values() []MeasureValueType {
 		"BIGINT",
 		"VARCHAR",
 		"BOOLEAN",
+		"MULTI",
 	}
 }
 
@@ -0,0 +1,27 @@
+package types
+
+// IsValid returns if the MeasureValueType is one of the values known to the
+// client. The client does not reject unknown values, unless its
+// RejectUnknownEnumValues option is set, as the service may support values
+// added after the client was released.
+func (v MeasureValueType) IsValid() bool {
+	for _, known := range v.Values() {
+		if v == known {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValid returns if the TimeUnit is one of the values known to the client.
+// The client does not reject unknown values, unless its RejectUnknownEnumValues
+// option is set, as the service may support values added after the client was
+// released.
+func (v TimeUnit) IsValid() bool {
+	for _, known := range v.Values() {
+		if v == known {
+			return true
+		}
+	}
+	return false
+}
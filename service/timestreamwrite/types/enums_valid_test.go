@@ -0,0 +1,29 @@
+package types
+
+import "testing"
+
+func TestMeasureValueType_IsValid(t *testing.T) {
+	for _, v := range MeasureValueType("").Values() {
+		if !v.IsValid() {
+			t.Errorf("expect %v to be valid", v)
+		}
+	}
+	for _, v := range []MeasureValueType{"", "double", "INT"} {
+		if v.IsValid() {
+			t.Errorf("expect %q to not be valid", v)
+		}
+	}
+}
+
+func TestTimeUnit_IsValid(t *testing.T) {
+	for _, v := range TimeUnit("").Values() {
+		if !v.IsValid() {
+			t.Errorf("expect %v to be valid", v)
+		}
+	}
+	for _, v := range []TimeUnit{"", "MILLIS", "milliseconds"} {
+		if v.IsValid() {
+			t.Errorf("expect %q to not be valid", v)
+		}
+	}
+}
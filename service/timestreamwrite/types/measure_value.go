@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// MeasureValueFrom returns the MeasureValue string form, and the
+// MeasureValueType, of the Go value v. Floating point values are DOUBLE,
+// integers are BIGINT, bools are BOOLEAN, and strings are VARCHAR. Returns an
+// error if the type of v is not supported, or v cannot be represented by its
+// measure value type, such as NaN, or an unsigned integer larger than a
+// BIGINT.
+//
+//    value, typ, err := types.MeasureValueFrom(13.5)
+//    if err != nil {
+//        return err
+//    }
+//    record := types.Record{
+//        MeasureName:      aws.String("cpu"),
+//        MeasureValue:     aws.String(value),
+//        MeasureValueType: typ,
+//    }
+func MeasureValueFrom(v interface{}) (value string, typ MeasureValueType, err error) {
+	switch v := v.(type) {
+	case float64:
+		return formatDouble(v, 64)
+	case float32:
+		return formatDouble(float64(v), 32)
+	case int:
+		return strconv.FormatInt(int64(v), 10), MeasureValueTypeBigint, nil
+	case int8:
+		return strconv.FormatInt(int64(v), 10), MeasureValueTypeBigint, nil
+	case int16:
+		return strconv.FormatInt(int64(v), 10), MeasureValueTypeBigint, nil
+	case int32:
+		return strconv.FormatInt(int64(v), 10), MeasureValueTypeBigint, nil
+	case int64:
+		return strconv.FormatInt(v, 10), MeasureValueTypeBigint, nil
+	case uint:
+		return formatBigint(uint64(v))
+	case uint8:
+		return formatBigint(uint64(v))
+	case uint16:
+		return formatBigint(uint64(v))
+	case uint32:
+		return formatBigint(uint64(v))
+	case uint64:
+		return formatBigint(v)
+	case bool:
+		return strconv.FormatBool(v), MeasureValueTypeBoolean, nil
+	case string:
+		return v, MeasureValueTypeVarchar, nil
+	default:
+		return "", "", fmt.Errorf("unsupported measure value type %T, expect a float, integer, bool, or string", v)
+	}
+}
+
+func formatDouble(v float64, bitSize int) (string, MeasureValueType, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return "", "", fmt.Errorf("measure value %v is not a finite DOUBLE", v)
+	}
+	return strconv.FormatFloat(v, 'f', -1, bitSize), MeasureValueTypeDouble, nil
+}
+
+func formatBigint(v uint64) (string, MeasureValueType, error) {
+	if v > math.MaxInt64 {
+		return "", "", fmt.Errorf("measure value %d overflows BIGINT", v)
+	}
+	return strconv.FormatUint(v, 10), MeasureValueTypeBigint, nil
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestMeasureValueFrom(t *testing.T) {
+	cases := map[string]struct {
+		Value       interface{}
+		ExpectValue string
+		ExpectType  MeasureValueType
+		ExpectErr   bool
+	}{
+		"float64": {
+			Value:       13.5,
+			ExpectValue: "13.5",
+			ExpectType:  MeasureValueTypeDouble,
+		},
+		"float64 large": {
+			Value:       1e21,
+			ExpectValue: "1000000000000000000000",
+			ExpectType:  MeasureValueTypeDouble,
+		},
+		"float32": {
+			Value:       float32(0.1),
+			ExpectValue: "0.1",
+			ExpectType:  MeasureValueTypeDouble,
+		},
+		"NaN": {
+			Value:     math.NaN(),
+			ExpectErr: true,
+		},
+		"int": {
+			Value:       -42,
+			ExpectValue: "-42",
+			ExpectType:  MeasureValueTypeBigint,
+		},
+		"int64": {
+			Value:       int64(math.MaxInt64),
+			ExpectValue: "9223372036854775807",
+			ExpectType:  MeasureValueTypeBigint,
+		},
+		"uint32": {
+			Value:       uint32(7),
+			ExpectValue: "7",
+			ExpectType:  MeasureValueTypeBigint,
+		},
+		"uint64 overflow": {
+			Value:     uint64(math.MaxUint64),
+			ExpectErr: true,
+		},
+		"bool": {
+			Value:       true,
+			ExpectValue: "true",
+			ExpectType:  MeasureValueTypeBoolean,
+		},
+		"string": {
+			Value:       "ok",
+			ExpectValue: "ok",
+			ExpectType:  MeasureValueTypeVarchar,
+		},
+		"unsupported": {
+			Value:     time.Second,
+			ExpectErr: true,
+		},
+		"nil": {
+			ExpectErr: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			value, typ, err := MeasureValueFrom(c.Value)
+			if c.ExpectErr {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectValue, value; e != a {
+				t.Errorf("expect %v value, got %v", e, a)
+			}
+			if e, a := c.ExpectType, typ; e != a {
+				t.Errorf("expect %v type, got %v", e, a)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+)
+
+// The allowed ranges of the RetentionProperties durations.
+const (
+	MinMemoryStoreRetentionPeriodInHours  int64 = 1
+	MaxMemoryStoreRetentionPeriodInHours  int64 = 8766
+	MinMagneticStoreRetentionPeriodInDays int64 = 1
+	MaxMagneticStoreRetentionPeriodInDays int64 = 73000
+)
+
+// RetentionRangeError is returned by NewRetentionProperties when a retention
+// duration is outside of the range allowed by Timestream.
+type RetentionRangeError struct {
+	// The name of the RetentionProperties field.
+	Field string
+
+	Value int64
+	Min   int64
+	Max   int64
+}
+
+func (e *RetentionRangeError) Error() string {
+	return fmt.Sprintf("%s %d out of range, must be between %d and %d",
+		e.Field, e.Value, e.Min, e.Max)
+}
+
+// NewRetentionProperties returns RetentionProperties retaining data in the
+// memory store for memoryHours and in the magnetic store for magneticDays.
+// Returns a RetentionRangeError if either duration is outside of the range
+// allowed by Timestream.
+func NewRetentionProperties(memoryHours, magneticDays int64) (*RetentionProperties, error) {
+	if memoryHours < MinMemoryStoreRetentionPeriodInHours || memoryHours > MaxMemoryStoreRetentionPeriodInHours {
+		return nil, &RetentionRangeError{
+			Field: "MemoryStoreRetentionPeriodInHours",
+			Value: memoryHours,
+			Min:   MinMemoryStoreRetentionPeriodInHours,
+			Max:   MaxMemoryStoreRetentionPeriodInHours,
+		}
+	}
+	if magneticDays < MinMagneticStoreRetentionPeriodInDays || magneticDays > MaxMagneticStoreRetentionPeriodInDays {
+		return nil, &RetentionRangeError{
+			Field: "MagneticStoreRetentionPeriodInDays",
+			Value: magneticDays,
+			Min:   MinMagneticStoreRetentionPeriodInDays,
+			Max:   MaxMagneticStoreRetentionPeriodInDays,
+		}
+	}
+
+	return &RetentionProperties{
+		MemoryStoreRetentionPeriodInHours:  memoryHours,
+		MagneticStoreRetentionPeriodInDays: magneticDays,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewRetentionProperties(t *testing.T) {
+	cases := map[string]struct {
+		MemoryHours  int64
+		MagneticDays int64
+		ExpectField  string
+	}{
+		"minimum": {
+			MemoryHours:  1,
+			MagneticDays: 1,
+		},
+		"maximum": {
+			MemoryHours:  8766,
+			MagneticDays: 73000,
+		},
+		"zero memory": {
+			MemoryHours:  0,
+			MagneticDays: 1,
+			ExpectField:  "MemoryStoreRetentionPeriodInHours",
+		},
+		"memory too large": {
+			MemoryHours:  8767,
+			MagneticDays: 1,
+			ExpectField:  "MemoryStoreRetentionPeriodInHours",
+		},
+		"negative magnetic": {
+			MemoryHours:  24,
+			MagneticDays: -1,
+			ExpectField:  "MagneticStoreRetentionPeriodInDays",
+		},
+		"magnetic too large": {
+			MemoryHours:  24,
+			MagneticDays: 73001,
+			ExpectField:  "MagneticStoreRetentionPeriodInDays",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			props, err := NewRetentionProperties(c.MemoryHours, c.MagneticDays)
+			if len(c.ExpectField) != 0 {
+				var rangeErr *RetentionRangeError
+				if !errors.As(err, &rangeErr) {
+					t.Fatalf("expect %T error, got %v", rangeErr, err)
+				}
+				if e, a := c.ExpectField, rangeErr.Field; e != a {
+					t.Errorf("expect %v field, got %v", e, a)
+				}
+				if props != nil {
+					t.Errorf("expect no retention properties, got %v", props)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.MemoryHours, props.MemoryStoreRetentionPeriodInHours; e != a {
+				t.Errorf("expect %v memory hours, got %v", e, a)
+			}
+			if e, a := c.MagneticDays, props.MagneticStoreRetentionPeriodInDays; e != a {
+				t.Errorf("expect %v magnetic days, got %v", e, a)
+			}
+		})
+	}
+}
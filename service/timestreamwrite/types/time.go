@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// FormatTime returns the time as a Record Time string in the unit, such as the
+// number of milliseconds since the Unix epoch for TimeUnitMilliseconds. An
+// empty unit formats the time in milliseconds, the unit the service assumes
+// when the record's TimeUnit is not set.
+//
+//    record := types.Record{
+//        Time:     aws.String(types.FormatTime(t, types.TimeUnitNanoseconds)),
+//        TimeUnit: types.TimeUnitNanoseconds,
+//    }
+func FormatTime(t time.Time, unit TimeUnit) string {
+	var v int64
+	switch unit {
+	case TimeUnitSeconds:
+		v = t.Unix()
+	case TimeUnitMicroseconds:
+		v = t.UnixNano() / int64(time.Microsecond)
+	case TimeUnitNanoseconds:
+		v = t.UnixNano()
+	default:
+		v = t.UnixNano() / int64(time.Millisecond)
+	}
+	return strconv.FormatInt(v, 10)
+}
+
+// ParseTime returns the time of a Record Time string in the unit. An empty
+// unit parses the time as milliseconds, the unit the service assumes when the
+// record's TimeUnit is not set. Returns an error if the string is not an
+// integer, or the unit is not known.
+func ParseTime(s string, unit TimeUnit) (time.Time, error) {
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q, %w", s, err)
+	}
+
+	switch unit {
+	case TimeUnitSeconds:
+		return time.Unix(v, 0).UTC(), nil
+	case TimeUnitMilliseconds, "":
+		return time.Unix(0, v*int64(time.Millisecond)).UTC(), nil
+	case TimeUnitMicroseconds:
+		return time.Unix(0, v*int64(time.Microsecond)).UTC(), nil
+	case TimeUnitNanoseconds:
+		return time.Unix(0, v).UTC(), nil
+	default:
+		return time.Time{}, fmt.Errorf("unknown time unit %q", unit)
+	}
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatTime(t *testing.T) {
+	tm := time.Date(2021, 2, 3, 4, 5, 6, 123456789, time.UTC)
+
+	cases := map[string]struct {
+		Unit       TimeUnit
+		Expect     string
+		ExpectTime time.Time
+	}{
+		"seconds": {
+			Unit:       TimeUnitSeconds,
+			Expect:     "1612325106",
+			ExpectTime: time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC),
+		},
+		"milliseconds": {
+			Unit:       TimeUnitMilliseconds,
+			Expect:     "1612325106123",
+			ExpectTime: time.Date(2021, 2, 3, 4, 5, 6, 123000000, time.UTC),
+		},
+		"microseconds": {
+			Unit:       TimeUnitMicroseconds,
+			Expect:     "1612325106123456",
+			ExpectTime: time.Date(2021, 2, 3, 4, 5, 6, 123456000, time.UTC),
+		},
+		"nanoseconds": {
+			Unit:       TimeUnitNanoseconds,
+			Expect:     "1612325106123456789",
+			ExpectTime: tm,
+		},
+		"unset": {
+			Expect:     "1612325106123",
+			ExpectTime: time.Date(2021, 2, 3, 4, 5, 6, 123000000, time.UTC),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := FormatTime(tm, c.Unit)
+			if e, a := c.Expect, s; e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+
+			parsed, err := ParseTime(s, c.Unit)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectTime, parsed; !e.Equal(a) {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestParseTime_Error(t *testing.T) {
+	cases := map[string]struct {
+		Value string
+		Unit  TimeUnit
+	}{
+		"not integer": {
+			Value: "2021-02-03T04:05:06Z",
+			Unit:  TimeUnitMilliseconds,
+		},
+		"unknown unit": {
+			Value: "1612325106",
+			Unit:  TimeUnit("MINUTES"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseTime(c.Value, c.Unit); err == nil {
+				t.Errorf("expect error, got none")
+			}
+		})
+	}
+}
@@ -68,6 +68,29 @@ type Endpoint struct {
 	CachePeriodInMinutes int64
 }
 
+// MeasureValue represents the data attribute of the time series. For example, the
+// CPU utilization of an EC2 instance or the RPM of a wind turbine are measures.
+// MeasureValue has both name and value. MeasureValue is only allowed for type
+// MULTI. Using MULTI type, you can pass multiple data attributes associated with
+// the same time series in a single record.
+type MeasureValue struct {
+
+	// Name of the MeasureValue.
+	//
+	// This member is required.
+	Name *string
+
+	// Contains the data type of the MeasureValue for the time series data point.
+	//
+	// This member is required.
+	Type MeasureValueType
+
+	// Value for the MeasureValue.
+	//
+	// This member is required.
+	Value *string
+}
+
 // Record represents a time series data point being written into Timestream. Each
 // record contains an array of dimensions. Dimensions represent the meta data
 // attributes of a time series data point such as the instance name or availability
@@ -89,9 +112,15 @@ type Record struct {
 	// Contains the measure value for the time series data point.
 	MeasureValue *string
 
-	// Contains the data type of the measure value for the time series data point.
+	// Contains the data type of the measure value for the time series data point. Use
+	// MULTI for records with multiple measures in MeasureValues.
 	MeasureValueType MeasureValueType
 
+	// Contains the list of MeasureValue for time series data points. This is only
+	// allowed for type MULTI. For scalar values, use MeasureValue attribute of the
+	// Record directly.
+	MeasureValues []MeasureValue
+
 	// Contains the time at which the measure value for the data point was collected.
 	// The time value plus the unit provides the time elapsed since the epoch. For
 	// example, if the time value is 12345 and the unit is ms, then 12345 ms have
@@ -106,7 +135,7 @@ type Record struct {
 	// a higher version number will update the existing measure value and version. In
 	// cases where the measure value is the same, Version will still be updated .
 	// Default value is to 1.
-	Version int64
+	Version *int64
 }
 
 // Records that were not successfully inserted into Timestream due to data
@@ -0,0 +1,89 @@
+package timestreamwrite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/internal/validation"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// addRejectUnknownEnumValues adds the validation of the enum values of the
+// records of WriteRecords operations, if the client's RejectUnknownEnumValues
+// is set.
+func addRejectUnknownEnumValues(stack *middleware.Stack, o Options) error {
+	if !o.RejectUnknownEnumValues {
+		return nil
+	}
+	return stack.Initialize.Add(&rejectUnknownEnumValues{}, middleware.After)
+}
+
+// rejectUnknownEnumValues fails a WriteRecords operation whose records use a
+// MeasureValueType or TimeUnit unknown to the client.
+type rejectUnknownEnumValues struct {
+}
+
+func (*rejectUnknownEnumValues) ID() string {
+	return "RejectUnknownEnumValues"
+}
+
+func (m *rejectUnknownEnumValues) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	input, ok := in.Parameters.(*WriteRecordsInput)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown input parameters type %T", in.Parameters)
+	}
+	if err := validateKnownEnumValuesWriteRecordsInput(input); err != nil {
+		return out, metadata, err
+	}
+	return next.HandleInitialize(ctx, in)
+}
+
+func validateKnownEnumValuesRecord(v *types.Record) error {
+	if v == nil {
+		return nil
+	}
+	invalidParams := smithy.InvalidParamsError{Context: "Record"}
+	if len(v.MeasureValueType) != 0 && !v.MeasureValueType.IsValid() {
+		invalidParams.Add(validation.NewErrInvalidValue("MeasureValueType",
+			fmt.Sprintf("unknown value %q, must be one of %v", v.MeasureValueType, v.MeasureValueType.Values())))
+	}
+	if len(v.TimeUnit) != 0 && !v.TimeUnit.IsValid() {
+		invalidParams.Add(validation.NewErrInvalidValue("TimeUnit",
+			fmt.Sprintf("unknown value %q, must be one of %v", v.TimeUnit, v.TimeUnit.Values())))
+	}
+	for i := range v.MeasureValues {
+		if t := v.MeasureValues[i].Type; len(t) != 0 && !t.IsValid() {
+			invalidParams.Add(validation.NewErrInvalidValue(fmt.Sprintf("MeasureValues[%d].Type", i),
+				fmt.Sprintf("unknown value %q, must be one of %v", t, t.Values())))
+		}
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	} else {
+		return nil
+	}
+}
+
+func validateKnownEnumValuesWriteRecordsInput(v *WriteRecordsInput) error {
+	if v == nil {
+		return nil
+	}
+	invalidParams := smithy.InvalidParamsError{Context: "WriteRecordsInput"}
+	if err := validateKnownEnumValuesRecord(v.CommonAttributes); err != nil {
+		invalidParams.AddNested("CommonAttributes", err.(smithy.InvalidParamsError))
+	}
+	for i := range v.Records {
+		if err := validateKnownEnumValuesRecord(&v.Records[i]); err != nil {
+			invalidParams.AddNested(fmt.Sprintf("Records[%d]", i), err.(smithy.InvalidParamsError))
+		}
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	} else {
+		return nil
+	}
+}
@@ -0,0 +1,15 @@
+package timestreamwrite
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// addUnsignedPayload signs the requests of the write operations with an
+// unsigned payload when the client's UseUnsignedPayload option is set.
+func addUnsignedPayload(stack *middleware.Stack, o Options) error {
+	if !o.UseUnsignedPayload {
+		return nil
+	}
+	return v4.UseUnsignedPayloadOverHTTPS(stack)
+}
@@ -0,0 +1,126 @@
+package timestreamwrite
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/sdk"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+)
+
+// UpsertOptions provides the options for UpsertWithAutoVersion.
+type UpsertOptions struct {
+	// The function returning the version the records are stamped with. If nil,
+	// the current time in Unix milliseconds is used, increased if needed so that
+	// each call returns a greater version than the last.
+	Version func() int64
+
+	// The functional options passed to each operation invoked.
+	ClientOptions []func(*Options)
+}
+
+// UpsertWithAutoVersion writes the records of params with WriteRecords, with
+// the Version of every record set from a monotonic clock, so that later
+// writes of a record replace the record written by earlier writes.
+//
+// Records rejected because the record already exists with a greater version,
+// such as written with a clock that is ahead, are written once more with a
+// Version one greater than the ExistingVersion of their rejection. If any
+// records are still rejected, or were rejected for another reason, a
+// *types.RejectedRecordsException is returned with the RecordIndex of each
+// rejected record referring to the records of params.
+func UpsertWithAutoVersion(ctx context.Context, client WriteRecordsAPIClient, params *WriteRecordsInput, optFns ...func(*UpsertOptions)) (*WriteRecordsOutput, error) {
+	if params == nil {
+		params = &WriteRecordsInput{}
+	}
+
+	options := UpsertOptions{
+		Version: nextUpsertVersion,
+	}
+	for _, fn := range optFns {
+		fn(&options)
+	}
+	if options.Version == nil {
+		options.Version = nextUpsertVersion
+	}
+
+	version := options.Version()
+	input := *params
+	input.Records = make([]types.Record, len(params.Records))
+	for i, r := range params.Records {
+		r.Version = aws.Int64(version)
+		input.Records[i] = r
+	}
+
+	out, err := client.WriteRecords(ctx, &input, options.ClientOptions...)
+	var rejected *types.RejectedRecordsException
+	if err == nil || !errors.As(err, &rejected) {
+		return out, err
+	}
+
+	// Write the records rejected by version again, keeping track of their
+	// index in params.
+	var retry WriteRecordsInput
+	var retryIndexes []int32
+	var notRetried []types.RejectedRecord
+	for _, r := range rejected.RejectedRecords {
+		if r.ExistingVersion == 0 || r.RecordIndex < 0 || int(r.RecordIndex) >= len(input.Records) {
+			notRetried = append(notRetried, r)
+			continue
+		}
+		record := input.Records[r.RecordIndex]
+		record.Version = aws.Int64(r.ExistingVersion + 1)
+		retry.Records = append(retry.Records, record)
+		retryIndexes = append(retryIndexes, r.RecordIndex)
+	}
+	if len(retry.Records) == 0 {
+		return out, err
+	}
+
+	retry.DatabaseName = input.DatabaseName
+	retry.TableName = input.TableName
+	retry.CommonAttributes = input.CommonAttributes
+	out, err = client.WriteRecords(ctx, &retry, options.ClientOptions...)
+	if err != nil {
+		var retryRejected *types.RejectedRecordsException
+		if !errors.As(err, &retryRejected) {
+			return out, err
+		}
+		for _, r := range retryRejected.RejectedRecords {
+			if r.RecordIndex >= 0 && int(r.RecordIndex) < len(retryIndexes) {
+				r.RecordIndex = retryIndexes[r.RecordIndex]
+			}
+			notRetried = append(notRetried, r)
+		}
+	}
+
+	if len(notRetried) != 0 {
+		return out, &types.RejectedRecordsException{
+			Message:         rejected.Message,
+			RejectedRecords: notRetried,
+		}
+	}
+	return out, nil
+}
+
+var upsertVersion struct {
+	mu   sync.Mutex
+	last int64
+}
+
+// nextUpsertVersion returns the current time in Unix milliseconds, or one
+// greater than the last version returned if the clock has not advanced.
+func nextUpsertVersion() int64 {
+	upsertVersion.mu.Lock()
+	defer upsertVersion.mu.Unlock()
+
+	v := sdk.NowTime().UnixNano() / int64(time.Millisecond)
+	if v <= upsertVersion.last {
+		v = upsertVersion.last + 1
+	}
+	upsertVersion.last = v
+	return v
+}
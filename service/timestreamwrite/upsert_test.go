@@ -0,0 +1,209 @@
+package timestreamwrite
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/sdk"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+)
+
+// mockUpsertClient rejects records written with a version not greater than
+// the stored version of the record, keyed by MeasureName.
+type mockUpsertClient struct {
+	stored   map[string]int64
+	rejectAs map[string]string
+	versions [][]int64
+}
+
+func (m *mockUpsertClient) WriteRecords(ctx context.Context, params *WriteRecordsInput, optFns ...func(*Options)) (*WriteRecordsOutput, error) {
+	var versions []int64
+	var rejected []types.RejectedRecord
+	for i, r := range params.Records {
+		versions = append(versions, aws.ToInt64(r.Version))
+		name := aws.ToString(r.MeasureName)
+		if reason, ok := m.rejectAs[name]; ok {
+			rejected = append(rejected, types.RejectedRecord{
+				RecordIndex: int32(i),
+				Reason:      aws.String(reason),
+			})
+			continue
+		}
+		if existing := m.stored[name]; existing >= aws.ToInt64(r.Version) {
+			rejected = append(rejected, types.RejectedRecord{
+				RecordIndex:     int32(i),
+				Reason:          aws.String("The record version is lower than the existing version."),
+				ExistingVersion: existing,
+			})
+			continue
+		}
+	}
+	m.versions = append(m.versions, versions)
+
+	if len(rejected) != 0 {
+		return nil, &types.RejectedRecordsException{
+			Message:         aws.String("One or more records have been rejected."),
+			RejectedRecords: rejected,
+		}
+	}
+	for _, r := range params.Records {
+		m.stored[aws.ToString(r.MeasureName)] = aws.ToInt64(r.Version)
+	}
+	return &WriteRecordsOutput{}, nil
+}
+
+func TestUpsertWithAutoVersion(t *testing.T) {
+	cases := map[string]struct {
+		Stored         map[string]int64
+		RejectAs       map[string]string
+		ExpectVersions [][]int64
+		ExpectRejected []int32
+	}{
+		"no conflict": {
+			Stored:         map[string]int64{"0": 5},
+			ExpectVersions: [][]int64{{10, 10}},
+		},
+		"stale version": {
+			Stored:         map[string]int64{"1": 20},
+			ExpectVersions: [][]int64{{10, 10}, {21}},
+		},
+		"other rejection": {
+			Stored:         map[string]int64{"0": 20},
+			RejectAs:       map[string]string{"1": "Measure value too large."},
+			ExpectVersions: [][]int64{{10, 10}, {21}},
+			ExpectRejected: []int32{1},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockUpsertClient{stored: c.Stored, rejectAs: c.RejectAs}
+			records := newTestRecords(2)
+
+			_, err := UpsertWithAutoVersion(context.Background(), client, &WriteRecordsInput{
+				DatabaseName: aws.String("db"),
+				TableName:    aws.String("table"),
+				Records:      records,
+			}, func(o *UpsertOptions) {
+				o.Version = func() int64 { return 10 }
+			})
+			if e, a := c.ExpectVersions, client.versions; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v versions written, got %v", e, a)
+			}
+			for _, r := range records {
+				if r.Version != nil {
+					t.Errorf("expect input records not to be modified, got version %v", *r.Version)
+				}
+			}
+
+			if len(c.ExpectRejected) == 0 {
+				if err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+				return
+			}
+
+			var rejected *types.RejectedRecordsException
+			if !errors.As(err, &rejected) {
+				t.Fatalf("expect %T error, got %v", rejected, err)
+			}
+			var indexes []int32
+			for _, r := range rejected.RejectedRecords {
+				indexes = append(indexes, r.RecordIndex)
+			}
+			if e, a := c.ExpectRejected, indexes; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v rejected records, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestUpsertWithAutoVersion_StillStale(t *testing.T) {
+	// The stored version advances past the corrected version before the
+	// records are written again.
+	client := &racingUpsertClient{mockUpsertClient: mockUpsertClient{stored: map[string]int64{"0": 20}}}
+
+	_, err := UpsertWithAutoVersion(context.Background(), client, &WriteRecordsInput{
+		Records: newTestRecords(1),
+	}, func(o *UpsertOptions) {
+		o.Version = func() int64 { return 10 }
+	})
+
+	var rejected *types.RejectedRecordsException
+	if !errors.As(err, &rejected) {
+		t.Fatalf("expect %T error, got %v", rejected, err)
+	}
+	if e, a := 2, len(client.versions); e != a {
+		t.Errorf("expect %v writes, got %v", e, a)
+	}
+}
+
+func TestUpsertWithAutoVersion_InvalidRecordIndex(t *testing.T) {
+	client := &indexUpsertClient{recordIndex: -1}
+
+	_, err := UpsertWithAutoVersion(context.Background(), client, &WriteRecordsInput{
+		Records: newTestRecords(1),
+	})
+
+	var rejected *types.RejectedRecordsException
+	if !errors.As(err, &rejected) {
+		t.Fatalf("expect %T error, got %v", rejected, err)
+	}
+	if e, a := 1, client.calls; e != a {
+		t.Errorf("expect %v writes, got %v", e, a)
+	}
+	if e, a := 1, len(rejected.RejectedRecords); e != a {
+		t.Fatalf("expect %v rejected records, got %v", e, a)
+	}
+	if e, a := int32(-1), rejected.RejectedRecords[0].RecordIndex; e != a {
+		t.Errorf("expect %v record index, got %v", e, a)
+	}
+}
+
+// indexUpsertClient rejects every write with a single version conflict at
+// recordIndex.
+type indexUpsertClient struct {
+	recordIndex int32
+	calls       int
+}
+
+func (m *indexUpsertClient) WriteRecords(ctx context.Context, params *WriteRecordsInput, optFns ...func(*Options)) (*WriteRecordsOutput, error) {
+	m.calls++
+	return nil, &types.RejectedRecordsException{
+		Message: aws.String("One or more records have been rejected."),
+		RejectedRecords: []types.RejectedRecord{{
+			RecordIndex:     m.recordIndex,
+			Reason:          aws.String("The record version is lower than the existing version."),
+			ExistingVersion: 20,
+		}},
+	}
+}
+
+type racingUpsertClient struct {
+	mockUpsertClient
+}
+
+func (m *racingUpsertClient) WriteRecords(ctx context.Context, params *WriteRecordsInput, optFns ...func(*Options)) (*WriteRecordsOutput, error) {
+	out, err := m.mockUpsertClient.WriteRecords(ctx, params, optFns...)
+	m.stored["0"] += 10
+	return out, err
+}
+
+func TestNextUpsertVersion(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	origNowTime := sdk.NowTime
+	defer func() { sdk.NowTime = origNowTime }()
+	sdk.NowTime = func() time.Time { return now }
+
+	first := nextUpsertVersion()
+	if e, a := now.UnixNano()/int64(time.Millisecond), first; e > a {
+		t.Errorf("expect version at least %v, got %v", e, a)
+	}
+	if e, a := first+1, nextUpsertVersion(); e != a {
+		t.Errorf("expect %v version with clock not advanced, got %v", e, a)
+	}
+}
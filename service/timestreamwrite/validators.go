@@ -333,6 +333,44 @@ func validateDimensions(v []types.Dimension) error {
 	}
 }
 
+func validateMeasureValue(v *types.MeasureValue) error {
+	if v == nil {
+		return nil
+	}
+	invalidParams := smithy.InvalidParamsError{Context: "MeasureValue"}
+	if v.Name == nil {
+		invalidParams.Add(smithy.NewErrParamRequired("Name"))
+	}
+	if v.Value == nil {
+		invalidParams.Add(smithy.NewErrParamRequired("Value"))
+	}
+	if len(v.Type) == 0 {
+		invalidParams.Add(smithy.NewErrParamRequired("Type"))
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	} else {
+		return nil
+	}
+}
+
+func validateMeasureValues(v []types.MeasureValue) error {
+	if v == nil {
+		return nil
+	}
+	invalidParams := smithy.InvalidParamsError{Context: "MeasureValues"}
+	for i := range v {
+		if err := validateMeasureValue(&v[i]); err != nil {
+			invalidParams.AddNested(fmt.Sprintf("[%d]", i), err.(smithy.InvalidParamsError))
+		}
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	} else {
+		return nil
+	}
+}
+
 func validateRecord(v *types.Record) error {
 	if v == nil {
 		return nil
@@ -343,6 +381,11 @@ func validateRecord(v *types.Record) error {
 			invalidParams.AddNested("Dimensions", err.(smithy.InvalidParamsError))
 		}
 	}
+	if v.MeasureValues != nil {
+		if err := validateMeasureValues(v.MeasureValues); err != nil {
+			invalidParams.AddNested("MeasureValues", err.(smithy.InvalidParamsError))
+		}
+	}
 	if invalidParams.Len() > 0 {
 		return invalidParams
 	} else {
@@ -0,0 +1,238 @@
+package timestreamwrite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/internal/validation"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+)
+
+type customValidateOpCreateTable struct {
+}
+
+func (*customValidateOpCreateTable) ID() string {
+	return "OperationInputCustomValidation"
+}
+
+func (m *customValidateOpCreateTable) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	input, ok := in.Parameters.(*CreateTableInput)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown input parameters type %T", in.Parameters)
+	}
+	if err := customValidateOpCreateTableInput(input); err != nil {
+		return out, metadata, err
+	}
+	return next.HandleInitialize(ctx, in)
+}
+
+type customValidateOpUpdateTable struct {
+}
+
+func (*customValidateOpUpdateTable) ID() string {
+	return "OperationInputCustomValidation"
+}
+
+func (m *customValidateOpUpdateTable) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	input, ok := in.Parameters.(*UpdateTableInput)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown input parameters type %T", in.Parameters)
+	}
+	if err := customValidateOpUpdateTableInput(input); err != nil {
+		return out, metadata, err
+	}
+	return next.HandleInitialize(ctx, in)
+}
+
+type customValidateOpWriteRecords struct {
+}
+
+func (*customValidateOpWriteRecords) ID() string {
+	return "OperationInputCustomValidation"
+}
+
+func (m *customValidateOpWriteRecords) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	input, ok := in.Parameters.(*WriteRecordsInput)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown input parameters type %T", in.Parameters)
+	}
+	if err := customValidateOpWriteRecordsInput(input); err != nil {
+		return out, metadata, err
+	}
+	return next.HandleInitialize(ctx, in)
+}
+
+// addOpCreateTableCustomValidationMiddleware adds the validation that the
+// retention durations of the input are within the ranges allowed by Timestream.
+func addOpCreateTableCustomValidationMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(&customValidateOpCreateTable{}, middleware.After)
+}
+
+// addOpUpdateTableCustomValidationMiddleware adds the validation that the
+// retention durations of the input are within the ranges allowed by Timestream.
+func addOpUpdateTableCustomValidationMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(&customValidateOpUpdateTable{}, middleware.After)
+}
+
+// addOpWriteRecordsCustomValidationMiddleware adds the validation of the
+// measure values of the input's records, which must use either a single
+// measure value or multiple measure values.
+func addOpWriteRecordsCustomValidationMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(&customValidateOpWriteRecords{}, middleware.After)
+}
+
+func customValidateMeasureValue(v *types.MeasureValue) error {
+	if v == nil {
+		return nil
+	}
+	invalidParams := smithy.InvalidParamsError{Context: "MeasureValue"}
+	if v.Type == types.MeasureValueTypeMulti {
+		invalidParams.Add(validation.NewErrInvalidValue("Type",
+			"MULTI is only valid for a record's MeasureValueType"))
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	} else {
+		return nil
+	}
+}
+
+func customValidateMeasureValues(v []types.MeasureValue) error {
+	if v == nil {
+		return nil
+	}
+	invalidParams := smithy.InvalidParamsError{Context: "MeasureValues"}
+	for i := range v {
+		if err := customValidateMeasureValue(&v[i]); err != nil {
+			invalidParams.AddNested(fmt.Sprintf("[%d]", i), err.(smithy.InvalidParamsError))
+		}
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	} else {
+		return nil
+	}
+}
+
+func customValidateRecord(v *types.Record) error {
+	if v == nil {
+		return nil
+	}
+	invalidParams := smithy.InvalidParamsError{Context: "Record"}
+	if v.MeasureValues != nil {
+		if err := customValidateMeasureValues(v.MeasureValues); err != nil {
+			invalidParams.AddNested("MeasureValues", err.(smithy.InvalidParamsError))
+		}
+	}
+	if v.MeasureValue != nil && len(v.MeasureValues) != 0 {
+		invalidParams.Add(validation.NewErrInvalidValue("MeasureValues",
+			"cannot be used with MeasureValue, a record must use either a single measure value or multiple measure values"))
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	} else {
+		return nil
+	}
+}
+
+func customValidateRecords(v []types.Record) error {
+	if v == nil {
+		return nil
+	}
+	invalidParams := smithy.InvalidParamsError{Context: "Records"}
+	for i := range v {
+		if err := customValidateRecord(&v[i]); err != nil {
+			invalidParams.AddNested(fmt.Sprintf("[%d]", i), err.(smithy.InvalidParamsError))
+		}
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	} else {
+		return nil
+	}
+}
+
+func customValidateRetentionProperties(v *types.RetentionProperties) error {
+	if v == nil {
+		return nil
+	}
+	invalidParams := smithy.InvalidParamsError{Context: "RetentionProperties"}
+	if _, err := types.NewRetentionProperties(v.MemoryStoreRetentionPeriodInHours, v.MagneticStoreRetentionPeriodInDays); err != nil {
+		var rangeErr *types.RetentionRangeError
+		if !errors.As(err, &rangeErr) {
+			return err
+		}
+		invalidParams.Add(validation.NewErrInvalidValue(rangeErr.Field,
+			fmt.Sprintf("%d out of range, must be between %d and %d", rangeErr.Value, rangeErr.Min, rangeErr.Max)))
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	} else {
+		return nil
+	}
+}
+
+func customValidateOpCreateTableInput(v *CreateTableInput) error {
+	if v == nil {
+		return nil
+	}
+	invalidParams := smithy.InvalidParamsError{Context: "CreateTableInput"}
+	if v.RetentionProperties != nil {
+		if err := customValidateRetentionProperties(v.RetentionProperties); err != nil {
+			invalidParams.AddNested("RetentionProperties", err.(smithy.InvalidParamsError))
+		}
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	} else {
+		return nil
+	}
+}
+
+func customValidateOpUpdateTableInput(v *UpdateTableInput) error {
+	if v == nil {
+		return nil
+	}
+	invalidParams := smithy.InvalidParamsError{Context: "UpdateTableInput"}
+	if v.RetentionProperties != nil {
+		if err := customValidateRetentionProperties(v.RetentionProperties); err != nil {
+			invalidParams.AddNested("RetentionProperties", err.(smithy.InvalidParamsError))
+		}
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	} else {
+		return nil
+	}
+}
+
+func customValidateOpWriteRecordsInput(v *WriteRecordsInput) error {
+	if v == nil {
+		return nil
+	}
+	invalidParams := smithy.InvalidParamsError{Context: "WriteRecordsInput"}
+	if v.CommonAttributes != nil {
+		if err := customValidateRecord(v.CommonAttributes); err != nil {
+			invalidParams.AddNested("CommonAttributes", err.(smithy.InvalidParamsError))
+		}
+	}
+	if v.Records != nil {
+		if err := customValidateRecords(v.Records); err != nil {
+			invalidParams.AddNested("Records", err.(smithy.InvalidParamsError))
+		}
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	} else {
+		return nil
+	}
+}
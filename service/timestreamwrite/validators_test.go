@@ -0,0 +1,274 @@
+package timestreamwrite
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+)
+
+func TestWriteRecords_UnknownEnumValues(t *testing.T) {
+	cases := map[string]struct {
+		Record      types.Record
+		Reject      bool
+		ExpectField string
+	}{
+		"unset": {
+			Reject: true,
+		},
+		"known": {
+			Record: types.Record{
+				MeasureValueType: types.MeasureValueTypeDouble,
+				TimeUnit:         types.TimeUnitMilliseconds,
+			},
+			Reject: true,
+		},
+		"unknown sent by default": {
+			Record: types.Record{MeasureValueType: "FLOAT", TimeUnit: "MILLIS"},
+		},
+		"unknown measure value type": {
+			Record:      types.Record{MeasureValueType: "FLOAT"},
+			Reject:      true,
+			ExpectField: "Records[0].MeasureValueType",
+		},
+		"unknown time unit": {
+			Record:      types.Record{TimeUnit: "MILLIS"},
+			Reject:      true,
+			ExpectField: "Records[0].TimeUnit",
+		},
+		"unknown measure values type": {
+			Record: types.Record{
+				MeasureValueType: types.MeasureValueTypeMulti,
+				MeasureValues: []types.MeasureValue{
+					{Name: aws.String("cpu"), Value: aws.String("13.5"), Type: "FLOAT"},
+				},
+			},
+			Reject:      true,
+			ExpectField: "Records[0].MeasureValues[0].Type",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var sent bool
+			client := newMockClient(func(r *http.Request) { sent = true }, func(o *Options) {
+				o.RejectUnknownEnumValues = c.Reject
+			})
+
+			_, err := client.WriteRecords(context.Background(), &WriteRecordsInput{
+				DatabaseName: aws.String("db"),
+				TableName:    aws.String("table"),
+				Records:      []types.Record{c.Record},
+			})
+			if len(c.ExpectField) == 0 {
+				if err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+				if !sent {
+					t.Errorf("expect request to be sent")
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expect error, got none")
+			}
+			if e, a := c.ExpectField, err.Error(); !strings.Contains(a, e) {
+				t.Errorf("expect error to contain %v, got %v", e, a)
+			}
+			if sent {
+				t.Errorf("expect request not to be sent")
+			}
+		})
+	}
+}
+
+func TestWriteRecords_MeasureValues(t *testing.T) {
+	cases := map[string]struct {
+		Record      types.Record
+		ExpectField string
+	}{
+		"multi measure": {
+			Record: types.Record{
+				MeasureValueType: types.MeasureValueTypeMulti,
+				MeasureValues: []types.MeasureValue{
+					{Name: aws.String("cpu"), Value: aws.String("13.5"), Type: types.MeasureValueTypeDouble},
+				},
+			},
+		},
+		"single and multi measure": {
+			Record: types.Record{
+				MeasureValue: aws.String("13.5"),
+				MeasureValues: []types.MeasureValue{
+					{Name: aws.String("cpu"), Value: aws.String("13.5"), Type: types.MeasureValueTypeDouble},
+				},
+			},
+			ExpectField: "Records[0].MeasureValues",
+		},
+		"missing measure value name": {
+			Record: types.Record{
+				MeasureValues: []types.MeasureValue{
+					{Value: aws.String("13.5"), Type: types.MeasureValueTypeDouble},
+				},
+			},
+			ExpectField: "Records[0].MeasureValues[0].Name",
+		},
+		"unknown measure value type": {
+			Record: types.Record{
+				MeasureValues: []types.MeasureValue{
+					{Name: aws.String("cpu"), Value: aws.String("13.5"), Type: "FLOAT"},
+				},
+			},
+		},
+		"multi measure value type": {
+			Record: types.Record{
+				MeasureValues: []types.MeasureValue{
+					{Name: aws.String("cpu"), Value: aws.String("13.5"), Type: types.MeasureValueTypeMulti},
+				},
+			},
+			ExpectField: "Records[0].MeasureValues[0].Type",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var sent bool
+			client := newMockClient(func(r *http.Request) { sent = true })
+
+			_, err := client.WriteRecords(context.Background(), &WriteRecordsInput{
+				DatabaseName: aws.String("db"),
+				TableName:    aws.String("table"),
+				Records:      []types.Record{c.Record},
+			})
+			if len(c.ExpectField) == 0 {
+				if err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+				if !sent {
+					t.Errorf("expect request to be sent")
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expect error, got none")
+			}
+			if e, a := c.ExpectField, err.Error(); !strings.Contains(a, e) {
+				t.Errorf("expect error to contain %v, got %v", e, a)
+			}
+			if sent {
+				t.Errorf("expect request not to be sent")
+			}
+		})
+	}
+}
+
+func TestValidateRetentionProperties(t *testing.T) {
+	cases := map[string]struct {
+		Operation   func(*Client, *types.RetentionProperties) error
+		Retention   types.RetentionProperties
+		ExpectField string
+		ExpectRange string
+	}{
+		"create table": {
+			Operation: createTableWithRetention,
+			Retention: types.RetentionProperties{
+				MemoryStoreRetentionPeriodInHours:  24,
+				MagneticStoreRetentionPeriodInDays: 365,
+			},
+		},
+		"create table memory out of range": {
+			Operation: createTableWithRetention,
+			Retention: types.RetentionProperties{
+				MemoryStoreRetentionPeriodInHours:  10000,
+				MagneticStoreRetentionPeriodInDays: 365,
+			},
+			ExpectField: "RetentionProperties.MemoryStoreRetentionPeriodInHours",
+			ExpectRange: "between 1 and 8766",
+		},
+		"create table magnetic out of range": {
+			Operation: createTableWithRetention,
+			Retention: types.RetentionProperties{
+				MemoryStoreRetentionPeriodInHours:  24,
+				MagneticStoreRetentionPeriodInDays: 100000,
+			},
+			ExpectField: "RetentionProperties.MagneticStoreRetentionPeriodInDays",
+			ExpectRange: "between 1 and 73000",
+		},
+		"update table": {
+			Operation: updateTableWithRetention,
+			Retention: types.RetentionProperties{
+				MemoryStoreRetentionPeriodInHours:  8766,
+				MagneticStoreRetentionPeriodInDays: 73000,
+			},
+		},
+		"update table memory out of range": {
+			Operation: updateTableWithRetention,
+			Retention: types.RetentionProperties{
+				MemoryStoreRetentionPeriodInHours:  0,
+				MagneticStoreRetentionPeriodInDays: 365,
+			},
+			ExpectField: "RetentionProperties.MemoryStoreRetentionPeriodInHours",
+			ExpectRange: "between 1 and 8766",
+		},
+		"update table magnetic out of range": {
+			Operation: updateTableWithRetention,
+			Retention: types.RetentionProperties{
+				MemoryStoreRetentionPeriodInHours:  24,
+				MagneticStoreRetentionPeriodInDays: -1,
+			},
+			ExpectField: "RetentionProperties.MagneticStoreRetentionPeriodInDays",
+			ExpectRange: "between 1 and 73000",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var sent bool
+			client := newMockClient(func(r *http.Request) { sent = true })
+
+			err := c.Operation(client, &c.Retention)
+			if len(c.ExpectField) == 0 {
+				if err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+				if !sent {
+					t.Errorf("expect request to be sent")
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expect error, got none")
+			}
+			for _, expect := range []string{c.ExpectField, c.ExpectRange} {
+				if e, a := expect, err.Error(); !strings.Contains(a, e) {
+					t.Errorf("expect error to contain %v, got %v", e, a)
+				}
+			}
+			if sent {
+				t.Errorf("expect request not to be sent")
+			}
+		})
+	}
+}
+
+func createTableWithRetention(client *Client, retention *types.RetentionProperties) error {
+	_, err := client.CreateTable(context.Background(), &CreateTableInput{
+		DatabaseName:        aws.String("db"),
+		TableName:           aws.String("table"),
+		RetentionProperties: retention,
+	})
+	return err
+}
+
+func updateTableWithRetention(client *Client, retention *types.RetentionProperties) error {
+	_, err := client.UpdateTable(context.Background(), &UpdateTableInput{
+		DatabaseName:        aws.String("db"),
+		TableName:           aws.String("table"),
+		RetentionProperties: retention,
+	})
+	return err
+}
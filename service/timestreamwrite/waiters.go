@@ -0,0 +1,222 @@
+package timestreamwrite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/internal/waiter"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// DescribeDatabaseAPIClient is a client that implements the DescribeDatabase
+// operation.
+type DescribeDatabaseAPIClient interface {
+	DescribeDatabase(context.Context, *DescribeDatabaseInput, ...func(*Options)) (*DescribeDatabaseOutput, error)
+}
+
+var _ DescribeDatabaseAPIClient = (*Client)(nil)
+
+// DatabaseExistsWaiterOptions are waiter options for DatabaseExistsWaiter
+type DatabaseExistsWaiterOptions struct {
+
+	// Set of options to modify how an operation is invoked. These apply to all
+	// operations invoked for this client. Use functional options on operation call to
+	// modify this list for per operation behavior.
+	APIOptions []func(*middleware.Stack) error
+
+	// MinDelay is the minimum amount of time to delay between retries. If unset,
+	// DatabaseExistsWaiter will use default minimum delay of 2 seconds. Note that
+	// MinDelay must resolve to a value lesser than or equal to the MaxDelay.
+	MinDelay time.Duration
+
+	// MaxDelay is the maximum amount of time to delay between retries. If unset or set
+	// to zero, DatabaseExistsWaiter will use default max delay of 120 seconds. Note
+	// that MaxDelay must resolve to value greater than or equal to the MinDelay.
+	MaxDelay time.Duration
+
+	// Retryable is function that can be used to override the default waiter-behavior
+	// based on operation output, or returned error. The function returns an error in
+	// case of a failure state. In case of retry state, this function returns a bool
+	// value of true and nil error, while in case of success it returns a bool value
+	// of false and nil error.
+	Retryable func(context.Context, *DescribeDatabaseInput, *DescribeDatabaseOutput, error) (bool, error)
+}
+
+// DatabaseExistsWaiter defines the waiter for a database to be described
+// successfully after it was created.
+type DatabaseExistsWaiter struct {
+	client DescribeDatabaseAPIClient
+
+	options DatabaseExistsWaiterOptions
+}
+
+// NewDatabaseExistsWaiter constructs a DatabaseExistsWaiter.
+func NewDatabaseExistsWaiter(client DescribeDatabaseAPIClient, optFns ...func(*DatabaseExistsWaiterOptions)) *DatabaseExistsWaiter {
+	options := DatabaseExistsWaiterOptions{}
+	options.MinDelay = 2 * time.Second
+	options.MaxDelay = 120 * time.Second
+	options.Retryable = databaseExistsStateRetryable
+
+	for _, fn := range optFns {
+		fn(&options)
+	}
+	return &DatabaseExistsWaiter{
+		client:  client,
+		options: options,
+	}
+}
+
+// Wait calls the waiter function for DatabaseExists waiter. The maxWaitDur is the
+// maximum wait duration the waiter will wait. The maxWaitDur is required and must
+// be greater than zero.
+func (w *DatabaseExistsWaiter) Wait(ctx context.Context, params *DescribeDatabaseInput, maxWaitDur time.Duration, optFns ...func(*DatabaseExistsWaiterOptions)) error {
+	options := w.options
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	if options.MaxDelay <= 0 {
+		options.MaxDelay = 120 * time.Second
+	}
+
+	return waiter.Wait(ctx, waiter.Options{
+		Name:     "DatabaseExists",
+		MinDelay: options.MinDelay,
+		MaxDelay: options.MaxDelay,
+		MaxWait:  maxWaitDur,
+	}, func(out interface{}, err error) (bool, error) {
+		retryable, err := options.Retryable(ctx, params, out.(*DescribeDatabaseOutput), err)
+		return !retryable, err
+	}, func(ctx context.Context) (interface{}, error) {
+		return w.client.DescribeDatabase(ctx, params, func(o *Options) {
+			o.APIOptions = append(o.APIOptions, options.APIOptions...)
+		})
+	})
+}
+
+func databaseExistsStateRetryable(ctx context.Context, input *DescribeDatabaseInput, output *DescribeDatabaseOutput, err error) (bool, error) {
+	if err != nil {
+		var notFound *types.ResourceNotFoundException
+		if errors.As(err, &notFound) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	return false, nil
+}
+
+// DescribeTableAPIClient is a client that implements the DescribeTable
+// operation.
+type DescribeTableAPIClient interface {
+	DescribeTable(context.Context, *DescribeTableInput, ...func(*Options)) (*DescribeTableOutput, error)
+}
+
+var _ DescribeTableAPIClient = (*Client)(nil)
+
+// TableActiveWaiterOptions are waiter options for TableActiveWaiter
+type TableActiveWaiterOptions struct {
+
+	// Set of options to modify how an operation is invoked. These apply to all
+	// operations invoked for this client. Use functional options on operation call to
+	// modify this list for per operation behavior.
+	APIOptions []func(*middleware.Stack) error
+
+	// MinDelay is the minimum amount of time to delay between retries. If unset,
+	// TableActiveWaiter will use default minimum delay of 2 seconds. Note that
+	// MinDelay must resolve to a value lesser than or equal to the MaxDelay.
+	MinDelay time.Duration
+
+	// MaxDelay is the maximum amount of time to delay between retries. If unset or set
+	// to zero, TableActiveWaiter will use default max delay of 120 seconds. Note that
+	// MaxDelay must resolve to value greater than or equal to the MinDelay.
+	MaxDelay time.Duration
+
+	// Retryable is function that can be used to override the default waiter-behavior
+	// based on operation output, or returned error. The function returns an error in
+	// case of a failure state. In case of retry state, this function returns a bool
+	// value of true and nil error, while in case of success it returns a bool value
+	// of false and nil error.
+	Retryable func(context.Context, *DescribeTableInput, *DescribeTableOutput, error) (bool, error)
+}
+
+// TableActiveWaiter defines the waiter for a table to become ACTIVE, and
+// writable, after it was created. The waiter continues to wait while the table
+// is not yet described, or is in any status other than ACTIVE, such as
+// CREATING. The waiter fails if the table is DELETING.
+type TableActiveWaiter struct {
+	client DescribeTableAPIClient
+
+	options TableActiveWaiterOptions
+}
+
+// NewTableActiveWaiter constructs a TableActiveWaiter.
+func NewTableActiveWaiter(client DescribeTableAPIClient, optFns ...func(*TableActiveWaiterOptions)) *TableActiveWaiter {
+	options := TableActiveWaiterOptions{}
+	options.MinDelay = 2 * time.Second
+	options.MaxDelay = 120 * time.Second
+	options.Retryable = tableActiveStateRetryable
+
+	for _, fn := range optFns {
+		fn(&options)
+	}
+	return &TableActiveWaiter{
+		client:  client,
+		options: options,
+	}
+}
+
+// Wait calls the waiter function for TableActive waiter. The maxWaitDur is the
+// maximum wait duration the waiter will wait. The maxWaitDur is required and must
+// be greater than zero.
+func (w *TableActiveWaiter) Wait(ctx context.Context, params *DescribeTableInput, maxWaitDur time.Duration, optFns ...func(*TableActiveWaiterOptions)) error {
+	options := w.options
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	if options.MaxDelay <= 0 {
+		options.MaxDelay = 120 * time.Second
+	}
+
+	return waiter.Wait(ctx, waiter.Options{
+		Name:     "TableActive",
+		MinDelay: options.MinDelay,
+		MaxDelay: options.MaxDelay,
+		MaxWait:  maxWaitDur,
+	}, func(out interface{}, err error) (bool, error) {
+		retryable, err := options.Retryable(ctx, params, out.(*DescribeTableOutput), err)
+		return !retryable, err
+	}, func(ctx context.Context) (interface{}, error) {
+		return w.client.DescribeTable(ctx, params, func(o *Options) {
+			o.APIOptions = append(o.APIOptions, options.APIOptions...)
+		})
+	})
+}
+
+func tableActiveStateRetryable(ctx context.Context, input *DescribeTableInput, output *DescribeTableOutput, err error) (bool, error) {
+	if err != nil {
+		var notFound *types.ResourceNotFoundException
+		if errors.As(err, &notFound) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	if output.Table == nil {
+		return true, nil
+	}
+
+	switch output.Table.TableStatus {
+	case types.TableStatusActive:
+		return false, nil
+	case types.TableStatusDeleting:
+		return false, fmt.Errorf("waiter state transitioned to Failure, table is %s",
+			output.Table.TableStatus)
+	default:
+		return true, nil
+	}
+}
@@ -0,0 +1,174 @@
+package timestreamwrite
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/internal/sdk"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+)
+
+type mockDescribeDatabaseClient struct {
+	errs  []error
+	calls int
+}
+
+func (m *mockDescribeDatabaseClient) DescribeDatabase(ctx context.Context, params *DescribeDatabaseInput, optFns ...func(*Options)) (*DescribeDatabaseOutput, error) {
+	err := m.errs[m.calls]
+	m.calls++
+	if err != nil {
+		return nil, err
+	}
+	return &DescribeDatabaseOutput{
+		Database: &types.Database{DatabaseName: params.DatabaseName},
+	}, nil
+}
+
+func TestDatabaseExistsWaiter(t *testing.T) {
+	cases := map[string]struct {
+		Errs        []error
+		ExpectCalls int
+		ExpectErr   bool
+	}{
+		"not found then exists": {
+			Errs:        []error{&types.ResourceNotFoundException{}, nil},
+			ExpectCalls: 2,
+		},
+		"access denied": {
+			Errs:        []error{&types.AccessDeniedException{}},
+			ExpectCalls: 1,
+			ExpectErr:   true,
+		},
+		"unexpected error": {
+			Errs:        []error{fmt.Errorf("some error")},
+			ExpectCalls: 1,
+			ExpectErr:   true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			restoreSleep := sdk.TestingUseNopSleep()
+			defer restoreSleep()
+
+			client := &mockDescribeDatabaseClient{errs: c.Errs}
+			w := NewDatabaseExistsWaiter(client)
+
+			err := w.Wait(context.Background(), &DescribeDatabaseInput{
+				DatabaseName: aws.String("db"),
+			}, time.Hour)
+			if c.ExpectErr {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+			} else if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.ExpectCalls, client.calls; e != a {
+				t.Errorf("expect %v calls, got %v", e, a)
+			}
+		})
+	}
+}
+
+type mockDescribeTableClient struct {
+	statuses []types.TableStatus
+	errs     []error
+	calls    int
+}
+
+func (m *mockDescribeTableClient) DescribeTable(ctx context.Context, params *DescribeTableInput, optFns ...func(*Options)) (*DescribeTableOutput, error) {
+	i := m.calls
+	m.calls++
+	if i < len(m.errs) && m.errs[i] != nil {
+		return nil, m.errs[i]
+	}
+	return &DescribeTableOutput{
+		Table: &types.Table{
+			DatabaseName: params.DatabaseName,
+			TableName:    params.TableName,
+			TableStatus:  m.statuses[i],
+		},
+	}, nil
+}
+
+func TestTableActiveWaiter(t *testing.T) {
+	cases := map[string]struct {
+		Statuses    []types.TableStatus
+		Errs        []error
+		ExpectCalls int
+		ExpectErr   bool
+	}{
+		"creating then active": {
+			Statuses:    []types.TableStatus{"CREATING", "CREATING", types.TableStatusActive},
+			ExpectCalls: 3,
+		},
+		"not found then active": {
+			Statuses:    []types.TableStatus{"", types.TableStatusActive},
+			Errs:        []error{&types.ResourceNotFoundException{}},
+			ExpectCalls: 2,
+		},
+		"deleting": {
+			Statuses:    []types.TableStatus{"CREATING", types.TableStatusDeleting},
+			ExpectCalls: 2,
+			ExpectErr:   true,
+		},
+		"access denied": {
+			Statuses:    []types.TableStatus{""},
+			Errs:        []error{&types.AccessDeniedException{}},
+			ExpectCalls: 1,
+			ExpectErr:   true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			restoreSleep := sdk.TestingUseNopSleep()
+			defer restoreSleep()
+
+			client := &mockDescribeTableClient{statuses: c.Statuses, errs: c.Errs}
+			w := NewTableActiveWaiter(client)
+
+			err := w.Wait(context.Background(), &DescribeTableInput{
+				DatabaseName: aws.String("db"),
+				TableName:    aws.String("table"),
+			}, time.Hour)
+			if c.ExpectErr {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+			} else if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.ExpectCalls, client.calls; e != a {
+				t.Errorf("expect %v calls, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestTableActiveWaiter_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client := &mockDescribeTableClient{
+		statuses: []types.TableStatus{"CREATING", "CREATING", "CREATING"},
+	}
+	w := NewTableActiveWaiter(client, func(o *TableActiveWaiterOptions) {
+		o.MinDelay = time.Millisecond
+		o.MaxDelay = time.Millisecond
+	})
+
+	err := w.Wait(ctx, &DescribeTableInput{
+		DatabaseName: aws.String("db"),
+		TableName:    aws.String("table"),
+	}, time.Hour)
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+}
@@ -0,0 +1,61 @@
+package timestreamwrite
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aws/smithy-go/middleware"
+	"golang.org/x/time/rate"
+)
+
+// writeRateLimit is a finalize middleware that blocks each attempt of the
+// WriteRecords operation until the client's write rate limiter allows it.
+type writeRateLimit struct {
+	limiter *rate.Limiter
+}
+
+func (*writeRateLimit) ID() string {
+	return "WriteRateLimit"
+}
+
+func (m *writeRateLimit) HandleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (
+	out middleware.FinalizeOutput, metadata middleware.Metadata, err error,
+) {
+	if err := m.limiter.Wait(ctx); err != nil {
+		return out, metadata, fmt.Errorf("failed to wait for write rate limit, %w", err)
+	}
+	return next.HandleFinalize(ctx, in)
+}
+
+// sharedRateLimiter is the write rate limiter shared by all WriteRecords
+// operations of a client. The limiter is created by the first rate limited
+// operation, since the client's WriteRateLimit is not known until the
+// client's functional options have been applied.
+type sharedRateLimiter struct {
+	once    sync.Once
+	limiter *rate.Limiter
+}
+
+// get returns the shared limiter, creating it with the limit if it has not
+// been created yet.
+func (s *sharedRateLimiter) get(limit rate.Limit) *rate.Limiter {
+	s.once.Do(func() {
+		s.limiter = rate.NewLimiter(limit, 1)
+	})
+	return s.limiter
+}
+
+// resolveWriteRateLimiter creates the limiter shared by all WriteRecords
+// operations of the client.
+func resolveWriteRateLimiter(o *Options) {
+	o.writeRateLimiter = &sharedRateLimiter{}
+}
+
+func addWriteRateLimit(stack *middleware.Stack, o Options) error {
+	if o.WriteRateLimit == 0 || o.writeRateLimiter == nil {
+		return nil
+	}
+	limiter := o.writeRateLimiter.get(o.WriteRateLimit)
+	return stack.Finalize.Insert(&writeRateLimit{limiter: limiter}, "Retry", middleware.After)
+}
@@ -0,0 +1,109 @@
+package timestreamwrite
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"golang.org/x/time/rate"
+)
+
+func newWriteRateLimitInput() *WriteRecordsInput {
+	return &WriteRecordsInput{
+		DatabaseName: aws.String("db"),
+		TableName:    aws.String("table"),
+		Records:      newTestRecords(1),
+	}
+}
+
+func TestClient_WriteRateLimit(t *testing.T) {
+	cases := map[string]struct {
+		Limit       rate.Limit
+		Invoke      func(*Client) error
+		ExpectDelay bool
+	}{
+		"write records limited": {
+			Limit: 5,
+			Invoke: func(c *Client) error {
+				_, err := c.WriteRecords(context.Background(), newWriteRateLimitInput())
+				return err
+			},
+			ExpectDelay: true,
+		},
+		"other operations not limited": {
+			Limit: 5,
+			Invoke: func(c *Client) error {
+				_, err := c.ListDatabases(context.Background(), &ListDatabasesInput{})
+				return err
+			},
+		},
+		"zero limit": {
+			Invoke: func(c *Client) error {
+				_, err := c.WriteRecords(context.Background(), newWriteRateLimitInput())
+				return err
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var sent []time.Time
+			client := newMockClient(func(r *http.Request) {
+				sent = append(sent, time.Now())
+			}, func(o *Options) {
+				o.WriteRateLimit = c.Limit
+			})
+
+			for i := 0; i < 2; i++ {
+				if err := c.Invoke(client); err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+			}
+			if e, a := 2, len(sent); e != a {
+				t.Fatalf("expect %v requests, got %v", e, a)
+			}
+
+			// The second request must wait 200ms for a token at 5 requests per
+			// second.
+			delay := sent[1].Sub(sent[0])
+			if c.ExpectDelay && delay < 150*time.Millisecond {
+				t.Errorf("expect second request to be delayed, got %v", delay)
+			}
+			if !c.ExpectDelay && delay >= 150*time.Millisecond {
+				t.Errorf("expect second request to not be delayed, got %v", delay)
+			}
+		})
+	}
+}
+
+func TestClient_WriteRateLimitContext(t *testing.T) {
+	var requests int32
+	client := newMockClient(func(r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+	}, func(o *Options) {
+		o.WriteRateLimit = 1
+	})
+
+	input := newWriteRateLimitInput()
+	if _, err := client.WriteRecords(context.Background(), input); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.WriteRecords(ctx, input)
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expect wait to respect context deadline, took %v", elapsed)
+	}
+	if e, a := int32(1), atomic.LoadInt32(&requests); e != a {
+		t.Errorf("expect %v requests, got %v", e, a)
+	}
+}
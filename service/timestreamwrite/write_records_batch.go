@@ -0,0 +1,225 @@
+package timestreamwrite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+)
+
+// MaxWriteRecordsBatchSize is the maximum number of records that can be
+// written by a single WriteRecords request.
+const MaxWriteRecordsBatchSize = 100
+
+// WriteRecordsAPIClient is a client that implements the WriteRecords
+// operation.
+type WriteRecordsAPIClient interface {
+	WriteRecords(context.Context, *WriteRecordsInput, ...func(*Options)) (*WriteRecordsOutput, error)
+}
+
+var _ WriteRecordsAPIClient = (*Client)(nil)
+
+// BatchOptions provides the options for WriteRecordsBatch.
+type BatchOptions struct {
+	// The number of chunks of records that will be written concurrently. If
+	// zero, chunks are written one at a time.
+	Concurrency int
+
+	// The maximum number of records WriteRecordsBatch will write. If the input
+	// has more records, a *TooManyRecordsError is returned without writing
+	// any of them. If zero, the number of records is unbounded.
+	MaxTotalRecords int
+
+	// The functional options passed to each operation invoked.
+	ClientOptions []func(*Options)
+}
+
+// WriteRecordsChunkResult is the result of writing a single chunk of records
+// with WriteRecords.
+type WriteRecordsChunkResult struct {
+	// The index of the chunk, starting at 0.
+	Index int
+
+	// The offset of the chunk's first record within the records of the
+	// WriteRecordsBatch input.
+	Offset int
+
+	// The records of the chunk.
+	Records []types.Record
+
+	// The output of the WriteRecords operation, if the chunk was written.
+	Output *WriteRecordsOutput
+
+	// The error writing the chunk, if any.
+	Err error
+}
+
+// WriteRecordsBatchOutput is the output of WriteRecordsBatch.
+type WriteRecordsBatchOutput struct {
+	// The result of each chunk, ordered by chunk index.
+	Chunks []WriteRecordsChunkResult
+}
+
+// FailedRecords returns the records of the WriteRecordsBatch input that were
+// not written, ordered by their index in the input, so they can be written
+// again. For a chunk that failed with a *types.RejectedRecordsException only
+// the records at the RecordIndex of each RejectedRecord are returned, mapped
+// from their index within the chunk. For a chunk that failed with any other
+// error all of the chunk's records are returned.
+func (o *WriteRecordsBatchOutput) FailedRecords() []types.Record {
+	if o == nil {
+		return nil
+	}
+
+	var failed []types.Record
+	for _, c := range o.Chunks {
+		if c.Err == nil {
+			continue
+		}
+
+		var rejected *types.RejectedRecordsException
+		if !errors.As(c.Err, &rejected) {
+			failed = append(failed, c.Records...)
+			continue
+		}
+
+		// RejectedRecords are not guaranteed to be ordered or unique.
+		isRejected := make([]bool, len(c.Records))
+		for _, r := range rejected.RejectedRecords {
+			if r.RecordIndex >= 0 && int(r.RecordIndex) < len(c.Records) {
+				isRejected[r.RecordIndex] = true
+			}
+		}
+		for i, r := range c.Records {
+			if isRejected[i] {
+				failed = append(failed, r)
+			}
+		}
+	}
+
+	return failed
+}
+
+// WriteRecordsBatchError is returned by WriteRecordsBatch when one or more
+// chunks of records could not be written.
+type WriteRecordsBatchError struct {
+	// The chunks that failed to be written, ordered by chunk index.
+	Chunks []WriteRecordsChunkResult
+}
+
+func (e *WriteRecordsBatchError) Error() string {
+	var msgs []string
+	for _, c := range e.Chunks {
+		msgs = append(msgs, fmt.Sprintf("chunk %d: %v", c.Index, c.Err))
+	}
+	return fmt.Sprintf("failed to write %d chunk(s) of records, %s", len(e.Chunks), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns an *aws.BatchError with a failure for each failed chunk,
+// indexed by chunk index.
+func (e *WriteRecordsBatchError) Unwrap() error {
+	failures := make([]aws.BatchFailure, 0, len(e.Chunks))
+	for _, c := range e.Chunks {
+		failures = append(failures, aws.BatchFailure{Index: c.Index, Err: c.Err})
+	}
+	return &aws.BatchError{Failures: failures}
+}
+
+// TooManyRecordsError is returned by WriteRecordsBatch when the input has
+// more records than the MaxTotalRecords of the BatchOptions.
+type TooManyRecordsError struct {
+	Records         int
+	MaxTotalRecords int
+}
+
+func (e *TooManyRecordsError) Error() string {
+	return fmt.Sprintf("%d records exceeds the maximum of %d records", e.Records, e.MaxTotalRecords)
+}
+
+// WriteRecordsBatch writes the records of params in chunks of up to
+// MaxWriteRecordsBatchSize records, one WriteRecords request per chunk. The
+// DatabaseName, TableName, and CommonAttributes of params are used for every
+// chunk.
+//
+// Chunks are written concurrently up to the Concurrency of the BatchOptions.
+// The results of the chunks are returned ordered by chunk index, regardless
+// of the order the chunks completed in. If one or more chunks fail to be
+// written, the output is returned along with a *WriteRecordsBatchError
+// describing the failed chunks, which unwraps to an *aws.BatchError. Chunks
+// not yet started when the context is canceled fail with the context's error.
+// If the input has more records than the MaxTotalRecords of the
+// BatchOptions, a *TooManyRecordsError is returned before any are written.
+func WriteRecordsBatch(ctx context.Context, client WriteRecordsAPIClient, params *WriteRecordsInput, optFns ...func(*BatchOptions)) (*WriteRecordsBatchOutput, error) {
+	if params == nil {
+		params = &WriteRecordsInput{}
+	}
+
+	var options BatchOptions
+	for _, fn := range optFns {
+		fn(&options)
+	}
+	if options.Concurrency <= 0 {
+		options.Concurrency = 1
+	}
+	if options.MaxTotalRecords > 0 && len(params.Records) > options.MaxTotalRecords {
+		return nil, &TooManyRecordsError{
+			Records:         len(params.Records),
+			MaxTotalRecords: options.MaxTotalRecords,
+		}
+	}
+
+	var chunks []WriteRecordsChunkResult
+	for offset := 0; offset < len(params.Records); offset += MaxWriteRecordsBatchSize {
+		end := offset + MaxWriteRecordsBatchSize
+		if end > len(params.Records) {
+			end = len(params.Records)
+		}
+		chunks = append(chunks, WriteRecordsChunkResult{
+			Index:   len(chunks),
+			Offset:  offset,
+			Records: params.Records[offset:end],
+		})
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, options.Concurrency)
+	for i := range chunks {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(chunk *WriteRecordsChunkResult) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := ctx.Err(); err != nil {
+				chunk.Err = err
+				return
+			}
+
+			input := *params
+			input.Records = chunk.Records
+			chunk.Output, chunk.Err = client.WriteRecords(ctx, &input, options.ClientOptions...)
+		}(&chunks[i])
+	}
+	wg.Wait()
+
+	out := &WriteRecordsBatchOutput{Chunks: chunks}
+
+	var batchErr *WriteRecordsBatchError
+	for _, c := range chunks {
+		if c.Err != nil {
+			if batchErr == nil {
+				batchErr = &WriteRecordsBatchError{}
+			}
+			batchErr.Chunks = append(batchErr.Chunks, c)
+		}
+	}
+	if batchErr != nil {
+		return out, batchErr
+	}
+
+	return out, nil
+}
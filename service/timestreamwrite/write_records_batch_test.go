@@ -0,0 +1,297 @@
+package timestreamwrite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+)
+
+type mockWriteRecordsClient struct {
+	// failures keyed by the MeasureName of the chunk's first record
+	failures map[string]error
+
+	mu     sync.Mutex
+	inputs []*WriteRecordsInput
+	active int
+	peak   int
+}
+
+func (m *mockWriteRecordsClient) WriteRecords(ctx context.Context, params *WriteRecordsInput, optFns ...func(*Options)) (*WriteRecordsOutput, error) {
+	m.mu.Lock()
+	m.inputs = append(m.inputs, params)
+	m.active++
+	if m.active > m.peak {
+		m.peak = m.active
+	}
+	m.mu.Unlock()
+	defer func() {
+		m.mu.Lock()
+		m.active--
+		m.mu.Unlock()
+	}()
+
+	// Complete later chunks first, so completion order differs from chunk order.
+	first, _ := strconv.Atoi(aws.ToString(params.Records[0].MeasureName))
+	time.Sleep(time.Duration(1000-first) * time.Microsecond)
+
+	if err := m.failures[aws.ToString(params.Records[0].MeasureName)]; err != nil {
+		return nil, err
+	}
+	return &WriteRecordsOutput{}, nil
+}
+
+func newTestRecords(n int) []types.Record {
+	records := make([]types.Record, n)
+	for i := range records {
+		records[i] = types.Record{MeasureName: aws.String(strconv.Itoa(i))}
+	}
+	return records
+}
+
+func TestWriteRecordsBatch(t *testing.T) {
+	for _, concurrency := range []int{0, 1, 3} {
+		t.Run(fmt.Sprintf("concurrency %d", concurrency), func(t *testing.T) {
+			client := &mockWriteRecordsClient{}
+
+			out, err := WriteRecordsBatch(context.Background(), client, &WriteRecordsInput{
+				DatabaseName: aws.String("db"),
+				TableName:    aws.String("table"),
+				Records:      newTestRecords(250),
+			}, func(o *BatchOptions) {
+				o.Concurrency = concurrency
+			})
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			var indexes, offsets, sizes []int
+			for _, c := range out.Chunks {
+				indexes = append(indexes, c.Index)
+				offsets = append(offsets, c.Offset)
+				sizes = append(sizes, len(c.Records))
+			}
+			if e, a := []int{0, 1, 2}, indexes; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v chunk indexes, got %v", e, a)
+			}
+			if e, a := []int{0, 100, 200}, offsets; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v chunk offsets, got %v", e, a)
+			}
+			if e, a := []int{100, 100, 50}, sizes; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v chunk sizes, got %v", e, a)
+			}
+
+			for _, input := range client.inputs {
+				if e, a := "db", aws.ToString(input.DatabaseName); e != a {
+					t.Errorf("expect %v database, got %v", e, a)
+				}
+				if e, a := "table", aws.ToString(input.TableName); e != a {
+					t.Errorf("expect %v table, got %v", e, a)
+				}
+			}
+
+			expectPeak := concurrency
+			if expectPeak == 0 {
+				expectPeak = 1
+			}
+			if client.peak > expectPeak {
+				t.Errorf("expect at most %v concurrent requests, got %v", expectPeak, client.peak)
+			}
+		})
+	}
+}
+
+func TestWriteRecordsBatch_ChunkFailure(t *testing.T) {
+	chunkErr := fmt.Errorf("rejected records")
+	client := &mockWriteRecordsClient{
+		failures: map[string]error{"100": chunkErr},
+	}
+
+	out, err := WriteRecordsBatch(context.Background(), client, &WriteRecordsInput{
+		DatabaseName: aws.String("db"),
+		TableName:    aws.String("table"),
+		Records:      newTestRecords(300),
+	}, func(o *BatchOptions) {
+		o.Concurrency = 3
+	})
+
+	var batchErr *WriteRecordsBatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("expect %T error, got %v", batchErr, err)
+	}
+	if e, a := 1, len(batchErr.Chunks); e != a {
+		t.Fatalf("expect %v failed chunks, got %v", e, a)
+	}
+	if e, a := 1, batchErr.Chunks[0].Index; e != a {
+		t.Errorf("expect chunk %v to fail, got %v", e, a)
+	}
+	if e, a := chunkErr, batchErr.Chunks[0].Err; e != a {
+		t.Errorf("expect %v error, got %v", e, a)
+	}
+
+	var awsBatchErr *aws.BatchError
+	if !errors.As(err, &awsBatchErr) {
+		t.Fatalf("expect %T error, got %v", awsBatchErr, err)
+	}
+	if e, a := []aws.BatchFailure{{Index: 1, Err: chunkErr}}, awsBatchErr.Failures; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v failures, got %v", e, a)
+	}
+	if !errors.Is(err, chunkErr) {
+		t.Errorf("expect error to wrap %v", chunkErr)
+	}
+
+	for i, c := range out.Chunks {
+		if i == 1 {
+			continue
+		}
+		if c.Err != nil || c.Output == nil {
+			t.Errorf("expect chunk %d to be written, got %v", i, c.Err)
+		}
+	}
+}
+
+func TestWriteRecordsBatch_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client := &mockWriteRecordsClient{}
+	_, err := WriteRecordsBatch(ctx, client, &WriteRecordsInput{
+		Records: newTestRecords(200),
+	})
+
+	var batchErr *WriteRecordsBatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("expect %T error, got %v", batchErr, err)
+	}
+	for _, c := range batchErr.Chunks {
+		if e, a := context.Canceled, c.Err; e != a {
+			t.Errorf("expect chunk %d %v error, got %v", c.Index, e, a)
+		}
+	}
+	if e, a := 0, len(client.inputs); e != a {
+		t.Errorf("expect %v requests, got %v", e, a)
+	}
+}
+
+func TestWriteRecordsBatch_MaxTotalRecords(t *testing.T) {
+	cases := map[string]struct {
+		Records         int
+		MaxTotalRecords int
+		ExpectRequests  int
+		ExpectErr       bool
+	}{
+		"unbounded": {
+			Records:        250,
+			ExpectRequests: 3,
+		},
+		"under cap": {
+			Records:         150,
+			MaxTotalRecords: 200,
+			ExpectRequests:  2,
+		},
+		"at cap": {
+			Records:         200,
+			MaxTotalRecords: 200,
+			ExpectRequests:  2,
+		},
+		"over cap": {
+			Records:         201,
+			MaxTotalRecords: 200,
+			ExpectErr:       true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := &mockWriteRecordsClient{}
+
+			_, err := WriteRecordsBatch(context.Background(), client, &WriteRecordsInput{
+				Records: newTestRecords(c.Records),
+			}, func(o *BatchOptions) {
+				o.MaxTotalRecords = c.MaxTotalRecords
+			})
+			if c.ExpectErr {
+				var tooMany *TooManyRecordsError
+				if !errors.As(err, &tooMany) {
+					t.Fatalf("expect %T error, got %v", tooMany, err)
+				}
+				if e, a := c.Records, tooMany.Records; e != a {
+					t.Errorf("expect %v records, got %v", e, a)
+				}
+				if e, a := c.MaxTotalRecords, tooMany.MaxTotalRecords; e != a {
+					t.Errorf("expect %v max total records, got %v", e, a)
+				}
+			} else if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.ExpectRequests, len(client.inputs); e != a {
+				t.Errorf("expect %v requests, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestWriteRecordsBatchOutput_FailedRecords(t *testing.T) {
+	client := &mockWriteRecordsClient{
+		failures: map[string]error{
+			// indexes within the chunk, out of order, duplicated, and out of range
+			"100": &types.RejectedRecordsException{
+				RejectedRecords: []types.RejectedRecord{
+					{RecordIndex: 99},
+					{RecordIndex: 3},
+					{RecordIndex: 0},
+					{RecordIndex: 3},
+					{RecordIndex: 100},
+				},
+			},
+			"300": fmt.Errorf("internal server error"),
+		},
+	}
+
+	out, err := WriteRecordsBatch(context.Background(), client, &WriteRecordsInput{
+		DatabaseName: aws.String("db"),
+		TableName:    aws.String("table"),
+		Records:      newTestRecords(320),
+	}, func(o *BatchOptions) {
+		o.Concurrency = 4
+	})
+	var batchErr *WriteRecordsBatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("expect %T error, got %v", batchErr, err)
+	}
+
+	expect := []string{"100", "103", "199"}
+	for i := 300; i < 320; i++ {
+		expect = append(expect, strconv.Itoa(i))
+	}
+
+	var actual []string
+	for _, r := range out.FailedRecords() {
+		actual = append(actual, aws.ToString(r.MeasureName))
+	}
+	if !reflect.DeepEqual(expect, actual) {
+		t.Errorf("expect %v failed records, got %v", expect, actual)
+	}
+}
+
+func TestWriteRecordsBatchOutput_FailedRecords_None(t *testing.T) {
+	client := &mockWriteRecordsClient{}
+
+	out, err := WriteRecordsBatch(context.Background(), client, &WriteRecordsInput{
+		Records: newTestRecords(150),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if v := out.FailedRecords(); len(v) != 0 {
+		t.Errorf("expect no failed records, got %v", v)
+	}
+}
@@ -103,6 +103,33 @@ func addOperationGetSamplingStatisticSummariesMiddlewares(stack *middleware.Stac
 	if err = addRequestResponseLogging(stack, options); err != nil {
 		return err
 	}
+	if err = addUserAgentAppID(stack, options); err != nil {
+		return err
+	}
+	if err = addResponseSizeLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addOperationSpan(stack, options); err != nil {
+		return err
+	}
+	if err = addOnRequestBody(stack, options); err != nil {
+		return err
+	}
+	if err = addConcurrencyLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addCorrelationID(stack, options); err != nil {
+		return err
+	}
+	if err = addOnResponseBody(stack, options); err != nil {
+		return err
+	}
+	if err = addRequestBodySize(stack); err != nil {
+		return err
+	}
+	if err = addDisableMiddlewareGuards(stack); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -120,6 +147,11 @@ type GetSamplingStatisticSummariesPaginatorOptions struct {
 	// Set to true if pagination should stop if the service returns a pagination token
 	// that matches the most recent token provided to the service.
 	StopOnDuplicateToken bool
+
+	// OnPage is called after each page is successfully retrieved, with the page
+	// number starting at 1, the number of items in the page, and the page's
+	// result metadata.
+	OnPage func(pageNum int, itemCount int, metadata middleware.Metadata)
 }
 
 // GetSamplingStatisticSummariesPaginator is a paginator for
@@ -130,6 +162,7 @@ type GetSamplingStatisticSummariesPaginator struct {
 	params    *GetSamplingStatisticSummariesInput
 	nextToken *string
 	firstPage bool
+	pageNum   int
 }
 
 // NewGetSamplingStatisticSummariesPaginator returns a new
@@ -180,6 +213,11 @@ func (p *GetSamplingStatisticSummariesPaginator) NextPage(ctx context.Context, o
 		p.nextToken = nil
 	}
 
+	p.pageNum++
+	if p.options.OnPage != nil {
+		p.options.OnPage(p.pageNum, len(result.SamplingStatisticSummaries), result.ResultMetadata)
+	}
+
 	return result, nil
 }
 
@@ -140,6 +140,33 @@ func addOperationGetTimeSeriesServiceStatisticsMiddlewares(stack *middleware.Sta
 	if err = addRequestResponseLogging(stack, options); err != nil {
 		return err
 	}
+	if err = addUserAgentAppID(stack, options); err != nil {
+		return err
+	}
+	if err = addResponseSizeLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addOperationSpan(stack, options); err != nil {
+		return err
+	}
+	if err = addOnRequestBody(stack, options); err != nil {
+		return err
+	}
+	if err = addConcurrencyLimit(stack, options); err != nil {
+		return err
+	}
+	if err = addCorrelationID(stack, options); err != nil {
+		return err
+	}
+	if err = addOnResponseBody(stack, options); err != nil {
+		return err
+	}
+	if err = addRequestBodySize(stack); err != nil {
+		return err
+	}
+	if err = addDisableMiddlewareGuards(stack); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -157,6 +184,11 @@ type GetTimeSeriesServiceStatisticsPaginatorOptions struct {
 	// Set to true if pagination should stop if the service returns a pagination token
 	// that matches the most recent token provided to the service.
 	StopOnDuplicateToken bool
+
+	// OnPage is called after each page is successfully retrieved, with the page
+	// number starting at 1, the number of items in the page, and the page's
+	// result metadata.
+	OnPage func(pageNum int, itemCount int, metadata middleware.Metadata)
 }
 
 // GetTimeSeriesServiceStatisticsPaginator is a paginator for
@@ -167,6 +199,7 @@ type GetTimeSeriesServiceStatisticsPaginator struct {
 	params    *GetTimeSeriesServiceStatisticsInput
 	nextToken *string
 	firstPage bool
+	pageNum   int
 }
 
 // NewGetTimeSeriesServiceStatisticsPaginator returns a new
@@ -217,6 +250,11 @@ func (p *GetTimeSeriesServiceStatisticsPaginator) NextPage(ctx context.Context,
 		p.nextToken = nil
 	}
 
+	p.pageNum++
+	if p.options.OnPage != nil {
+		p.options.OnPage(p.pageNum, len(result.TimeSeriesServiceStatistics), result.ResultMetadata)
+	}
+
 	return result, nil
 }
 